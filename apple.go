@@ -0,0 +1,79 @@
+package exif
+
+import (
+	"encoding/binary"
+	"errors"
+	"io"
+	"math"
+	"strings"
+)
+
+// appleHeader begins Apple maker notes. It is followed by a two byte version, a byte
+// order mark and the IFD, with offsets relative to the start of the maker note.
+const appleHeader = "Apple iOS\x00"
+
+// idAppleRunTime is the ID of the Apple maker note tag containing the RunTime plist.
+const idAppleRunTime ID = 0x0003
+
+// appleRunTimeKeys are the keys of the RunTime plist dictionary, which is an encoded
+// CMTime, in the order of the IDs of their AppleRunTime tags.
+var appleRunTimeKeys = []string{"flags", "value", "timescale", "epoch"}
+
+func matchApple(make string, header []byte) bool {
+	return strings.HasPrefix(string(header), appleHeader)
+}
+
+// appleIFD returns the location of the Apple maker note IFD, which is big-endian.
+func appleIFD(header []byte, offset int64, order binary.ByteOrder) (int64, int64, binary.ByteOrder, error) {
+	if len(header) < 14 {
+		return 0, 0, nil, errors.New("short Apple maker note")
+	}
+	return offset + 14, offset, headerOrder(header[12:14], binary.BigEndian), nil
+}
+
+// expandApple decodes the RunTime plist of the Apple maker note into the AppleRunTime
+// group. The fields of the plist are stored in 8 byte slots indexed by their tag ID,
+// followed by the composite RunTimeSinceBoot. The group is omitted if the RunTime
+// tag is missing or not a valid plist.
+func expandApple(lt *LazyDecoder, r io.ReaderAt, dir *Dir, model string) ([]*Dir, error) {
+	lztag, ok := dir.tag(idAppleRunTime)
+	if !ok {
+		return nil, nil
+	}
+	data, err := lt.tagData(r, lztag, dir)
+	if err != nil {
+		return nil, err
+	}
+	v, err := DecodeBinaryPlist(data)
+	if err != nil {
+		return nil, nil
+	}
+	dict, ok := v.(map[string]any)
+	if !ok {
+		return nil, nil
+	}
+	const slot = 8
+	runTime := make([]byte, slot*(len(appleRunTimeKeys)+1))
+	fields := make([]int64, len(appleRunTimeKeys))
+	for i, key := range appleRunTimeKeys {
+		fields[i], ok = dict[key].(int64)
+		if !ok {
+			return nil, nil
+		}
+		if key == "value" || key == "epoch" { // CMTime value and epoch are 64 bit.
+			binary.BigEndian.PutUint64(runTime[slot*i:], uint64(fields[i]))
+		} else {
+			binary.BigEndian.PutUint32(runTime[slot*i:], uint32(fields[i]))
+		}
+	}
+	if value, scale := fields[1], fields[2]; scale != 0 {
+		binary.BigEndian.PutUint64(runTime[slot*len(fields):], math.Float64bits(float64(value)/float64(scale)))
+	} else {
+		runTime = runTime[:slot*len(fields)]
+	}
+	rt := newBinaryDir(runTime, binary.BigEndian, GroupAppleRunTime, slot)
+	rt.Offset = lztag.dataOffset()
+	rt.Parent = dir
+	rt.ParentTag = idAppleRunTime
+	return []*Dir{rt}, nil
+}
@@ -0,0 +1,254 @@
+package exif
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"math"
+	"time"
+	"unicode/utf16"
+)
+
+// bplistHeader begins binary property lists, which Apple maker notes use for some values.
+const bplistHeader = "bplist00"
+
+// bplistMaxDepth limits the nesting of containers of a binary property list.
+const bplistMaxDepth = 32
+
+// DecodeBinaryPlist decodes the top object of a binary property list
+// ("bplist00"), as found in values of Apple maker note tags. Objects are
+// decoded as the following types:
+//
+//   - null: nil
+//   - boolean: bool
+//   - integer: int64, or uint64 for values which do not fit in an int64
+//   - real: float64
+//   - date: time.Time
+//   - data: []byte
+//   - string: string
+//   - UID: uint64
+//   - array and set: []any
+//   - dictionary: map[string]any, keys which are not strings are formatted with fmt.
+func DecodeBinaryPlist(data []byte) (any, error) {
+	const trailerSize = 32
+	if len(data) < len(bplistHeader)+trailerSize || string(data[:len(bplistHeader)]) != bplistHeader {
+		return nil, errors.New("not a binary plist")
+	}
+	trailer := data[len(data)-trailerSize:]
+	p := bplist{
+		data:        data,
+		offsetSize:  int(trailer[6]),
+		refSize:     int(trailer[7]),
+		offsetTable: binary.BigEndian.Uint64(trailer[24:]),
+	}
+	numObjects := binary.BigEndian.Uint64(trailer[8:])
+	top := binary.BigEndian.Uint64(trailer[16:])
+	if p.offsetSize == 0 || p.offsetSize > 8 || p.refSize == 0 || p.refSize > 8 {
+		return nil, errors.New("invalid binary plist trailer")
+	}
+	tableEnd := uint64(len(data) - trailerSize)
+	if p.offsetTable > tableEnd || numObjects > (tableEnd-p.offsetTable)/uint64(p.offsetSize) {
+		return nil, errors.New("binary plist offset table out of bounds")
+	}
+	p.numObjects = numObjects
+	p.budget = len(data)
+	return p.object(top, 0)
+}
+
+type bplist struct {
+	data        []byte
+	offsetSize  int
+	refSize     int
+	offsetTable uint64
+	numObjects  uint64
+	// budget limits the number of objects decoded, which may exceed
+	// the number of objects in the plist since objects may be shared.
+	budget int
+}
+
+// uint decodes the big-endian unsigned integer of n bytes at off.
+func (p *bplist) uint(off uint64, n int) (uint64, error) {
+	if off > uint64(len(p.data)) || uint64(n) > uint64(len(p.data))-off {
+		return 0, errors.New("binary plist value out of bounds")
+	}
+	var v uint64
+	for _, b := range p.data[off : off+uint64(n)] {
+		v = v<<8 | uint64(b)
+	}
+	return v, nil
+}
+
+// bytes returns the n bytes at off.
+func (p *bplist) bytes(off, n uint64) ([]byte, error) {
+	if off > uint64(len(p.data)) || n > uint64(len(p.data))-off {
+		return nil, errors.New("binary plist value out of bounds")
+	}
+	return p.data[off : off+n], nil
+}
+
+// object decodes the object with index ref of the offset table. depth is
+// the number of containers the object is nested in.
+func (p *bplist) object(ref uint64, depth int) (any, error) {
+	if ref >= p.numObjects {
+		return nil, errors.New("binary plist object reference out of bounds")
+	}
+	if depth > bplistMaxDepth {
+		return nil, errors.New("binary plist nested too deeply")
+	}
+	p.budget--
+	if p.budget < 0 {
+		return nil, errors.New("binary plist contains too many objects")
+	}
+	off, err := p.uint(p.offsetTable+ref*uint64(p.offsetSize), p.offsetSize)
+	if err != nil {
+		return nil, err
+	}
+	marker, err := p.uint(off, 1)
+	if err != nil {
+		return nil, err
+	}
+	off++
+	kind, info := marker>>4, marker&0xf
+	switch kind {
+	case 0x0:
+		switch info {
+		case 0x0:
+			return nil, nil
+		case 0x8:
+			return false, nil
+		case 0x9:
+			return true, nil
+		}
+	case 0x1:
+		switch info {
+		case 0, 1, 2:
+			// Integers of up to 4 bytes are unsigned.
+			v, err := p.uint(off, 1<<info)
+			return int64(v), err
+		case 3:
+			v, err := p.uint(off, 8)
+			return int64(v), err
+		case 4:
+			// 128 bit integers hold unsigned 64 bit values in their low half.
+			v, err := p.uint(off+8, 8)
+			if v > math.MaxInt64 {
+				return v, err
+			}
+			return int64(v), err
+		}
+	case 0x2:
+		switch info {
+		case 2:
+			v, err := p.uint(off, 4)
+			return float64(math.Float32frombits(uint32(v))), err
+		case 3:
+			v, err := p.uint(off, 8)
+			return math.Float64frombits(v), err
+		}
+	case 0x3:
+		if info != 3 {
+			break
+		}
+		v, err := p.uint(off, 8)
+		if err != nil {
+			return nil, err
+		}
+		// Dates are seconds since 2001-01-01 UTC.
+		secs := math.Float64frombits(v)
+		epoch := time.Date(2001, time.January, 1, 0, 0, 0, 0, time.UTC)
+		return epoch.Add(time.Duration(secs * float64(time.Second))), nil
+	case 0x4, 0x5, 0x6:
+		n, off, err := p.length(info, off)
+		if err != nil {
+			return nil, err
+		}
+		if kind == 0x6 {
+			b, err := p.bytes(off, 2*n)
+			if err != nil {
+				return nil, err
+			}
+			u := make([]uint16, n)
+			for i := range u {
+				u[i] = binary.BigEndian.Uint16(b[2*i:])
+			}
+			return string(utf16.Decode(u)), nil
+		}
+		b, err := p.bytes(off, n)
+		if err != nil {
+			return nil, err
+		}
+		if kind == 0x5 {
+			return string(b), nil
+		}
+		return append([]byte{}, b...), nil
+	case 0x8:
+		return p.uint(off, int(info)+1)
+	case 0xa, 0xc:
+		n, off, err := p.length(info, off)
+		if err != nil {
+			return nil, err
+		}
+		if _, err := p.bytes(off, n*uint64(p.refSize)); err != nil {
+			return nil, err
+		}
+		v := make([]any, n)
+		for i := range v {
+			elem, _ := p.uint(off+uint64(i*p.refSize), p.refSize)
+			v[i], err = p.object(elem, depth+1)
+			if err != nil {
+				return nil, err
+			}
+		}
+		return v, nil
+	case 0xd:
+		n, off, err := p.length(info, off)
+		if err != nil {
+			return nil, err
+		}
+		if _, err := p.bytes(off, 2*n*uint64(p.refSize)); err != nil {
+			return nil, err
+		}
+		v := make(map[string]any, n)
+		for i := uint64(0); i < n; i++ {
+			keyRef, _ := p.uint(off+i*uint64(p.refSize), p.refSize)
+			valRef, _ := p.uint(off+(n+i)*uint64(p.refSize), p.refSize)
+			key, err := p.object(keyRef, depth+1)
+			if err != nil {
+				return nil, err
+			}
+			val, err := p.object(valRef, depth+1)
+			if err != nil {
+				return nil, err
+			}
+			s, ok := key.(string)
+			if !ok {
+				s = fmt.Sprint(key)
+			}
+			v[s] = val
+		}
+		return v, nil
+	}
+	return nil, fmt.Errorf("unsupported binary plist object marker %#02x", marker)
+}
+
+// length returns the number of elements of a data, string or container object whose
+// marker has the low nibble info, followed by the offset of the object's content.
+// An info of 0xf indicates the length is stored in an integer object after the marker.
+func (p *bplist) length(info, off uint64) (n, content uint64, err error) {
+	if info != 0xf {
+		return info, off, nil
+	}
+	marker, err := p.uint(off, 1)
+	if err != nil {
+		return 0, 0, err
+	}
+	if marker>>4 != 0x1 || marker&0xf > 3 {
+		return 0, 0, errors.New("invalid binary plist object length")
+	}
+	size := 1 << (marker & 0xf)
+	n, err = p.uint(off+1, size)
+	if err == nil && n > uint64(len(p.data)) {
+		err = errors.New("binary plist object length out of bounds")
+	}
+	return n, off + 1 + uint64(size), err
+}
@@ -0,0 +1,57 @@
+package exif
+
+import (
+	"encoding/binary"
+	"reflect"
+	"testing"
+)
+
+// testBinaryPlist returns a binary plist of the encoded objects, whose
+// references are their indices. The first object is the top object.
+func testBinaryPlist(objects ...[]byte) []byte {
+	b := []byte(bplistHeader)
+	offsets := make([]byte, len(objects))
+	for i, obj := range objects {
+		offsets[i] = byte(len(b))
+		b = append(b, obj...)
+	}
+	offsetTable := len(b)
+	b = append(b, offsets...)
+	trailer := make([]byte, 32)
+	trailer[6], trailer[7] = 1, 1 // Offset and reference sizes.
+	binary.BigEndian.PutUint64(trailer[8:], uint64(len(objects)))
+	binary.BigEndian.PutUint64(trailer[24:], uint64(offsetTable))
+	return append(b, trailer...)
+}
+
+func TestDecodeBinaryPlist(t *testing.T) {
+	plist := testBinaryPlist(
+		[]byte{0xd3, 1, 2, 3, 4, 5, 6}, // Dictionary of 3 entries.
+		append([]byte{0x55}, "flags"...),
+		append([]byte{0x55}, "value"...),
+		append([]byte{0x54}, "list"...),
+		[]byte{0x10, 1},
+		[]byte{0x13, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xfe},
+		[]byte{0xa3, 7, 8, 9}, // Array of 3 elements.
+		[]byte{0x09},
+		[]byte{0x23, 0x3f, 0xf8, 0, 0, 0, 0, 0, 0},
+		[]byte{0x62, 0, 'o', 0, 'k'},
+	)
+	got, err := DecodeBinaryPlist(plist)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := map[string]any{
+		"flags": int64(1),
+		"value": int64(-2),
+		"list":  []any{true, 1.5, "ok"},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("got %#v, want %#v", got, want)
+	}
+	// Self referencing arrays must not recurse indefinitely.
+	_, err = DecodeBinaryPlist(testBinaryPlist([]byte{0xa1, 0}))
+	if err == nil {
+		t.Error("expected error decoding self referencing plist")
+	}
+}
@@ -0,0 +1,58 @@
+package exif
+
+import (
+	"encoding/binary"
+	"fmt"
+	"io"
+	"strings"
+)
+
+// IDs of Canon maker note tags containing binary arrays decoded as directories.
+const (
+	idCanonCameraSettings ID = 0x0001
+	idCanonFileInfo       ID = 0x0093
+	// idCanonShutterCount is the index of the ShutterCount in the CanonFileInfo array.
+	idCanonShutterCount ID = 0x0001
+)
+
+func matchCanon(make string, header []byte) bool {
+	return strings.HasPrefix(make, "Canon")
+}
+
+// canonIFD returns the location of the Canon maker note IFD, which begins the
+// maker note without a header. Value offsets are relative to the TIFF header.
+func canonIFD(header []byte, offset int64, order binary.ByteOrder) (int64, int64, binary.ByteOrder, error) {
+	return offset, 0, order, nil
+}
+
+// expandCanon decodes the CanonCameraSettings and CanonFileInfo arrays, which contain
+// 16 bit entries. The ShutterCount entry of the CanonFileInfo array is only kept for
+// EOS-1D models, where it is stored as a 32 bit value with its 16 bit halves swapped.
+func expandCanon(lt *LazyDecoder, r io.ReaderAt, dir *Dir, model string) ([]*Dir, error) {
+	var dirs []*Dir
+	settings, err := lt.decodeBinaryDir(r, dir, idCanonCameraSettings, GroupCanonCameraSettings, 2)
+	if err != nil {
+		return nil, fmt.Errorf("reading CanonCameraSettings: %w", err)
+	}
+	if settings != nil {
+		dirs = append(dirs, settings)
+	}
+	info, err := lt.decodeBinaryDir(r, dir, idCanonFileInfo, GroupCanonFileInfo, 2)
+	if err != nil {
+		return nil, fmt.Errorf("reading CanonFileInfo: %w", err)
+	}
+	if info != nil {
+		if strings.Contains(model, "EOS-1D") {
+			for i := range info.tags {
+				if info.tags[i].ID == idCanonShutterCount {
+					v := &info.tags[i].value
+					v[0], v[1], v[2], v[3] = v[2], v[3], v[0], v[1]
+				}
+			}
+		} else {
+			info.removeTag(idCanonShutterCount)
+		}
+		dirs = append(dirs, info)
+	}
+	return dirs, nil
+}
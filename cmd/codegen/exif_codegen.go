@@ -5,6 +5,7 @@ import (
 	"bytes"
 	_ "embed"
 	"fmt"
+	"go/format"
 	"log"
 	"os"
 	"sort"
@@ -21,53 +22,66 @@ import (
 //go:embed exif.txt
 var txt []byte
 
+// GPS tags, found in the IFD pointed to by GPSInfo. Same format as exif.txt.
+//
+//go:embed gps.txt
+var gpstxt []byte
+
+// Maker note tags. Same format as exif.txt where the Group field names the
+// group of the maker note directory, i.e. Canon for exif.GroupCanon.
+//
+//go:embed canon.txt
+var canontxt []byte
+
+//go:embed nikon.txt
+var nikontxt []byte
+
+//go:embed sony.txt
+var sonytxt []byte
+
+//go:embed fujifilm.txt
+var fujifilmtxt []byte
+
+//go:embed olympus.txt
+var olympustxt []byte
+
+//go:embed panasonic.txt
+var panasonictxt []byte
+
+//go:embed pentax.txt
+var pentaxtxt []byte
+
+//go:embed apple.txt
+var appletxt []byte
+
+//go:embed samsung.txt
+var samsungtxt []byte
+
+//go:embed google.txt
+var googletxt []byte
+
 func main() {
 	startProgram := time.Now()
-	fp, _ := os.Create("tagdefinitions.go")
-	defer fp.Close()
-	// fp, _ = os.Open(os.DevNull)
-	scn := bufio.NewScanner(bytes.NewReader(txt))
-	var tags []TagPreproces
-	var currentType TagPreproces
-	for scn.Scan() {
-		line := scn.Text()
-		if len(line) < 6 {
-			log.Println("skipping line", line)
-			continue
-		}
-		fields := strings.Split(line, "\t")
-		if len(fields) < 2 {
-			continue
-		}
-		if line[0] == '\t' {
-			currentType.Values = append(currentType.Values, strings.TrimSpace(line))
-			continue
-		}
-		if line[0:2] == "0x" {
-			v, err := strconv.ParseUint(fields[0][2:], 16, 16)
-			if err != nil {
-				continue
-			}
-			tags = append(tags, currentType)
-			currentType = TagPreproces{}
-			currentType.Writable = fields[2]
-			currentType.Group = fields[3]
-			currentType.Tagname = fields[1]
-			currentType.ID = uint16(v)
-			if len(fields) > 4 {
-				currentType.Values = append(currentType.Values, fields[4])
-			}
-			continue
-		}
-
-	}
-	tags = tags[1:] // first type is empty
+	// Generated code is buffered for formatting since map keys are of varying length.
+	fp := new(bytes.Buffer)
+	tags := parseTable(txt)
+	tags = append(tags, parseTable(gpstxt)...)
+	tags = append(tags, parseMakerNoteTable(canontxt, "Canon")...)
+	tags = append(tags, parseMakerNoteTable(nikontxt, "Nikon")...)
+	tags = append(tags, parseMakerNoteTable(sonytxt, "Sony")...)
+	tags = append(tags, parseMakerNoteTable(fujifilmtxt, "Fujifilm")...)
+	tags = append(tags, parseMakerNoteTable(olympustxt, "Olympus")...)
+	tags = append(tags, parseMakerNoteTable(panasonictxt, "Panasonic")...)
+	tags = append(tags, parseMakerNoteTable(pentaxtxt, "Pentax")...)
+	tags = append(tags, parseMakerNoteTable(appletxt, "Apple")...)
+	tags = append(tags, parseMakerNoteTable(samsungtxt, "Samsung")...)
+	tags = append(tags, parseMakerNoteTable(googletxt, "Google")...)
 	fmt.Fprint(fp, `// Code generated by "cmd/codegen"; DO NOT EDIT
 // See github.com/soypat/exif
 
 package exif
 
-var tags = map[uint16]tagdef{
+var tags = map[tagkey]tagdef{
 `)
 	for i := range tags {
 		tag := tags[i]
@@ -82,30 +96,94 @@ var tags = map[uint16]tagdef{
 			grp = exif.GroupInteropIFD
 		case "SubIFD":
 			grp = exif.GroupSubIFD
+		case "GPS":
+			grp = exif.GroupGPS
 		default:
 			grp = exif.GroupNone
 		}
+		// Tags are keyed by the group of their tag table since IDs of
+		// different tables collide, i.e: GPS IDs and InteropIndex.
+		table := "GroupNone"
+		switch {
+		case tag.MakerNote:
+			table = "Group" + tag.Group
+		case grp == exif.GroupGPS:
+			table = "GroupGPS"
+		}
+		if tag.MakerNote && (i == 0 || tags[i-1].Group != tag.Group) {
+			// Separate tables so map keys of different length are aligned separately.
+			fmt.Fprintf(fp, "\n\t// %s maker note tags.\n", tag.Group)
+		}
 		enums, strings := parseEnums(tag)
 
-		str := fmt.Sprintf("\t%0#4x: {Name: %q, Type: %d, flags: %x, arrayLen: [2]int{%d, %d}",
-			tag.ID, tag.Tagname, tp, flag, arraylen[0], arraylen[1])
+		str := fmt.Sprintf("\t{%s, %0#4x}: {Name: %q, Type: %d, flags: %x, arrayLen: [2]int{%d, %d}",
+			table, tag.ID, tag.Tagname, tp, flag, arraylen[0], arraylen[1])
 		fmt.Fprint(fp, str)
 		fmt.Fprintf(fp, ", ID: %0#4x", tag.ID)
 		if len(enums) != 0 && len(enums) == len(strings) {
 			fmt.Fprintf(fp, ", enum: %#v, enumString: %#v", enums, strings)
 		}
 		fp.WriteString("},\n")
-		_ = grp
 
 		// fmt.Fprintf(fp, "\t%+v %d %d\n", tag.Writable, tp, flag)
 	}
 	fmt.Fprint(fp, "}\n")
+	src, err := format.Source(fp.Bytes())
+	if err != nil {
+		log.Fatal(err)
+	}
+	err = os.WriteFile("tagdefinitions.go", src, 0666)
+	if err != nil {
+		log.Fatal(err)
+	}
 	genExifid(tags)
 	fmt.Println(time.Since(startProgram)) // so that generate runs.
 	// Output:
 	// None.
 }
 
+// parseTable parses an ExifTool-like tag table. Each tag starts on a line
+// with the tab separated fields: Tag ID, Tag Name, Writable, Group, Values / Notes.
+// Lines starting with a tab are additional values of the preceding tag.
+func parseTable(txt []byte) (tags []TagPreproces) {
+	scn := bufio.NewScanner(bytes.NewReader(txt))
+	var currentType TagPreproces
+	for scn.Scan() {
+		line := scn.Text()
+		if len(line) < 6 {
+			log.Println("skipping line", line)
+			continue
+		}
+		fields := strings.Split(line, "\t")
+		if len(fields) < 2 {
+			continue
+		}
+		if line[0] == '\t' {
+			currentType.Values = append(currentType.Values, strings.TrimSpace(line))
+			continue
+		}
+		if line[0:2] == "0x" {
+			v, err := strconv.ParseUint(fields[0][2:], 16, 16)
+			if err != nil {
+				continue
+			}
+			tags = append(tags, currentType)
+			currentType = TagPreproces{}
+			currentType.Writable = fields[2]
+			currentType.Group = fields[3]
+			currentType.Tagname = fields[1]
+			currentType.ID = uint16(v)
+			if len(fields) > 4 {
+				currentType.Values = append(currentType.Values, fields[4])
+			}
+			continue
+		}
+
+	}
+	tags = append(tags, currentType)
+	return tags[1:] // first type is empty
+}
+
 func parseEnums(tag TagPreproces) (vals []int64, res []string) {
 	for _, val := range tag.Values {
 		before, after, ok := strings.Cut(val, "=")
@@ -177,11 +255,16 @@ func parseType(s string) (tp exif.Type, flags uint8, arrayLen [2]int) {
 	case "undef":
 		tp = exif.TypeUndefined
 	case "int16":
-		tp = exif.TypeUint16
+		tp = exif.TypeUint16 + signedAdd
 	case "int32":
 		tp = exif.TypeUint32 + signedAdd
 	case "int8":
 		tp = exif.TypeUint8 + signedAdd
+	case "int64":
+		tp = exif.TypeUint64
+		if isSigned {
+			tp = exif.TypeInt64
+		}
 	case "rational64":
 		tp = exif.TypeURational64 + signedAdd
 	case "double":
@@ -204,6 +287,30 @@ type TagPreproces struct {
 	Writable string
 	Group    string
 	Values   []string
+	// Vendor is set for tags of a maker note table, whose Group names the table.
+	Vendor string
+	// MakerNote is set for tags of a maker note table.
+	MakerNote bool
+}
+
+// parseMakerNoteTable parses the maker note tag tables of a camera vendor.
+func parseMakerNoteTable(txt []byte, vendor string) []TagPreproces {
+	tags := parseTable(txt)
+	for i := range tags {
+		tags[i].Vendor = vendor
+		tags[i].MakerNote = true
+	}
+	return tags
+}
+
+// constName returns the name of the tag's exifid constant. Names of maker note
+// tags are prefixed by their group since they collide with EXIF tags, i.e.
+// CanonSerialNumber, and the vendor name is trimmed from the tag's name.
+func (tag TagPreproces) constName() string {
+	if tag.MakerNote {
+		return tag.Group + strings.TrimPrefix(tag.Tagname, tag.Vendor)
+	}
+	return tag.Tagname
 }
 
 func genExifid(tags tagPs) {
@@ -223,8 +330,9 @@ import "github.com/soypat/exif"
 // All Exif field/tag IDs.
 const (
 `)
-	// Sort for consistent results.
-	sort.Sort(tags)
+	// Sort for consistent results. Stable sort keeps EXIF table
+	// entries before GPS entries of same ID.
+	sort.Stable(tags)
 	var tagslice tagPs
 
 	for _, tag := range tags {
@@ -235,31 +343,55 @@ const (
 
 	// Delete duplicated entries
 	written := make(map[string]struct{})
-	maxLen := 0
 	var uniqTagSlice tagPs
 	for _, tag := range tagslice {
-		_, ok := written[tag.Tagname]
+		name := tag.constName()
+		_, ok := written[name]
 		if !ok {
 			uniqTagSlice = append(uniqTagSlice, tag)
-			written[tag.Tagname] = struct{}{}
-			if len(tag.Tagname) > maxLen {
-				maxLen = len(tag.Tagname)
+			written[name] = struct{}{}
+		}
+	}
+	// Maker note tags, which are sorted last, are written in a
+	// block per table following the EXIF tag block.
+	start := 0
+	for i := range uniqTagSlice {
+		if i == len(uniqTagSlice)-1 || uniqTagSlice[i+1].Group != uniqTagSlice[start].Group && uniqTagSlice[i+1].MakerNote {
+			if uniqTagSlice[start].MakerNote {
+				fmt.Fprintf(fp, "\n// %s maker note tag IDs.\nconst (\n", uniqTagSlice[start].Group)
 			}
+			writeConsts(fp, uniqTagSlice[start:i+1])
+			fp.WriteString(")\n")
+			start = i + 1
+		}
+	}
+}
+
+// writeConsts writes the constant declarations of the tags aligned by name.
+func writeConsts(fp *os.File, tags tagPs) {
+	maxLen := 0
+	for _, tag := range tags {
+		if len(tag.constName()) > maxLen {
+			maxLen = len(tag.constName())
 		}
 	}
 	fmtString := "\t%-" + strconv.Itoa(maxLen) + "s exif.ID = %0#4x\n"
-	for _, tag := range uniqTagSlice {
-		fmt.Fprintf(fp, fmtString, tag.Tagname, uint16(tag.ID))
-		written[tag.Tagname] = struct{}{}
+	for _, tag := range tags {
+		fmt.Fprintf(fp, fmtString, tag.constName(), uint16(tag.ID))
 	}
-	fp.WriteString(")\n")
 }
 
 type tagPs []TagPreproces
 
-func (a tagPs) Len() int           { return len(a) }
-func (a tagPs) Swap(i, j int)      { a[i], a[j] = a[j], a[i] }
-func (a tagPs) Less(i, j int) bool { return a[i].ID < a[j].ID }
+func (a tagPs) Len() int      { return len(a) }
+func (a tagPs) Swap(i, j int) { a[i], a[j] = a[j], a[i] }
+func (a tagPs) Less(i, j int) bool {
+	if a[i].MakerNote || a[j].MakerNote {
+		// Maker note tags follow the EXIF tags in the order of their tables.
+		return !a[i].MakerNote && a[j].MakerNote
+	}
+	return a[i].ID < a[j].ID
+}
 
 //go:linkname newflags github.com/soypat/exif.newflags
 func newflags(unsafe, protected, avoid, writeConstrained, mandatory bool) uint8
@@ -1,20 +1,38 @@
 package exif
 
 import (
+	"bytes"
 	"encoding/binary"
 	"errors"
 	"fmt"
 	"io"
+	"io/fs"
+	"math"
 	"strconv"
-	"unsafe"
 )
 
 type LazyDecoder struct {
-	dirs       []lazydir
+	// dirs are the decoded directories in the order they were decoded.
+	dirs       []*Dir
 	order      binary.ByteOrder
 	baseOffset int64
-	app1Size   [2]byte
-	buf        [8]byte
+	app1End    int64
+	bigTIFF    bool
+	// data holds EXIF data which is not stored contiguously in the file, such as data
+	// decoded from a PNG raw profile text chunk. It is read instead of the reader passed to the decoder.
+	data []byte
+	buf  [8]byte
+}
+
+// reader returns a reader of the EXIF data decoded from r.
+func (lt *LazyDecoder) reader(r io.ReaderAt) io.ReaderAt {
+	switch {
+	case lt.data != nil:
+		return bytes.NewReader(lt.data)
+	case r == nil:
+		return nil
+	}
+	return newOffsetReaderAt(r, lt.baseOffset, nil)
 }
 
 // MakeIFDs processes the collected tags in the LazyDecoder (obtained from a previous call to Decode)
@@ -22,30 +40,32 @@ type LazyDecoder struct {
 // If a nil reader is passed into MakeIFDs then only the tags which have a
 // lazy in-memory representation will be returned.
 // The callback passed in to MakeIFDs will decide if a tag is made or skipped depending
-// on whether the call returns true or false. The callback has the ifd level, size in bytes
-// and the tag's ID to decide whether to create the tag and allocate memory for it.
+// on whether the call returns true or false. The callback has the ifd level, which is the
+// index of the directory in [LazyDecoder.Dirs], size in bytes and the tag's ID to decide
+// whether to create the tag and allocate memory for it. IFDs are returned in the same order as Dirs.
 func (lt *LazyDecoder) MakeIFDs(r io.ReaderAt, fn func(ifd, size int, id ID) bool) ([]IFD, error) {
 	if fn == nil {
 		return nil, errors.New("nil callback")
 	}
-	if r != nil {
-		r = newOffsetReaderAt(r, lt.baseOffset, nil)
-	}
+	r = lt.reader(r)
 	var ifds []IFD
 	for ifd, dir := range lt.dirs {
-		tags := make([]Tag, 0, len(dir.Tags))
-		for i, lztag := range dir.Tags {
+		tags := make([]Tag, 0, len(dir.tags))
+		for _, lztag := range dir.tags {
 			sz := lztag.size()
-			if r == nil && lztag.dataOffset() != 0 {
+			if r == nil && dir.data == nil && lztag.dataOffset() != 0 {
 				continue // Nil reader means no way to read from file.
 			}
+			if lztag.dataOffset() < 0 {
+				continue // Unreadable value.
+			}
 			if !fn(ifd, sz, lztag.ID) {
 				continue // User decides to skip tag.
 			}
-			tag, err := lt.getTag(r, lztag)
+			tag, err := lt.getTag(r, lztag, dir)
 			if err != nil {
 				// Return correctly generated tags up to the point of failure.
-				return append(ifds, IFD{Tags: tags[:i], Group: dir.Group}), err
+				return append(ifds, IFD{Tags: tags, Group: dir.Group}), err
 			}
 			tags = append(tags, tag)
 		}
@@ -54,65 +74,198 @@ func (lt *LazyDecoder) MakeIFDs(r io.ReaderAt, fn func(ifd, size int, id ID) boo
 	return ifds, nil
 }
 
-func (lt *LazyDecoder) getTag(r io.ReaderAt, lztag lazytag) (tag Tag, err error) {
-	if dataOffset := lztag.dataOffset(); dataOffset != 0 {
-		// 8-byte values or variable length value are stored at an offset position.
-		var data []byte
-		if lztag.length == 8 && lztag.Type != TypeUndefined {
-			data = lt.buf[:8]
-		} else {
-			data = make([]byte, lztag.length)
-		}
+func (lt *LazyDecoder) getTag(r io.ReaderAt, lztag lazytag, dir *Dir) (tag Tag, err error) {
+	data, err := lt.tagData(r, lztag, dir)
+	if err != nil {
+		return Tag{}, err
+	}
+	var v any
+	if len(data) == 0 {
+		v, err = emptyTypeData(lztag.Type)
+	} else {
+		v, err = DecodeTypeData(lztag.Type, dir.order, data)
+	}
+	if err != nil {
+		return Tag{}, err
+	}
+	return Tag{ID: lztag.ID, Group: dir.Group, data: v}, nil
+}
 
-		n, err := r.ReadAt(data, int64(dataOffset))
-		if err != nil {
-			return Tag{}, fmt.Errorf("reading %d/%d exif data at %#x: %w", n, lztag.length, dataOffset, err)
-		}
-		if n != int(lztag.length) {
-			return Tag{}, errors.New("incomplete read")
+// emptyTypeData returns the value of a tag of Type tp with a count of zero,
+// which TIFF permits.
+func emptyTypeData(tp Type) (v any, err error) {
+	switch tp {
+	case TypeString:
+		return "", nil
+	case TypeUndefined:
+		return []byte{}, nil
+	}
+	return decodeTypeSlice(tp, nil, nil, 0)
+}
+
+// tagData returns the undecoded value of a tag of dir, which is empty for tags
+// with a count of zero. The returned slice may reference the decoder's buffer
+// for 8-byte values which are not of undefined type.
+func (lt *LazyDecoder) tagData(r io.ReaderAt, lztag lazytag, dir *Dir) ([]byte, error) {
+	dataOffset := lztag.dataOffset()
+	if dataOffset < 0 {
+		return nil, fmt.Errorf("tag %s has invalid data offset", lztag.ID.StringGroup(dir.Group))
+	}
+	if dataOffset == 0 {
+		// Values of up to 4 bytes in length (8 for BigTIFF), stored in place.
+		data := lztag.value[:lztag.length]
+		if lztag.Type == TypeUndefined {
+			// Undefined data is not copied by DecodeTypeData.
+			data = append([]byte{}, data...)
 		}
-		v, err := DecodeTypeData(lztag.ID.Type(), lt.order, data)
-		if err != nil {
-			return Tag{}, err
+		return data, nil
+	}
+	if dir.data != nil {
+		// Directory decoded from a binary array in memory.
+		if dataOffset+int64(lztag.length) > int64(len(dir.data)) {
+			return nil, fmt.Errorf("tag %s value out of bounds", lztag.ID.StringGroup(dir.Group))
 		}
-		tag = Tag{ID: lztag.ID, data: v}
-
+		return append([]byte{}, dir.data[dataOffset:dataOffset+int64(lztag.length)]...), nil
+	}
+	// 8-byte values or variable length value are stored at an offset position.
+	if size := readerSize(r); size >= 0 && dataOffset+int64(lztag.length) > size {
+		return nil, fmt.Errorf("tag %s value out of bounds", lztag.ID.StringGroup(dir.Group))
+	}
+	var data []byte
+	if lztag.length == 8 && lztag.Type != TypeUndefined {
+		data = lt.buf[:8]
 	} else {
-		// 1, 2 or 4 byte length values, stored in place.
-		sz := lztag.Type.Size()
-		v, err := DecodeTypeData(lztag.Type, lt.order, lztag.arrayptr()[:sz])
-		if err != nil {
-			return Tag{}, err
-		}
-		tag = Tag{ID: lztag.ID, data: v}
+		data = make([]byte, lztag.length)
+	}
+	n, err := r.ReadAt(data, dataOffset)
+	if err != nil {
+		return nil, fmt.Errorf("reading %d/%d exif data at %#x: %w", n, lztag.length, dataOffset, err)
 	}
-	return tag, nil
+	if n != int(lztag.length) {
+		return nil, errors.New("incomplete read")
+	}
+	return data, nil
 }
 
-func (lt *LazyDecoder) GetTag(r io.ReaderAt, ifdLevel int, id ID) (_ Tag, err error) {
-	switch {
-	case len(lt.dirs) == 0:
-		err = errors.New("decoder empty: did decoding succeed?")
-	case ifdLevel > len(lt.dirs):
-		err = errors.New("IFD level exceeds available levels")
+// GetTag reads the tag with the given ID from the first directory of group g.
+// See [LazyDecoder.Dir].
+func (lt *LazyDecoder) GetTag(r io.ReaderAt, g Group, id ID) (Tag, error) {
+	if len(lt.dirs) == 0 {
+		return Tag{}, errors.New("decoder empty: did decoding succeed?")
 	}
-	if err != nil {
-		return Tag{}, err
+	dir := lt.Dir(g)
+	if dir == nil {
+		return Tag{}, errors.New("IFD group " + g.String() + " not found")
 	}
-	ifdTags := lt.dirs[ifdLevel].Tags
-	for _, lztag := range ifdTags {
+	return lt.GetDirTag(r, dir, id)
+}
+
+// GetDirTag reads the tag with the given ID from the directory dir,
+// which must have been obtained from the decoder.
+func (lt *LazyDecoder) GetDirTag(r io.ReaderAt, dir *Dir, id ID) (Tag, error) {
+	for _, lztag := range dir.tags {
 		if lztag.ID == id {
-			if r == nil && lztag.dataOffset() != 0 {
-				return Tag{}, errors.New("need non-nil reader to read tag " + id.String())
+			r = lt.reader(r)
+			if r == nil && dir.data == nil && lztag.dataOffset() != 0 {
+				return Tag{}, errors.New("need non-nil reader to read tag " + id.StringGroup(dir.Group))
 			}
-			return lt.getTag(r, lztag)
+			return lt.getTag(r, lztag, dir)
 		}
 	}
 	return Tag{}, errors.New("tag ID not found in IFD")
 }
 
+// maxThumbnailSize limits the size of thumbnails read into memory.
+const maxThumbnailSize = 16 << 20
+
+// Thumbnail reads the JPEG thumbnail referenced by the ThumbnailOffset and
+// ThumbnailLength tags of IFD1. It returns nil if IFD1 has no JPEG thumbnail.
+// The thumbnail may be set as [IFD.Thumbnail] of IFD1 to be written by [Marshal].
+func (lt *LazyDecoder) Thumbnail(r io.ReaderAt) ([]byte, error) {
+	dir := lt.Dir(GroupIFD1)
+	if dir == nil {
+		return nil, nil
+	}
+	offsetTag, ok := dir.tag(idThumbnailOffset)
+	lengthTag, ok2 := dir.tag(idThumbnailLength)
+	if !ok || !ok2 {
+		return nil, nil
+	}
+	r = lt.reader(r)
+	if r == nil {
+		return nil, errors.New("need non-nil reader to read thumbnail")
+	}
+	tag, err := lt.getTag(r, offsetTag, dir)
+	if err != nil {
+		return nil, err
+	}
+	offset, err := tag.Int()
+	if err != nil {
+		return nil, err
+	}
+	tag, err = lt.getTag(r, lengthTag, dir)
+	if err != nil {
+		return nil, err
+	}
+	length, err := tag.Int()
+	if err != nil {
+		return nil, err
+	}
+	if offset <= 0 || length <= 0 || length > maxThumbnailSize {
+		return nil, fmt.Errorf("invalid thumbnail of length %d at offset %d", length, offset)
+	}
+	data := make([]byte, length)
+	n, err := r.ReadAt(data, offset)
+	if n < len(data) {
+		return nil, fmt.Errorf("reading %d/%d thumbnail data at %#x: %w", n, length, offset, err)
+	}
+	return data, nil
+}
+
+// Root returns IFD0, the root of the directory tree, or nil if decoding failed.
+// IFD1 and the directories chained after it, such as the pages of multi-page
+// TIFF files, are reached by following Next. Directories after IFD1 have GroupNone.
+func (lt *LazyDecoder) Root() *Dir {
+	if len(lt.dirs) == 0 {
+		return nil
+	}
+	return lt.dirs[0]
+}
+
+// Dirs returns all decoded directories in the order they were decoded. The IFD0
+// chain comes first, followed by the ExifIFD chain, InteropIFD, GPS, SubIFDs
+// and the maker note directories.
+func (lt *LazyDecoder) Dirs() []*Dir {
+	return lt.dirs
+}
+
+// Dir returns the first decoded directory of group g, or nil if there is none.
+// SubIFD groups may be found in several places of the tree, in which case
+// the SubIFD closest to IFD0 is returned.
+func (lt *LazyDecoder) Dir(g Group) *Dir {
+	for _, dir := range lt.dirs {
+		if dir.Group == g {
+			return dir
+		}
+	}
+	return nil
+}
+
 // Decode marshals exif data in r lazily. It only stores values that have a
 // constrained in-memory representation.
+//
+// r may contain TIFF formatted EXIF data, a JPEG image, in which case the
+// image's marker segments are walked to find the APP1 segment containing EXIF data,
+// a PNG image, see [FindPNGExif], a WebP image, see [FindWebPExif], a HEIF image
+// such as HEIC or AVIF, see [FindHEIFExif], or a JPEG XL container, see [FindJXLExif].
+// EXIF data decoded from a legacy PNG text chunk, split across HEIF extents or Brotli
+// compressed in a JPEG XL container is retained by the decoder, in which case the reader
+// passed to MakeIFDs and GetTag is not used.
+//
+// Maker notes of known formats, such as Canon's, are decoded as children of the
+// ExifIFD with a group of their own, i.e. GroupCanon. Binary arrays of maker notes
+// are decoded as children of the maker note directory. Other formats may be decoded
+// by registering a [MakerNoteDecoder]. Maker notes which fail to decode are skipped.
 func (lt *LazyDecoder) Decode(r io.ReaderAt) (err error) {
 	*lt = LazyDecoder{}
 	var buf [8]byte
@@ -123,13 +276,53 @@ func (lt *LazyDecoder) Decode(r io.ReaderAt) (err error) {
 	if n != len(buf) {
 		return errors.New("wanted to read 10 starting bytes, only read " + strconv.Itoa(n))
 	}
-	start := string(buf[:2])
-	if start == "\xff\xd8" {
+	switch {
+	case string(buf[:2]) == "\xff\xd8":
 		// start of image found.
-		copy(lt.app1Size[:2], buf[4:])
-		r.ReadAt(buf[:], 12)
-		r = newOffsetReaderAt(r, 12, nil)
-		lt.baseOffset = 12
+		app1, err := findJPEGExif(r)
+		if err != nil {
+			return err
+		}
+		lt.baseOffset = app1.PayloadOffset() + int64(len(exifHeader))
+		lt.app1End = app1.End()
+		r = lt.reader(r)
+
+	case string(buf[:]) == pngSignature:
+		lt.baseOffset, _, lt.data, err = findPNGExif(r)
+		if err != nil {
+			return err
+		}
+		r = lt.reader(r)
+
+	case string(buf[:2]) == "\xff\x0a" || string(buf[4:8]) == "JXL ":
+		lt.baseOffset, _, lt.data, err = findJXLExif(r)
+		if err != nil {
+			return err
+		}
+		r = lt.reader(r)
+
+	case string(buf[4:8]) == "ftyp":
+		lt.baseOffset, _, lt.data, err = findHEIFExif(r)
+		if err != nil {
+			return err
+		}
+		r = lt.reader(r)
+
+	case string(buf[:4]) == "RIFF":
+		lt.baseOffset, _, err = findWebPExif(r)
+		if err != nil {
+			return err
+		}
+		r = lt.reader(r)
+	}
+	if lt.baseOffset != 0 || lt.data != nil {
+		n, err = r.ReadAt(buf[:], 0)
+		if err != nil {
+			return err
+		}
+		if n != len(buf) {
+			return errors.New("short read of EXIF header")
+		}
 	}
 	var order binary.ByteOrder
 	switch string(buf[:2]) {
@@ -142,156 +335,337 @@ func (lt *LazyDecoder) Decode(r io.ReaderAt) (err error) {
 	}
 	lt.order = order
 	//
-	specialMarker := order.Uint16(buf[2:])
-	if specialMarker != 42 {
+	var offset int64
+	switch specialMarker := order.Uint16(buf[2:]); specialMarker {
+	case 42:
+		// read offset to first IFD and load them.
+		offset = int64(order.Uint32(buf[4:]))
+	case 43:
+		// BigTIFF header contains the offset size, a reserved field and a 64 bit offset to the first IFD.
+		if order.Uint16(buf[4:]) != 8 || order.Uint16(buf[6:]) != 0 {
+			return errors.New("unsupported BigTIFF offset size")
+		}
+		n, err = r.ReadAt(buf[:], 8)
+		if err != nil {
+			return err
+		}
+		if n != len(buf) {
+			return errors.New("short read of BigTIFF header")
+		}
+		lt.bigTIFF = true
+		offset = int64(order.Uint64(buf[:]))
+	default:
 		return errors.New("failed to find special marker")
 	}
-	// read offset to first IFD and load them.
-	offset := int64(order.Uint32(buf[4:]))
-	if offset == 0 {
-		return errors.New("zero IFD0 offset")
+	if offset <= 0 {
+		return errors.New("invalid IFD0 offset")
 	}
-	group := GroupIFD0
-	for offset != 0 {
-		d, next, err := decodeDir(r, offset, order)
+	visited := make(map[int64]bool)
+	ifd0, err := lt.decodeChain(r, offset, GroupNone, nil, 0, visited)
+	if err != nil {
+		return err
+	}
+	ifd0.Group = GroupIFD0
+	if ifd0.Next != nil {
+		ifd0.Next.Group = GroupIFD1
+	}
+	if exifOffset := ifd0.pointer(idExifOffset); exifOffset != 0 {
+		exif, err := lt.decodeChain(r, exifOffset, GroupExifIFD, ifd0, idExifOffset, visited)
 		if err != nil {
-			return err
+			return fmt.Errorf("decoding ExifIFD: %w", err)
 		}
-		if next == offset {
-			return errors.New("recursive dir")
+		if interopOffset := exif.pointer(idInteropOffset); interopOffset != 0 {
+			_, err = lt.decodeChain(r, interopOffset, GroupInteropIFD, exif, idInteropOffset, visited)
+			if err != nil {
+				return fmt.Errorf("decoding Interop IFD: %w", err)
+			}
 		}
-		offset = next
-		if group < GroupSubIFD {
-			d.Group = group
-			group++
+	}
+	if gpsOffset := ifd0.pointer(idGPSInfo); gpsOffset != 0 {
+		_, err = lt.decodeChain(r, gpsOffset, GroupGPS, ifd0, idGPSInfo, visited)
+		if err != nil {
+			return fmt.Errorf("decoding GPS IFD: %w", err)
 		}
-		lt.dirs = append(lt.dirs, d)
 	}
-	var subIFDOffset uint32
-	for _, tag := range lt.dirs[0].Tags {
-		if tag.ID == 0x8769 && tag.dataOffset() == 0 { // Check ExifOffset ID.
-			subIFDOffset = lt.order.Uint32(tag.arrayptr()[:4])
+	// Directories appended while iterating are also searched for nested SubIFDs.
+	for i := 0; i < len(lt.dirs); i++ {
+		dir := lt.dirs[i]
+		offsets, err := lt.subIFDOffsets(r, dir)
+		if err != nil {
+			return err
+		}
+		for j, offset := range offsets {
+			_, err = lt.decodeChain(r, offset, SubIFDGroup(j), dir, idSubIFDs, visited)
+			if err != nil {
+				return fmt.Errorf("decoding %s: %w", SubIFDGroup(j).String(), err)
+			}
 		}
 	}
-	if subIFDOffset == 0 {
-		return nil
+	if exif := lt.Dir(GroupExifIFD); exif != nil {
+		// Maker notes are proprietary and often corrupted by editing software,
+		// so failing to decode them does not fail decoding.
+		_ = lt.decodeMakerNote(r, ifd0, exif)
 	}
+	return nil
+}
 
-	offset = int64(subIFDOffset)
+// decodeChain decodes the directory at offset and the directories chained to it
+// by their next IFD offset, appending them to the decoder's directories. The first
+// directory of the chain is added to the children of parent, if not nil.
+// Offsets found in visited are rejected to prevent loops.
+func (lt *LazyDecoder) decodeChain(r io.ReaderAt, offset int64, group Group, parent *Dir, parentTag ID, visited map[int64]bool) (first *Dir, err error) {
+	var prev *Dir
 	for offset != 0 {
-		d, next, err := decodeDir(r, offset, order)
+		if visited[offset] {
+			return first, fmt.Errorf("IFD at offset %d referenced more than once", offset)
+		}
+		visited[offset] = true
+		d, next, err := decodeDir(r, offset, lt.order, lt.bigTIFF, 0)
 		if err != nil {
-			return err
+			return first, err
 		}
-		if next == offset {
-			return errors.New("recursive dir")
+		d.Group = group
+		d.Parent = parent
+		d.ParentTag = parentTag
+		if prev == nil {
+			first = d
+			if parent != nil {
+				parent.Children = append(parent.Children, d)
+			}
+		} else {
+			prev.Next = d
 		}
-		offset = next
-		d.Group = GroupSubIFD
 		lt.dirs = append(lt.dirs, d)
+		prev = d
+		offset = next
 	}
-	return nil
+	return first, nil
+}
+
+// subIFDOffsets returns the offsets contained in the SubIFDs tag of dir,
+// up to MaxSubIFDs offsets. It returns nil if the directory contains no SubIFDs tag.
+func (lt *LazyDecoder) subIFDOffsets(r io.ReaderAt, dir *Dir) ([]int64, error) {
+	for _, lztag := range dir.tags {
+		if lztag.ID != idSubIFDs || lztag.dataOffset() < 0 {
+			continue
+		}
+		tag, err := lt.getTag(r, lztag, dir)
+		if err != nil {
+			return nil, fmt.Errorf("reading SubIFDs tag: %w", err)
+		}
+		offsets, err := tag.Ints()
+		if err != nil {
+			return nil, fmt.Errorf("reading SubIFDs tag: %w", err)
+		}
+		if len(offsets) > MaxSubIFDs {
+			offsets = offsets[:MaxSubIFDs]
+		}
+		for _, offset := range offsets {
+			if offset <= 0 {
+				return nil, errors.New("invalid SubIFD offset")
+			}
+		}
+		return offsets, nil
+	}
+	return nil, nil
 }
 
-// EndOfApp1 returns the end of the APP1 segment with EXIF metadata.
+// EndOfApp1 returns the offset of the end of the APP1 segment with EXIF metadata.
 // This is only set when decoding images and not
 // just pure EXIF data.
 func (e *LazyDecoder) EndOfApp1() int64 {
-	return int64(e.order.Uint16(e.app1Size[:])) + 4 // App1 length does not include SOI and APP1 markers (4 bytes).
+	return e.app1End
 }
 
-type lazydir struct {
-	Tags  []lazytag
+// Dir is an image file directory (IFD) of the directory tree built by [LazyDecoder].
+// Directories are linked to the directory which points to them with a pointer tag,
+// such as ExifOffset or SubIFDs, and to the directory chained after them.
+type Dir struct {
 	Group Group
+	// Offset of the directory relative to the start of the TIFF header.
+	// For directories decoded from a binary array it is the offset of the array.
+	Offset int64
+	// Parent is the directory containing the pointer tag which points to this
+	// directory's chain. It is nil for the IFD0 chain.
+	Parent *Dir
+	// ParentTag is the ID of Parent's pointer tag, i.e. ExifOffset (0x8769), or zero if Parent is nil.
+	ParentTag ID
+	// Next is the directory pointed to by the next IFD offset of this directory, or nil.
+	Next *Dir
+	// Children are the first directories of the chains pointed
+	// to by this directory's pointer tags, in decoding order.
+	Children []*Dir
+	tags     []lazytag
+	// order is the byte order of the directory, which differs from
+	// that of the EXIF data for some maker notes.
+	order binary.ByteOrder
+	// data holds the binary array a directory was decoded from. Tag values
+	// not stored in place are at their offset in data instead of the EXIF data.
+	data []byte
+	// base is the offset the directory's value offsets are relative to,
+	// which is nonzero for maker notes with relative offsets.
+	base int64
+}
+
+// IDs returns the IDs of the directory's tags in the order they are stored.
+func (d *Dir) IDs() []ID {
+	ids := make([]ID, len(d.tags))
+	for i := range d.tags {
+		ids[i] = d.tags[i].ID
+	}
+	return ids
 }
 
-func decodeDir(r io.ReaderAt, offset int64, order binary.ByteOrder) (d lazydir, nextOffset int64, err error) {
-	var buf [32]byte
-	n, err := r.ReadAt(buf[:2], offset)
+// pointer returns the offset value of a pointer tag with the given id
+// contained in the directory, such as ExifOffset or GPSInfo.
+// It returns 0 if the tag is not found.
+func (d *Dir) pointer(id ID) int64 {
+	for _, tag := range d.tags {
+		if tag.ID != id || tag.dataOffset() != 0 {
+			continue
+		}
+		switch tag.length {
+		case 4:
+			return int64(d.order.Uint32(tag.value[:4]))
+		case 8:
+			if off := int64(d.order.Uint64(tag.value[:8])); off > 0 {
+				return off
+			}
+		}
+	}
+	return 0
+}
+
+// decodeDir decodes the IFD at offset. Offsets to tag values and the next IFD
+// are relative to base, which is zero for all IFDs but those of some maker notes.
+// The returned offsets are relative to the start of the TIFF header.
+func decodeDir(r io.ReaderAt, offset int64, order binary.ByteOrder, bigTIFF bool, base int64) (d *Dir, nextOffset int64, err error) {
+	var buf [8]byte
+	countSize, entrySize := 2, 12
+	if bigTIFF {
+		countSize, entrySize = 8, 20
+	}
+	n, err := r.ReadAt(buf[:countSize], offset)
 	if err != nil {
-		return d, 0, fmt.Errorf("while seeking offset at %d: %w", offset, err)
+		return nil, 0, fmt.Errorf("while seeking offset at %d: %w", offset, err)
 	}
-	if n != 2 {
-		return d, 0, errors.New("expected read 2 bytes at offset, got " + strconv.Itoa(n))
+	if n != countSize {
+		return nil, 0, errors.New("expected read " + strconv.Itoa(countSize) + " bytes at offset, got " + strconv.Itoa(n))
+	}
+	var nTags uint64
+	if bigTIFF {
+		nTags = order.Uint64(buf[:8])
+		if nTags > math.MaxUint16 {
+			return nil, 0, fmt.Errorf("BigTIFF IFD at %d has too many entries (%d)", offset, nTags)
+		}
+	} else {
+		nTags = uint64(order.Uint16(buf[:2]))
 	}
-	nTags := order.Uint16(buf[:2])
-	d.Tags = make([]lazytag, nTags)
+	d = &Dir{Offset: offset, tags: make([]lazytag, nTags), order: order, base: base}
 	// load tags
-	totalOffset := offset + 2
+	totalOffset := offset + int64(countSize)
 	for n := 0; n < int(nTags); n++ {
-		t, err := decodeTag(r, totalOffset, order)
+		t, err := decodeTag(r, totalOffset, order, bigTIFF)
 		if err != nil {
-			return d, 0, err
+			return nil, 0, err
+		}
+		if t.offset > 0 {
+			t.offset += base
 		}
-		d.Tags[n] = t
-		totalOffset += 12 // size of tag field.
+		d.tags[n] = t
+		totalOffset += int64(entrySize) // size of tag field.
+	}
+	offsetSize := 4
+	if bigTIFF {
+		offsetSize = 8
 	}
-	n, err = r.ReadAt(buf[:4], totalOffset)
+	n, err = r.ReadAt(buf[:offsetSize], totalOffset)
 	if err != nil {
-		return d, 0, err
+		return nil, 0, err
+	}
+	if n != offsetSize {
+		return nil, 0, errors.New("read less than wanted")
+	}
+	if bigTIFF {
+		nextOffset = int64(order.Uint64(buf[:8]))
+	} else {
+		nextOffset = int64(order.Uint32(buf[:4]))
+	}
+	if nextOffset < 0 {
+		return nil, 0, errors.New("invalid next IFD offset")
 	}
-	if n != 4 {
-		return d, 0, errors.New("read less than wanted")
+	if nextOffset != 0 {
+		nextOffset += base
 	}
-	nextOffset = int64(order.Uint32(buf[:4]))
 	return d, nextOffset, nil
 }
 
 type lazytag struct {
-	offsetOrValue uint32
-	ID            ID
-	Type          Type
-	// Size in bytes of field if array, else 0.
+	// value holds values which fit in the IFD entry, which is 4 bytes
+	// for TIFF and 8 bytes for BigTIFF.
+	value [8]byte
+	// offset to values which do not fit in the IFD entry. It is zero for values stored
+	// in place and negative if the entry's offset is invalid, making the value unreadable.
+	offset int64
+	ID     ID
+	Type   Type
+	// Size in bytes of field.
 	length int
 }
 
-func (lt *lazytag) dataOffset() uint32 {
-	if lt.length == 0 {
-		return 0 // No data, only value.
-	}
-	return lt.offsetOrValue
+func (lt *lazytag) dataOffset() int64 {
+	return lt.offset
 }
 
 func (lt *lazytag) size() int {
-	if lt.length == 0 {
-		return int(lt.Type.Size())
-	}
 	return lt.length
 }
 
-func (lt *lazytag) arrayptr() *[4]byte {
-	return (*[4]byte)(unsafe.Pointer(&lt.offsetOrValue))
-}
-
-func decodeTag(r io.ReaderAt, offset int64, order binary.ByteOrder) (lztag lazytag, err error) {
-	var buf [12]byte
-	n, err := r.ReadAt(buf[:], offset)
+func decodeTag(r io.ReaderAt, offset int64, order binary.ByteOrder, bigTIFF bool) (lztag lazytag, err error) {
+	var buf [20]byte
+	entrySize := 12
+	if bigTIFF {
+		entrySize = 20
+	}
+	n, err := r.ReadAt(buf[:entrySize], offset)
 	if err != nil {
 		return lztag, err
 	}
-	if n != len(buf) {
+	if n != entrySize {
 		return lztag, errors.New("reading tag got short read (" + strconv.Itoa(n) + ")")
 	}
 	lztag.ID = ID(order.Uint16(buf[0:]))
 	lztag.Type = Type(order.Uint16(buf[2:]))
-	count := order.Uint32(buf[4:])
-	if count == 1<<32-1 {
-		return lztag, errors.New("invalid count offset in tag")
+	var count uint64
+	var valueBuf []byte
+	if bigTIFF {
+		count = order.Uint64(buf[4:])
+		valueBuf = buf[12:20]
+	} else {
+		count = uint64(order.Uint32(buf[4:]))
+		valueBuf = buf[8:12]
 	}
 	sz := lztag.Type.Size()
 	if sz == 0 || sz > 8 {
 		return lztag, errors.New("invalid tag type: " + strconv.Itoa(int(lztag.Type)))
 	}
+	if count >= math.MaxInt32/uint64(sz) {
+		return lztag, errors.New("invalid count offset in tag")
+	}
 	length := int(count) * int(sz)
-	valueBuf := buf[8:12]
-	if length > 4 {
-		lztag.offsetOrValue = order.Uint32(valueBuf)
-		lztag.length = length
-
+	lztag.length = length
+	if length > len(valueBuf) {
+		if bigTIFF {
+			lztag.offset = int64(order.Uint64(valueBuf))
+		} else {
+			lztag.offset = int64(order.Uint32(valueBuf))
+		}
+		if lztag.offset <= 0 {
+			// Mark the value as unreadable so the rest of the directory is still decoded.
+			lztag.offset = -1
+		}
 	} else {
-		arr := lztag.arrayptr()
-		copy(arr[:], valueBuf)
-		_ = arr // Place breakpoints for debugging.
+		copy(lztag.value[:], valueBuf)
 	}
 	return lztag, nil
 }
@@ -339,6 +713,27 @@ func newOffsetReaderAt(r io.ReaderAt, baseOffset int64, buf []byte) *offsetReade
 	}
 }
 
+// readerSize returns the size of the data in r, or -1 if it is unknown.
+func readerSize(r io.ReaderAt) int64 {
+	switch r := r.(type) {
+	case *offsetReaderAt:
+		size := readerSize(r.r)
+		if size >= 0 && size < r.offset {
+			return 0
+		} else if size >= 0 {
+			return size - r.offset
+		}
+	case interface{ Size() int64 }:
+		return r.Size()
+	case interface{ Stat() (fs.FileInfo, error) }:
+		info, err := r.Stat()
+		if err == nil && info.Mode().IsRegular() {
+			return info.Size()
+		}
+	}
+	return -1
+}
+
 func (or *offsetReaderAt) buflims() (start, end int64) {
 	if or.bufOffset < 0 {
 		return 0, 0
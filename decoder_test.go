@@ -0,0 +1,411 @@
+package exif
+
+import (
+	"bytes"
+	"encoding/binary"
+	"io"
+	"os"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/soypat/exif/rational"
+)
+
+func TestLazyDecoder_gps(t *testing.T) {
+	order := binary.BigEndian
+	latitude := make([]byte, 24)
+	for i, v := range []uint32{35, 1, 41, 1, 3049, 100} {
+		order.PutUint32(latitude[i*4:], v)
+	}
+	altitude := make([]byte, 8)
+	order.PutUint32(altitude, 12345)
+	order.PutUint32(altitude[4:], 10)
+	data := buildTestTIFF(order,
+		[]testEntry{
+			{id: 0x010f, tp: TypeString, data: []byte("Phone\x00")},
+			{id: 0x8825, tp: TypeUint32, dir: 1}, // GPSInfo.
+		},
+		[]testEntry{
+			{id: 0x0000, tp: TypeUint8, data: []byte{2, 3, 0, 0}}, // GPSVersionID.
+			{id: 0x0001, tp: TypeString, data: []byte("N\x00")},   // GPSLatitudeRef.
+			{id: 0x0002, tp: TypeURational64, data: latitude},     // GPSLatitude.
+			{id: 0x0006, tp: TypeURational64, data: altitude},     // GPSAltitude.
+		},
+	)
+	r := bytes.NewReader(data)
+	var decoder LazyDecoder
+	err := decoder.Decode(r)
+	if err != nil {
+		t.Fatal(err)
+	}
+	gps := decoder.Dir(GroupGPS)
+	if gps == nil || gps.Parent != decoder.Root() || gps.ParentTag != 0x8825 {
+		t.Fatal("GPS IFD not found under IFD0")
+	}
+	tag, err := decoder.GetTag(r, GroupGPS, 0x0002)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expect := []rational.U64{rational.NewU64(35, 1), rational.NewU64(41, 1), rational.NewU64(3049, 100)}
+	if !reflect.DeepEqual(tag.Value(), expect) {
+		t.Errorf("GPSLatitude: got %v, want %v", tag.Value(), expect)
+	}
+	tag, err = decoder.GetTag(r, GroupGPS, 0x0006)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, _ := tag.Rational(); got != rational.NewU64(12345, 10) {
+		t.Errorf("GPSAltitude: got %v", tag.Value())
+	}
+	ifds, err := decoder.MakeIFDs(r, func(ifd, size int, id ID) bool { return true })
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(ifds) != 2 || ifds[1].Group != GroupGPS || len(ifds[1].Tags) != 4 {
+		t.Fatalf("unexpected IFDs: %+v", ifds)
+	}
+	for i, expect := range []string{
+		"GPSVersionID (uint8): 2 3 0 0",
+		"GPSLatitudeRef (string): N\x00",
+		"GPSLatitude (rational): 35/1 41/1 3049/100",
+		"GPSAltitude (rational): 12345/10",
+	} {
+		if got := ifds[1].Tags[i].String(); got != expect {
+			t.Errorf("got %q, want %q", got, expect)
+		}
+	}
+}
+
+func TestLazyDecoder_interop(t *testing.T) {
+	fp, err := os.Open("testdata/app1jpeg.bin")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer fp.Close()
+	offset, err := FindStartOffset(fp, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	r := io.NewSectionReader(fp, offset, 1<<20)
+	var decoder LazyDecoder
+	err = decoder.Decode(r)
+	if err != nil {
+		t.Fatal(err)
+	}
+	interop := decoder.Dir(GroupInteropIFD)
+	if interop == nil || interop.Parent != decoder.Dir(GroupExifIFD) {
+		t.Fatal("Interop IFD not found under ExifIFD")
+	}
+	tag, err := decoder.GetTag(r, GroupInteropIFD, 0x0001) // InteropIndex.
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, _ := tag.Bytes(); string(got) != "R98\x00" {
+		t.Errorf("got InteropIndex %q, want R98", got)
+	}
+}
+
+func TestID_groupCollision(t *testing.T) {
+	const id = 0x0002
+	if got := ID(id).StringGroup(GroupInteropIFD); got != "InteropVersion" {
+		t.Errorf("got %q in InteropIFD", got)
+	}
+	if got := ID(id).StringGroup(GroupGPS); got != "GPSLatitude" {
+		t.Errorf("got %q in GPS", got)
+	}
+	if ID(id).TypeGroup(GroupGPS) != TypeURational64 {
+		t.Error("wrong type for GPSLatitude")
+	}
+}
+
+// testEntry is a tag entry of a test TIFF built with buildTestTIFF.
+type testEntry struct {
+	id   ID
+	tp   Type
+	data []byte
+	// If dir is non-zero the entry is a pointer to the directory of that index.
+	dir int
+	// dirs makes the entry an array of pointers to the directories of those indices.
+	dirs []int
+	// If undefDir is non-zero the entry is an undefined value spanning the directory
+	// of that index, excluding its out-of-line data, as in maker notes.
+	undefDir int
+}
+
+// buildTestTIFF builds TIFF data containing the directories dirs. The first
+// directory is IFD0. Other directories are only reachable via pointer entries.
+func buildTestTIFF(order binary.ByteOrder, dirs ...[]testEntry) []byte {
+	const headerSize = 8
+	dirOffsets := make([]int, len(dirs))
+	offset := headerSize
+	for i, dir := range dirs {
+		dirOffsets[i] = offset
+		offset += 2 + 12*len(dir) + 4
+	}
+	buf := make([]byte, offset)
+	if order == binary.LittleEndian {
+		copy(buf, "II")
+	} else {
+		copy(buf, "MM")
+	}
+	order.PutUint16(buf[2:], 42)
+	order.PutUint32(buf[4:], headerSize)
+	for i, dir := range dirs {
+		ptr := dirOffsets[i]
+		order.PutUint16(buf[ptr:], uint16(len(dir)))
+		ptr += 2
+		for _, entry := range dir {
+			data := entry.data
+			if entry.dir != 0 {
+				data = make([]byte, 4)
+				order.PutUint32(data, uint32(dirOffsets[entry.dir]))
+			}
+			for i, dir := range entry.dirs {
+				if i == 0 {
+					data = make([]byte, 4*len(entry.dirs))
+				}
+				order.PutUint32(data[4*i:], uint32(dirOffsets[dir]))
+			}
+			order.PutUint16(buf[ptr:], uint16(entry.id))
+			order.PutUint16(buf[ptr+2:], uint16(entry.tp))
+			if entry.undefDir != 0 {
+				order.PutUint32(buf[ptr+4:], uint32(2+12*len(dirs[entry.undefDir])+4))
+				order.PutUint32(buf[ptr+8:], uint32(dirOffsets[entry.undefDir]))
+				ptr += 12
+				continue
+			}
+			order.PutUint32(buf[ptr+4:], uint32(len(data)/int(entry.tp.Size())))
+			if len(data) <= 4 {
+				copy(buf[ptr+8:ptr+12], data)
+			} else {
+				order.PutUint32(buf[ptr+8:], uint32(len(buf)))
+				buf = append(buf, data...)
+			}
+			ptr += 12
+		}
+		// Next IFD offset left as zero.
+	}
+	return buf
+}
+
+func TestLazyDecoder_bigTIFF(t *testing.T) {
+	order := binary.LittleEndian
+	// entry appends a 20 byte BigTIFF IFD entry.
+	entry := func(b []byte, id ID, tp Type, count, value uint64) []byte {
+		b = order.AppendUint16(b, uint16(id))
+		b = order.AppendUint16(b, uint16(tp))
+		b = order.AppendUint64(b, count)
+		return order.AppendUint64(b, value)
+	}
+	const (
+		ifd0Offset = 16
+		dataOffset = ifd0Offset + 8 + 4*20 + 8
+		gpsOffset  = dataOffset + 16 + 10
+	)
+	b := []byte("II")
+	b = order.AppendUint16(b, 43)
+	b = order.AppendUint16(b, 8)
+	b = order.AppendUint16(b, 0)
+	b = order.AppendUint64(b, ifd0Offset)
+	b = order.AppendUint64(b, 4)
+	b = entry(b, 0x0100, TypeUint64, 1, 5_000_000_000)  // ImageWidth.
+	b = entry(b, 0x010f, TypeString, 10, dataOffset+16) // Make.
+	b = entry(b, 0x0111, TypeUint64, 2, dataOffset)     // StripOffsets.
+	b = entry(b, 0x8825, TypeIFD64, 1, gpsOffset)       // GPSInfo.
+	b = order.AppendUint64(b, 0)                        // Next IFD.
+	b = order.AppendUint64(b, 1<<33)
+	b = order.AppendUint64(b, 1<<34)
+	b = append(b, "BigMaker\x00\x00"...)
+	b = order.AppendUint64(b, 1)
+	b = entry(b, 0x0000, TypeUint8, 4, 0x00000302) // GPSVersionID.
+	b = order.AppendUint64(b, 0)
+
+	var decoder LazyDecoder
+	r := bytes.NewReader(b)
+	err := decoder.Decode(r)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ifds, err := decoder.MakeIFDs(r, func(ifd, size int, id ID) bool { return true })
+	if err != nil {
+		t.Fatal(err)
+	}
+	var got []string
+	for _, ifd := range ifds {
+		for _, tag := range ifd.Tags {
+			got = append(got, ifd.Group.String()+" "+strings.Trim(tag.String(), "\x00"))
+		}
+	}
+	want := []string{
+		"IFD0 ImageWidth (uint32): 5000000000", // Type of tag definition.
+		"IFD0 Make (string): BigMaker",
+		"IFD0 StripOffsets (unknown): 8589934592 17179869184",
+		"IFD0 GPSInfo (unknown): 138",
+		"GPS GPSVersionID (uint8): 2 3 0 0",
+	}
+	if strings.Join(got, "\n") != strings.Join(want, "\n") {
+		t.Errorf("got tags:\n%s\nwant:\n%s", strings.Join(got, "\n"), strings.Join(want, "\n"))
+	}
+}
+
+func TestLazyDecoder_subIFDs(t *testing.T) {
+	order := binary.LittleEndian
+	u32 := func(v uint32) []byte {
+		b := make([]byte, 4)
+		order.PutUint32(b, v)
+		return b
+	}
+	tiff := buildTestTIFF(order,
+		[]testEntry{
+			{id: 0x0100, tp: TypeUint32, data: u32(4000)},   // ImageWidth.
+			{id: 0x014a, tp: TypeUint32, dirs: []int{1, 2}}, // SubIFDs.
+			{id: 0x8769, tp: TypeUint32, dir: 3},            // ExifOffset.
+		},
+		[]testEntry{
+			{id: 0x0100, tp: TypeUint32, data: u32(3000)},
+			{id: 0x014a, tp: TypeUint32, dirs: []int{4}},
+		},
+		[]testEntry{{id: 0x0100, tp: TypeUint32, data: u32(2000)}},
+		[]testEntry{{id: 0xa001, tp: TypeUint16, data: []byte{1, 0}}}, // ColorSpace.
+		[]testEntry{{id: 0x0100, tp: TypeUint32, data: u32(1000)}},
+	)
+	var decoder LazyDecoder
+	r := bytes.NewReader(tiff)
+	err := decoder.Decode(r)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []struct {
+		group  Group
+		parent int
+		width  int64
+	}{
+		{GroupIFD0, -1, 4000},
+		{GroupExifIFD, 0, 0},
+		{GroupSubIFD0, 0, 3000},
+		{GroupSubIFD1, 0, 2000},
+		{GroupSubIFD0, 2, 1000},
+	}
+	ifds, err := decoder.MakeIFDs(r, func(ifd, size int, id ID) bool { return id == 0x0100 })
+	if err != nil {
+		t.Fatal(err)
+	}
+	dirs := decoder.Dirs()
+	if len(ifds) != len(want) || len(dirs) != len(want) {
+		t.Fatalf("got %d IFDs, want %d", len(ifds), len(want))
+	}
+	for level, w := range want {
+		dir := dirs[level]
+		var parent *Dir
+		if w.parent >= 0 {
+			parent = dirs[w.parent]
+		}
+		if ifds[level].Group != w.group || dir.Group != w.group || dir.Parent != parent {
+			t.Errorf("IFD %d: got group %s, want %s parent %d", level, dir.Group.String(), w.group.String(), w.parent)
+		}
+		if w.width == 0 {
+			continue
+		}
+		width, err := ifds[level].Tags[0].Int()
+		if err != nil || width != w.width {
+			t.Errorf("IFD %d: got ImageWidth %d (%v), want %d", level, width, err, w.width)
+		}
+	}
+	root := decoder.Root()
+	if !reflect.DeepEqual(root.Children, []*Dir{dirs[1], dirs[2], dirs[3]}) {
+		t.Errorf("unexpected IFD0 children")
+	}
+	if dirs[4].ParentTag != 0x014a || dirs[2].Children[0] != dirs[4] {
+		t.Errorf("nested SubIFD not linked to its parent")
+	}
+	tag, err := decoder.GetDirTag(r, dirs[4], 0x0100)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if width, _ := tag.Int(); width != 1000 {
+		t.Errorf("got nested SubIFD ImageWidth %d, want 1000", width)
+	}
+	if tag, err = decoder.GetTag(r, GroupSubIFD1, 0x0100); err != nil {
+		t.Fatal(err)
+	} else if width, _ := tag.Int(); width != 2000 {
+		t.Errorf("got SubIFD1 ImageWidth %d, want 2000", width)
+	}
+}
+
+func TestLazyDecoder_emptyTag(t *testing.T) {
+	order := binary.LittleEndian
+	tiff := buildTestTIFF(order, []testEntry{
+		{id: 0x0100, tp: TypeUint16, data: []byte{0x10, 0}}, // ImageWidth.
+		{id: 0x010e, tp: TypeString},                        // ImageDescription with count 0.
+		{id: 0x0111, tp: TypeUint32},                        // StripOffsets with count 0.
+		{id: 0x0112, tp: TypeUint16, data: []byte{1, 0}},    // Orientation.
+	})
+	var decoder LazyDecoder
+	r := bytes.NewReader(tiff)
+	err := decoder.Decode(r)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ifds, err := decoder.MakeIFDs(r, func(ifd, size int, id ID) bool { return true })
+	if err != nil {
+		t.Fatal(err)
+	}
+	tags := ifds[0].Tags
+	if len(tags) != 4 {
+		t.Fatalf("got %d tags, want 4", len(tags))
+	}
+	if s, ok := tags[1].Value().(string); !ok || s != "" {
+		t.Errorf("got ImageDescription %#v, want empty string", tags[1].Value())
+	}
+	if v, err := tags[2].Ints(); err != nil || len(v) != 0 {
+		t.Errorf("got StripOffsets %v (%v), want no values", v, err)
+	}
+	if v, err := tags[3].Int(); err != nil || v != 1 {
+		t.Errorf("got Orientation %d (%v), want 1", v, err)
+	}
+
+	// Empty values are encoded with a count of zero.
+	data, err := Marshal(order, ifds)
+	if err != nil {
+		t.Fatal(err)
+	}
+	r = bytes.NewReader(data)
+	err = decoder.Decode(r)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tag, err := decoder.GetTag(r, GroupIFD0, 0x0111)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if v, err := tag.Ints(); err != nil || len(v) != 0 {
+		t.Errorf("got re-encoded StripOffsets %v (%v), want no values", v, err)
+	}
+}
+
+func TestLazyDecoder_invalidOffset(t *testing.T) {
+	order := binary.LittleEndian
+	tiff := buildTestTIFF(order, []testEntry{
+		{id: 0x010f, tp: TypeString, data: []byte("Maker\x00")}, // Make.
+		{id: 0x0112, tp: TypeUint16, data: []byte{1, 0}},        // Orientation.
+	})
+	// Point the value of Make to offset 0.
+	order.PutUint32(tiff[8+2+8:], 0)
+	var decoder LazyDecoder
+	r := bytes.NewReader(tiff)
+	err := decoder.Decode(r)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ifds, err := decoder.MakeIFDs(r, func(ifd, size int, id ID) bool { return true })
+	if err != nil {
+		t.Fatal(err)
+	}
+	if tags := ifds[0].Tags; len(tags) != 1 || tags[0].ID != 0x0112 {
+		t.Errorf("got tags %v, want only Orientation", tags)
+	}
+	if _, err := decoder.GetTag(r, GroupIFD0, 0x010f); err == nil {
+		t.Error("expected error reading tag with invalid data offset")
+	}
+}
@@ -0,0 +1,348 @@
+package exif
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"math"
+	"sort"
+
+	"github.com/soypat/exif/rational"
+)
+
+// IDs of tags which point to other IFDs. The encoder computes their values.
+const (
+	idExifOffset    ID = 0x8769
+	idGPSInfo       ID = 0x8825
+	idInteropOffset ID = 0xa005
+	idSubIFDs       ID = 0x014a
+)
+
+// IDs of tags which contain offsets to image data of IFD1.
+const (
+	idStripOffsets    ID = 0x0111
+	idStripByteCounts ID = 0x0117
+	idThumbnailOffset ID = 0x0201
+	idThumbnailLength ID = 0x0202
+)
+
+// Marshal encodes the IFDs into TIFF formatted EXIF data with the given byte order,
+// starting with the byte order mark. The result is suitable for decoding with
+// [LazyDecoder.Decode] and may be embedded in a JPEG APP1 segment after the
+// "Exif\x00\x00" prefix.
+//
+// The IFD0 group is required. IFD1 is chained after IFD0. The ExifIFD
+// (GroupExifIFD, or the deprecated GroupSubIFD), GroupGPS and
+// GroupInteropIFD directories are written as sub-IFDs and the pointer tags
+// ExifOffset, GPSInfo and InteropOffset referencing them are generated by Marshal.
+// Pointer tags present in the IFDs are ignored. SubIFDs are not encoded and the
+// SubIFDs tag is dropped. IFDs of other groups, such as SubIFD0, decoded maker
+// notes or the directories chained after IFD1, are skipped, so the IFDs returned
+// by [LazyDecoder.MakeIFDs] may be passed to Marshal. Maker notes are written as
+// the undecoded value of the MakerNote tag.
+//
+// The image data of IFD1 is not carried by its tags, so the ThumbnailOffset,
+// ThumbnailLength, StripOffsets and StripByteCounts tags of IFD1 are dropped.
+// If IFD1 has a Thumbnail it is written after the directory, and ThumbnailOffset
+// and ThumbnailLength tags referencing it are generated by Marshal.
+//
+// Tag values are encoded with the type of the tag definition of the tag's group.
+// Tags of unknown type are encoded according to their value. Tags of other IFDs
+// which contain offsets to data outside of the IFDs, such as StripOffsets, are
+// written as is.
+func Marshal(order binary.ByteOrder, ifds []IFD) ([]byte, error) {
+	mark, err := byteOrderMark(order)
+	if err != nil {
+		return nil, err
+	}
+	dirs, err := newEncdirs(order, ifds)
+	if err != nil {
+		return nil, err
+	}
+	const headerSize = 8
+	size, err := layoutEncdirs(dirs, headerSize)
+	if err != nil {
+		return nil, err
+	}
+	buf := make([]byte, size)
+	copy(buf, mark)
+	order.PutUint16(buf[2:], 42)
+	order.PutUint32(buf[4:], uint32(dirs[0].offset))
+	for _, dir := range dirs {
+		dir.put(buf, order)
+	}
+	return buf, nil
+}
+
+// byteOrderMark returns the TIFF header byte order mark of order.
+func byteOrderMark(order binary.ByteOrder) (string, error) {
+	switch order {
+	case binary.LittleEndian:
+		return "II", nil
+	case binary.BigEndian:
+		return "MM", nil
+	}
+	return "", errors.New("byte order must be binary.LittleEndian or binary.BigEndian")
+}
+
+// encdir is an IFD prepared for encoding.
+type encdir struct {
+	group   Group
+	entries []encentry
+	// next is the next IFD in the chain.
+	next *encdir
+	// offset of the IFD in the output.
+	offset int64
+	// thumbnail is the JPEG thumbnail of IFD1, written at thumbnailOffset.
+	thumbnail       []byte
+	thumbnailOffset int64
+}
+
+// encentry is an IFD entry prepared for encoding.
+type encentry struct {
+	id    ID
+	tp    Type
+	count uint32
+	data  []byte
+	// dir is set for entries which point to an IFD.
+	dir *encdir
+	// dataOffset of data in the output if it is not stored inline.
+	dataOffset int64
+}
+
+// newEncdirs returns the IFDs prepared for encoding in the order
+// they are laid out. The first returned IFD is IFD0.
+func newEncdirs(order binary.ByteOrder, ifds []IFD) ([]*encdir, error) {
+	var ifd0, ifd1, exif, interop, gps *encdir
+	for i := range ifds {
+		var dst **encdir
+		switch ifds[i].Group {
+		case GroupIFD0:
+			dst = &ifd0
+		case GroupIFD1:
+			dst = &ifd1
+		case GroupExifIFD, GroupSubIFD:
+			dst = &exif
+		case GroupInteropIFD:
+			dst = &interop
+		case GroupGPS:
+			dst = &gps
+		default:
+			continue // Directories which are not re-encoded, such as maker notes.
+		}
+		if *dst != nil {
+			return nil, fmt.Errorf("duplicate %s IFD", ifds[i].Group.String())
+		}
+		dir, err := newEncdir(order, ifds[i])
+		if err != nil {
+			return nil, err
+		}
+		*dst = dir
+	}
+	switch {
+	case ifd0 == nil:
+		return nil, errors.New("missing IFD0")
+	case interop != nil && exif == nil:
+		return nil, errors.New("InteropIFD requires an ExifIFD to point to it")
+	}
+	dirs := []*encdir{ifd0}
+	if ifd1 != nil {
+		ifd0.next = ifd1
+		dirs = append(dirs, ifd1)
+	}
+	if exif != nil {
+		ifd0.addPointer(idExifOffset, exif)
+		dirs = append(dirs, exif)
+	}
+	if interop != nil {
+		exif.addPointer(idInteropOffset, interop)
+		dirs = append(dirs, interop)
+	}
+	if gps != nil {
+		ifd0.addPointer(idGPSInfo, gps)
+		dirs = append(dirs, gps)
+	}
+	for _, dir := range dirs {
+		sort.SliceStable(dir.entries, func(i, j int) bool {
+			return dir.entries[i].id < dir.entries[j].id
+		})
+		for i := 1; i < len(dir.entries); i++ {
+			if dir.entries[i].id == dir.entries[i-1].id {
+				return nil, fmt.Errorf("duplicate tag %s in %s", dir.entries[i].id.StringGroup(dir.group), dir.group.String())
+			}
+		}
+	}
+	return dirs, nil
+}
+
+func newEncdir(order binary.ByteOrder, ifd IFD) (*encdir, error) {
+	dir := &encdir{group: ifd.Group, entries: make([]encentry, 0, len(ifd.Tags))}
+	for _, tag := range ifd.Tags {
+		if tag.ID == idExifOffset || tag.ID == idGPSInfo || tag.ID == idInteropOffset || tag.ID == idSubIFDs {
+			continue // Pointer tags are generated.
+		}
+		if ifd.Group == GroupIFD1 && (tag.ID == idThumbnailOffset || tag.ID == idThumbnailLength ||
+			tag.ID == idStripOffsets || tag.ID == idStripByteCounts) {
+			continue // Offsets to image data are only valid in the original file.
+		}
+		if tag.Group == GroupNone {
+			// Tags created with NewTag belong to the IFD they are in.
+			tag.Group = ifd.Group
+		}
+		entry, err := newEncentry(order, tag)
+		if err != nil {
+			return nil, fmt.Errorf("encoding %s tag %s: %w", ifd.Group.String(), tag.ID.StringGroup(tag.Group), err)
+		}
+		dir.entries = append(dir.entries, entry)
+	}
+	if ifd.Group == GroupIFD1 && len(ifd.Thumbnail) > 0 {
+		dir.thumbnail = ifd.Thumbnail
+		length := make([]byte, 4)
+		order.PutUint32(length, uint32(len(ifd.Thumbnail)))
+		// The value of ThumbnailOffset is set once the thumbnail is laid out.
+		dir.entries = append(dir.entries,
+			encentry{id: idThumbnailOffset, tp: TypeUint32, count: 1, data: make([]byte, 4)},
+			encentry{id: idThumbnailLength, tp: TypeUint32, count: 1, data: length},
+		)
+	}
+	return dir, nil
+}
+
+func newEncentry(order binary.ByteOrder, tag Tag) (encentry, error) {
+	tp, err := tag.encodeType()
+	if err != nil {
+		return encentry{}, err
+	}
+	if tp == TypeString {
+		// ASCII values are null terminated.
+		switch c := tag.data.(type) {
+		case string:
+			if len(c) == 0 || c[len(c)-1] != 0 {
+				tag.data = c + "\x00"
+			}
+		case []byte:
+			if len(c) == 0 || c[len(c)-1] != 0 {
+				tag.data = append(append([]byte{}, c...), 0)
+			}
+		}
+	}
+	data, err := EncodeTypeData(tp, order, tag.data)
+	if err != nil {
+		return encentry{}, err
+	}
+	return encentry{
+		id:    tag.ID,
+		tp:    tp,
+		count: uint32(len(data) / int(tp.Size())),
+		data:  data,
+	}, nil
+}
+
+// encodeType returns the type the tag's value is encoded as.
+func (t Tag) encodeType() (Type, error) {
+	tp := t.typ()
+	switch t.data.(type) {
+	case nil:
+		return 0, errors.New("nil tag value")
+	case string:
+		if tp != TypeUndefined {
+			tp = TypeString
+		}
+	case []byte:
+		if !tp.IsBytes() {
+			tp = TypeUndefined
+		}
+	case rational.U64, []rational.U64:
+		tp = TypeURational64
+	case rational.I64, []rational.I64:
+		tp = TypeRational64
+	case float32, float64, []float64:
+		if !tp.IsFloat() {
+			tp = TypeFloat64
+		}
+	default:
+		if tp.IsInt() {
+			break
+		}
+		// Unknown type, i.e. StripOffsets. Choose a 32 bit type which fits all values.
+		ints, err := t.Ints()
+		if err != nil {
+			return 0, err
+		}
+		tp = TypeUint32
+		for _, v := range ints {
+			if v < 0 || v > math.MaxUint32 {
+				tp = TypeInt32
+				break
+			}
+		}
+	}
+	return tp, nil
+}
+
+// addPointer adds an entry to the IFD which points to dir.
+func (d *encdir) addPointer(id ID, dir *encdir) {
+	d.entries = append(d.entries, encentry{id: id, tp: TypeUint32, count: 1, dir: dir})
+}
+
+// size returns the size of the IFD's entries, excluding out-of-line data.
+func (d *encdir) size() int64 {
+	return 2 + 12*int64(len(d.entries)) + 4
+}
+
+// layoutEncdirs sets the offsets of the IFDs and their out-of-line data starting
+// at offset start. Each IFD is followed by its data. It returns the end offset.
+func layoutEncdirs(dirs []*encdir, start int64) (end int64, err error) {
+	offset := start
+	for _, dir := range dirs {
+		offset += offset % 2 // IFDs begin on a word boundary.
+		dir.offset = offset
+		offset += dir.size()
+		for i := range dir.entries {
+			entry := &dir.entries[i]
+			if len(entry.data) > 4 {
+				offset += offset % 2 // Values begin on a word boundary.
+				entry.dataOffset = offset
+				offset += int64(len(entry.data))
+			}
+		}
+		if len(dir.thumbnail) > 0 {
+			dir.thumbnailOffset = offset
+			offset += int64(len(dir.thumbnail))
+		}
+	}
+	if offset > math.MaxUint32 {
+		return 0, errors.New("encoded EXIF exceeds 4GB offset limit")
+	}
+	return offset, nil
+}
+
+// put writes the IFD and its out-of-line data to buf at the offsets set by layoutEncdirs.
+func (d *encdir) put(buf []byte, order binary.ByteOrder) {
+	ptr := d.offset
+	order.PutUint16(buf[ptr:], uint16(len(d.entries)))
+	ptr += 2
+	for _, entry := range d.entries {
+		order.PutUint16(buf[ptr:], uint16(entry.id))
+		order.PutUint16(buf[ptr+2:], uint16(entry.tp))
+		order.PutUint32(buf[ptr+4:], entry.count)
+		switch {
+		case entry.dir != nil:
+			order.PutUint32(buf[ptr+8:], uint32(entry.dir.offset))
+		case entry.id == idThumbnailOffset && len(d.thumbnail) > 0:
+			order.PutUint32(buf[ptr+8:], uint32(d.thumbnailOffset))
+			copy(buf[d.thumbnailOffset:], d.thumbnail)
+		case len(entry.data) > 4:
+			order.PutUint32(buf[ptr+8:], uint32(entry.dataOffset))
+			copy(buf[entry.dataOffset:], entry.data)
+		default:
+			copy(buf[ptr+8:ptr+12], entry.data)
+		}
+		ptr += 12
+	}
+	var next int64
+	if d.next != nil {
+		next = d.next.offset
+	}
+	order.PutUint32(buf[ptr:], uint32(next))
+}
@@ -0,0 +1,241 @@
+package exif
+
+import (
+	"bytes"
+	"encoding/binary"
+	"io"
+	"os"
+	"testing"
+
+	"github.com/soypat/exif/rational"
+)
+
+func TestMarshal_roundtrip(t *testing.T) {
+	fp, err := os.Open("testdata/app1jpeg.bin")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer fp.Close()
+	offset, err := FindStartOffset(fp, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	r := io.NewSectionReader(fp, offset, 1<<20)
+	var decoder LazyDecoder
+	err = decoder.Decode(r)
+	if err != nil {
+		t.Fatal(err)
+	}
+	all := func(ifd, size int, id ID) bool { return true }
+	ifds, err := decoder.MakeIFDs(r, all)
+	if err != nil {
+		t.Fatal(err)
+	}
+	thumbnail, err := decoder.Thumbnail(r)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.HasPrefix(thumbnail, []byte{0xff, 0xd8}) {
+		t.Fatal("thumbnail is not a JPEG image")
+	}
+	for i := range ifds {
+		if ifds[i].Group == GroupIFD1 {
+			ifds[i].Thumbnail = thumbnail
+		}
+	}
+	for _, order := range []binary.ByteOrder{binary.BigEndian, binary.LittleEndian} {
+		data, err := Marshal(order, ifds)
+		if err != nil {
+			t.Fatal(err)
+		}
+		r := bytes.NewReader(data)
+		err = decoder.Decode(r)
+		if err != nil {
+			t.Fatal(err)
+		}
+		got, err := decoder.MakeIFDs(r, all)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(got) != len(ifds) {
+			t.Fatalf("got %d IFDs, want %d", len(got), len(ifds))
+		}
+		for i := range ifds {
+			if got[i].Group != ifds[i].Group || len(got[i].Tags) != len(ifds[i].Tags) {
+				t.Fatalf("IFD %d mismatch: got %s with %d tags, want %s with %d tags", i,
+					got[i].Group.String(), len(got[i].Tags), ifds[i].Group.String(), len(ifds[i].Tags))
+			}
+			for j, want := range ifds[i].Tags {
+				if want.ID == idExifOffset || want.ID == idInteropOffset || want.ID == idThumbnailOffset {
+					continue // Pointer values depend on layout.
+				}
+				if got[i].Tags[j].String() != want.String() {
+					t.Errorf("tag mismatch: got %q, want %q", got[i].Tags[j].String(), want.String())
+				}
+			}
+		}
+		gotThumbnail, err := decoder.Thumbnail(r)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !bytes.Equal(gotThumbnail, thumbnail) {
+			t.Errorf("got thumbnail of %d bytes, want %d bytes", len(gotThumbnail), len(thumbnail))
+		}
+	}
+}
+
+func TestMarshal_dropThumbnail(t *testing.T) {
+	ifds := []IFD{
+		{Group: GroupIFD0, Tags: []Tag{{ID: 0x010f, Group: GroupIFD0, data: "Maker"}}},
+		{Group: GroupIFD1, Tags: []Tag{
+			{ID: 0x0103, Group: GroupIFD1, data: int64(6)}, // Compression.
+			{ID: idThumbnailOffset, Group: GroupIFD1, data: int64(958)},
+			{ID: idThumbnailLength, Group: GroupIFD1, data: int64(24576)},
+		}},
+	}
+	data, err := Marshal(binary.LittleEndian, ifds)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var decoder LazyDecoder
+	r := bytes.NewReader(data)
+	err = decoder.Decode(r)
+	if err != nil {
+		t.Fatal(err)
+	}
+	got, err := decoder.MakeIFDs(r, func(ifd, size int, id ID) bool { return true })
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(got) != 2 || len(got[1].Tags) != 1 || got[1].Tags[0].ID != 0x0103 {
+		t.Errorf("got IFDs %v, want IFD1 without thumbnail offset tags", got)
+	}
+	thumbnail, err := decoder.Thumbnail(r)
+	if err != nil || thumbnail != nil {
+		t.Errorf("got thumbnail of %d bytes (%v), want none", len(thumbnail), err)
+	}
+}
+
+func TestMarshal_makerNote(t *testing.T) {
+	data, err := os.ReadFile("testdata/canon.tiff")
+	if err != nil {
+		t.Fatal(err)
+	}
+	var decoder LazyDecoder
+	r := bytes.NewReader(data)
+	err = decoder.Decode(r)
+	if err != nil {
+		t.Fatal(err)
+	}
+	all := func(ifd, size int, id ID) bool { return true }
+	ifds, err := decoder.MakeIFDs(r, all)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if decoder.Dir(GroupCanon) == nil {
+		t.Fatal("Canon maker note not decoded")
+	}
+	want := map[Group]IFD{}
+	for _, ifd := range ifds {
+		want[ifd.Group] = ifd
+	}
+	data, err = Marshal(binary.BigEndian, ifds)
+	if err != nil {
+		t.Fatal(err)
+	}
+	r = bytes.NewReader(data)
+	err = decoder.Decode(r)
+	if err != nil {
+		t.Fatal(err)
+	}
+	got, err := decoder.MakeIFDs(r, all)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, ifd := range got[:2] {
+		if ifd.Group != GroupIFD0 && ifd.Group != GroupExifIFD {
+			t.Fatalf("unexpected %s IFD", ifd.Group.String())
+		}
+		if len(ifd.Tags) != len(want[ifd.Group].Tags) {
+			t.Fatalf("%s: got %d tags, want %d", ifd.Group.String(), len(ifd.Tags), len(want[ifd.Group].Tags))
+		}
+		for i, tag := range ifd.Tags {
+			if tag.ID == idExifOffset {
+				continue // Pointer values depend on layout.
+			}
+			if w := want[ifd.Group].Tags[i]; tag.String() != w.String() {
+				t.Errorf("tag mismatch: got %q, want %q", tag.String(), w.String())
+			}
+		}
+	}
+}
+
+func TestMarshal_newTags(t *testing.T) {
+	mustTag := func(g Group, id ID, v any) Tag {
+		tag, err := NewGroupTag(g, id, v)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return tag
+	}
+	ifds := []IFD{
+		{Group: GroupIFD0, Tags: []Tag{
+			mustTag(GroupIFD0, 0x0112, 6),                           // Orientation.
+			mustTag(GroupIFD0, 0x010f, "Maker"),                     // Make.
+			mustTag(GroupIFD0, 0x0102, []int64{8, 8, 8}),            // BitsPerSample.
+			mustTag(GroupIFD0, 0x011a, rational.NewU64(300, 1)),     // XResolution.
+			mustTag(GroupIFD0, 0x0111, []int64{8, 100000, 1 << 31}), // StripOffsets.
+		}},
+		{Group: GroupGPS, Tags: []Tag{
+			mustTag(GroupGPS, 0x0001, "S"), // GPSLatitudeRef.
+			mustTag(GroupGPS, 0x0005, 1),   // GPSAltitudeRef.
+			mustTag(GroupGPS, 0x0002, []rational.U64{rational.NewU64(33, 1), rational.NewU64(52, 1), rational.NewU64(0, 1)}),
+		}},
+	}
+	data, err := Marshal(binary.LittleEndian, ifds)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var decoder LazyDecoder
+	r := bytes.NewReader(data)
+	err = decoder.Decode(r)
+	if err != nil {
+		t.Fatal(err)
+	}
+	got, err := decoder.MakeIFDs(r, func(ifd, size int, id ID) bool { return true })
+	if err != nil {
+		t.Fatal(err)
+	}
+	expect := [][]string{
+		{
+			"BitsPerSample (uint16): 8 8 8",
+			"Make (string): Maker\x00",
+			"StripOffsets (unknown): 8 100000 2147483648",
+			"Orientation (uint16): Rotate 90 CW",
+			"XResolution (rational): 300/1",
+			"GPSInfo (unknown): 118",
+		},
+		{
+			"GPSLatitudeRef (string): S\x00",
+			"GPSLatitude (rational): 33/1 52/1 0",
+			"GPSAltitudeRef (uint8): Below Sea Level",
+		},
+	}
+	if len(got) != len(expect) {
+		t.Fatalf("got %d IFDs, want %d", len(got), len(expect))
+	}
+	for i := range expect {
+		if len(got[i].Tags) != len(expect[i]) {
+			t.Fatalf("got %v, want %q", got[i].Tags, expect[i])
+		}
+		for j := range expect[i] {
+			if s := got[i].Tags[j].String(); s != expect[i][j] {
+				t.Errorf("got %q, want %q", s, expect[i][j])
+			}
+		}
+	}
+	_, err = Marshal(binary.LittleEndian, []IFD{{Group: GroupIFD0, Tags: []Tag{mustTag(GroupIFD0, 0x0112, 1<<20)}}})
+	if err == nil {
+		t.Error("expected out of range error for uint16 Orientation")
+	}
+}
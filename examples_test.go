@@ -64,25 +64,27 @@ func ExampleLazyDecoder() {
 	// 	ResolutionUnit (uint16): inches
 	// 	ThumbnailOffset (uint32): 958
 	// 	ThumbnailLength (uint32): 24576
-	// SubIFD:
+	// ExifIFD:
 	// 	ExposureTime (rational): 39636/1000000
 	// 	ExposureProgram (uint16): Aperture-priority AE
 	// 	ISO (uint16): 6
-	// 	ExifVersion (undefined): "0"
+	// 	ExifVersion (undefined): "0220"
 	// 	DateTimeOriginal (string): 2023:01:04 14:18:34
 	// 	CreateDate (string): 2023:01:04 14:18:34
-	// 	ComponentsConfiguration (undefined): "\x01"
+	// 	ComponentsConfiguration (undefined): "\x01\x02\x03\x00"
 	// 	ShutterSpeedValue (urational): 4657045/1000000
 	// 	BrightnessValue (urational): 0
 	// 	MeteringMode (uint16): Center-weighted average
 	// 	Flash (uint16): 0
-	// 	FlashpixVersion (undefined): "0"
+	// 	FlashpixVersion (undefined): "0100"
 	// 	ColorSpace (uint16): sRGB
 	// 	ExifImageWidth (uint16): 2048
 	// 	ExifImageHeight (uint16): 1536
 	// 	InteropOffset (unknown): 822
 	// 	ExposureMode (uint16): Auto
 	// 	WhiteBalance (uint16): Auto
+	// InteropIFD:
+	// 	InteropIndex (string): R98
 }
 
 func ExampleLazyDecoder_onlyWords() {
@@ -8,6 +8,7 @@ import (
 	"io"
 	"math"
 	"strconv"
+	"strings"
 
 	"github.com/soypat/exif/rational"
 )
@@ -18,21 +19,35 @@ import (
 type IFD struct {
 	Tags  []Tag
 	Group Group
+	// Thumbnail holds the JPEG thumbnail of IFD1, which is written by [Marshal]
+	// along with the ThumbnailOffset and ThumbnailLength tags pointing to it.
+	// It is not set by MakeIFDs, see [LazyDecoder.Thumbnail].
+	Thumbnail []byte
 }
 
 // Tag represents an EXIF field and the contained data in the field.
 type Tag struct {
-	ID   ID
-	data any
+	ID ID
+	// Group is the group of the IFD containing the tag. It determines the
+	// tag definition of the ID, since IDs of different groups may collide,
+	// i.e: GPSLatitude and InteropVersion both have ID 0x0002.
+	// The zero value GroupNone is interpreted as the standard EXIF group.
+	Group Group
+	data  any
 }
 
 // String returns a human readable representation of the tag and its value.
 func (t Tag) String() string {
 	desc, err := t.Describe()
 	if err != nil {
-		return fmt.Sprintf("!ERR %s: %v", t.ID.String(), err.Error())
+		return fmt.Sprintf("!ERR %s: %v", t.ID.StringGroup(t.Group), err.Error())
 	}
-	return fmt.Sprintf("%s (%s): %v", t.ID.String(), t.ID.Type().String(), desc)
+	return fmt.Sprintf("%s (%s): %v", t.ID.StringGroup(t.Group), t.typ().String(), desc)
+}
+
+// typ returns the type of the tag's ID in the tag's group.
+func (t Tag) typ() Type {
+	return t.ID.TypeGroup(t.Group)
 }
 
 // Describe returns a human-readable description of the value contained in the
@@ -45,50 +60,50 @@ func (t Tag) Describe() (description string, err error) {
 	if t.data == nil {
 		return "", errors.New("nil tag value")
 	}
-	tagdef, ok := getTagdef(t.ID)
+	tagdef, ok := getTagdef(t.Group, t.ID)
 	if !ok {
 		return "", errors.New("unknown tag ID")
 	}
-	tp := t.ID.Type()
-	if tp == 0 {
-		tp = tagdef.Type
-	}
+	tp := tagdef.Type
 	switch {
 	case tp.IsFloat():
-		v, err := t.Float()
+		v, err := t.Floats()
 		if err != nil {
 			return "", err
 		}
-		description = strconv.FormatFloat(v, 'g', 6, 64)
+		description = joinValues(len(v), func(i int) string {
+			return strconv.FormatFloat(v[i], 'g', 6, 64)
+		})
 
 	case tp.IsInt():
-		v, err := t.Int()
+		v, err := t.Ints()
 		if err != nil {
 			return "", err
 		}
 		// Case where the ID represents an enum.
 		if len(tagdef.enum) > 0 {
-			v, err := toInt(t.data)
-			if err != nil {
-				return "", fmt.Errorf("%v <unexpected %T type>", t.data, t.data)
-			}
-			for i, enum := range tagdef.enum {
-				if enum == v {
-					return tagdef.enumString[i], nil
+			enumStrings := make([]string, len(v))
+			for i := range v {
+				enumStrings[i], err = tagdef.enumStringOf(v[i])
+				if err != nil {
+					return "", err
 				}
 			}
-			return "", fmt.Errorf("%d <unexpected value of Exif enum>", v)
+			return strings.Join(enumStrings, " "), nil
 		}
-		// Just an integer.
-		description = strconv.FormatInt(v, 10)
+		// Just integers.
+		description = joinValues(len(v), func(i int) string {
+			return strconv.FormatInt(v[i], 10)
+		})
 
 	case tp.IsRational():
-		v, err := t.Rational()
+		v, err := t.Rationals()
 		if err != nil {
 			return "", err
 		}
-		stringer := v.(fmt.Stringer)
-		description = stringer.String()
+		description = joinValues(len(v), func(i int) string {
+			return v[i].(fmt.Stringer).String()
+		})
 
 	case tp.IsBytes():
 		v, err := t.Bytes()
@@ -102,7 +117,13 @@ func (t Tag) Describe() (description string, err error) {
 		}
 	case tp == 0: // Unknown type.
 		// Some tags have this label. They are usually offset
-		description = fmt.Sprintf("%v", t.data)
+		if v, ok := t.data.([]int64); ok {
+			description = joinValues(len(v), func(i int) string {
+				return strconv.FormatInt(v[i], 10)
+			})
+		} else {
+			description = fmt.Sprintf("%v", t.data)
+		}
 	default:
 		return "", fmt.Errorf("unknown Exif type code (%d)", uint16(tp))
 	}
@@ -110,32 +131,59 @@ func (t Tag) Describe() (description string, err error) {
 	return description, nil
 }
 
+// joinValues joins n space separated values obtained from the str callback.
+func joinValues(n int, str func(i int) string) string {
+	if n == 1 {
+		return str(0)
+	}
+	var sb strings.Builder
+	for i := 0; i < n; i++ {
+		if i != 0 {
+			sb.WriteByte(' ')
+		}
+		sb.WriteString(str(i))
+	}
+	return sb.String()
+}
+
 // Value returns the value contained in the tag. An uninitialized tag will return nil.
 func (t Tag) Value() any {
 	return t.data
 }
 
-// NewTag creates a new tag with the underlying value.
+// NewTag creates a new tag of the standard EXIF group with the underlying value.
+// Multiple values may be passed in as a slice, i.e: []int64, []float64.
 // It returns an error if the resulting tag would be malformed.
 // i.e: mismatched type between value and what would be expected with tag's ID.
 func NewTag(id ID, value any) (_ Tag, err error) {
+	return NewGroupTag(GroupNone, id, value)
+}
+
+// NewGroupTag creates a new tag with the underlying value. The tag's ID is
+// interpreted according to the group g. See [NewTag].
+func NewGroupTag(g Group, id ID, value any) (_ Tag, err error) {
 	inputValueType := Type(0)
 	v, err := toInt(value)
 	if err == nil {
 		inputValueType = TypeInt32
 		value = v
 	}
-	if v, ok := value.(float32); ok {
+	switch c := value.(type) {
+	case float32:
 		inputValueType = TypeFloat64
-		value = float64(v)
+		value = float64(c)
+	case float64, []float64:
+		inputValueType = TypeFloat64
+	case []int64:
+		inputValueType = TypeInt32
 	}
-	idTp := id.Type()
-	if inputValueType.IsInt() != idTp.IsInt() ||
-		inputValueType.IsFloat() != idTp.IsFloat() {
-		return Tag{}, fmt.Errorf("mismatch between value type %s and %q type %s", inputValueType.String(), id.String(), id.Type().String())
+	idTp := id.TypeGroup(g)
+	if idTp != 0 && (inputValueType.IsInt() != idTp.IsInt() ||
+		inputValueType.IsFloat() != idTp.IsFloat()) {
+		return Tag{}, fmt.Errorf("mismatch between value type %s and %q type %s", inputValueType.String(), id.StringGroup(g), idTp.String())
 	}
 
-	return Tag{ID: id, data: value}, nil
+	return Tag{ID: id, Group: g, data: value}, nil
 }
 
 // Type is the set of all types one may encounter when parsing EXIF data.
@@ -160,6 +208,18 @@ const (
 	TypeFloat32
 	// TypeFloat64 can be found as the double type in EXIF spec.
 	TypeFloat64
+	// TypeIFD is a 32 bit offset to an IFD. It is decoded as an unsigned integer.
+	TypeIFD
+)
+
+// BigTIFF types. Values of BigTIFF types are limited to the range of int64.
+const (
+	// TypeUint64 can be found as the LONG8 type in BigTIFF spec.
+	TypeUint64 Type = iota + 16
+	// TypeInt64 can be found as the SLONG8 type in BigTIFF spec.
+	TypeInt64
+	// TypeIFD64 is a 64 bit offset to an IFD, found as the IFD8 type in BigTIFF spec.
+	TypeIFD64
 )
 
 // Group represents the IFD group.
@@ -171,12 +231,86 @@ const (
 	GroupIFD0
 	// IFD of the thumbnail.
 	GroupIFD1
-	// IFD containing digicam's information such as shutter speed, focal length etc.
+	// GroupSubIFD was the group of the ExifIFD.
+	//
+	// Deprecated: LazyDecoder labels the ExifIFD GroupExifIFD. SubIFDs pointed
+	// to by the SubIFDs tag are labeled GroupSubIFD0 through GroupSubIFD7.
 	GroupSubIFD
+	// IFD containing digicam's information such as shutter speed, focal length etc.
+	// Pointed to by the ExifOffset tag in IFD0.
 	GroupExifIFD
+	// IFD containing interoperability information. Pointed to by the InteropOffset tag in the ExifIFD.
 	GroupInteropIFD
+	// IFD containing GPS information. Pointed to by the GPSInfo tag in IFD0.
+	GroupGPS
+	// IFDs pointed to by the SubIFDs tag (0x014a), numbered by their index in the tag's
+	// offset array. DNG and raw images store the full resolution image in these IFDs.
+	// Use [SubIFDGroup] to obtain the group of a given index.
+	GroupSubIFD0
+	GroupSubIFD1
+	GroupSubIFD2
+	GroupSubIFD3
+	GroupSubIFD4
+	GroupSubIFD5
+	GroupSubIFD6
+	GroupSubIFD7
+	// IFD of Canon maker notes, found in the MakerNote tag (0x927c) of the ExifIFD.
+	GroupCanon
+	// Binary arrays of the Canon maker note, decoded as directories whose tag IDs
+	// are the index of the entry in the array. Pointed to by the CanonCameraSettings
+	// (0x0001) and CanonFileInfo (0x0093) tags of the Canon IFD.
+	GroupCanonCameraSettings
+	GroupCanonFileInfo
+	// IFD of Nikon type 3 maker notes, which begin with "Nikon\x00" and contain a TIFF header.
+	GroupNikon
+	// Decrypted LensData (0x0098) of the Nikon maker note. Tag IDs are indices in the
+	// LensData layout of versions 0101 to 0203, to which other versions are mapped.
+	// It also contains the composite LensID tag (0x0100).
+	GroupNikonLensData
+	// Decrypted ShotInfo (0x0091) of the Nikon maker note. Tag IDs are indices in the data.
+	GroupNikonShotInfo
+	// IFD of Sony maker notes, which begin with "SONY DSC " or "SONY CAM " or have no header.
+	GroupSony
+	// IFD of Fujifilm maker notes, which begin with "FUJIFILM" and are always little-endian.
+	GroupFujifilm
+	// IFD of Olympus maker notes, which begin with "OLYMPUS\x00", "OM SYSTEM" or "OLYMP\x00".
+	GroupOlympus
+	// Sub-IFDs of the Olympus maker note, pointed to by the Equipment (0x2010)
+	// and CameraSettings (0x2020) tags of the Olympus IFD.
+	GroupOlympusEquipment
+	GroupOlympusCameraSettings
+	// IFD of Panasonic maker notes, which begin with "Panasonic\x00\x00\x00".
+	GroupPanasonic
+	// IFD of Pentax maker notes, which begin with "AOC\x00" or "PENTAX \x00".
+	GroupPentax
+	// IFD of Apple maker notes, which begin with "Apple iOS\x00" and are big-endian.
+	GroupApple
+	// Binary plist of the RunTime (0x0003) tag of the Apple maker note, which holds the
+	// time since boot of the capture as a CMTime. Tag IDs are assigned to its fields.
+	GroupAppleRunTime
+	// IFD of Samsung type 2 maker notes, which have no header.
+	GroupSamsung
+	// Google HDR+ maker notes, which begin with "HDRP". Tag IDs are offsets in the maker note.
+	GroupGoogle
+	// Directories of maker notes decoded by a [MakerNoteDecoder] which are not assigned
+	// a group by the decoder. It has no tag definitions, so its tags cannot be
+	// described but their values may be read with accessors such as [Tag.Int].
+	GroupMakerNote
 )
 
+// MaxSubIFDs is the maximum number of IFDs pointed to by a SubIFDs tag
+// which are decoded, limited by the number of SubIFD groups.
+const MaxSubIFDs = int(GroupSubIFD7-GroupSubIFD0) + 1
+
+// SubIFDGroup returns the group of the IFD at index i of a SubIFDs tag's
+// offset array. It returns GroupNone if i is not less than MaxSubIFDs.
+func SubIFDGroup(i int) Group {
+	if i < 0 || i >= MaxSubIFDs {
+		return GroupNone
+	}
+	return GroupSubIFD0 + Group(i)
+}
+
 // String returns a human readable representation of the IFD group. i.e: IFD0, IFD1, SubIFD.
 func (g Group) String() (s string) {
 	switch g {
@@ -190,6 +324,46 @@ func (g Group) String() (s string) {
 		s = "ExifIFD"
 	case GroupInteropIFD:
 		s = "InteropIFD"
+	case GroupGPS:
+		s = "GPS"
+	case GroupSubIFD0, GroupSubIFD1, GroupSubIFD2, GroupSubIFD3, GroupSubIFD4, GroupSubIFD5, GroupSubIFD6, GroupSubIFD7:
+		s = "SubIFD" + strconv.Itoa(int(g-GroupSubIFD0))
+	case GroupCanon:
+		s = "Canon"
+	case GroupCanonCameraSettings:
+		s = "CanonCameraSettings"
+	case GroupCanonFileInfo:
+		s = "CanonFileInfo"
+	case GroupNikon:
+		s = "Nikon"
+	case GroupNikonLensData:
+		s = "NikonLensData"
+	case GroupNikonShotInfo:
+		s = "NikonShotInfo"
+	case GroupSony:
+		s = "Sony"
+	case GroupFujifilm:
+		s = "Fujifilm"
+	case GroupOlympus:
+		s = "Olympus"
+	case GroupOlympusEquipment:
+		s = "OlympusEquipment"
+	case GroupOlympusCameraSettings:
+		s = "OlympusCameraSettings"
+	case GroupPanasonic:
+		s = "Panasonic"
+	case GroupPentax:
+		s = "Pentax"
+	case GroupApple:
+		s = "Apple"
+	case GroupAppleRunTime:
+		s = "AppleRunTime"
+	case GroupSamsung:
+		s = "Samsung"
+	case GroupGoogle:
+		s = "Google"
+	case GroupMakerNote:
+		s = "MakerNote"
 	default:
 		s = "<unknown IFD group>"
 	}
@@ -203,9 +377,9 @@ func (tp Type) Size() (s uint8) {
 		s = 1
 	case TypeUint16, TypeInt16:
 		s = 2
-	case TypeUint32, TypeInt32, TypeFloat32:
+	case TypeUint32, TypeInt32, TypeFloat32, TypeIFD:
 		s = 4
-	case TypeRational64, TypeFloat64, TypeURational64:
+	case TypeRational64, TypeFloat64, TypeURational64, TypeUint64, TypeInt64, TypeIFD64:
 		s = 8
 	default:
 		s = 0 // Invalid type.
@@ -240,6 +414,14 @@ func (tp Type) String() (s string) {
 		s = "urational"
 	case TypeURational64:
 		s = "rational"
+	case TypeIFD:
+		s = "ifd"
+	case TypeUint64:
+		s = "uint64"
+	case TypeInt64:
+		s = "int64"
+	case TypeIFD64:
+		s = "ifd64"
 	default:
 		s = "unknown"
 	}
@@ -248,30 +430,44 @@ func (tp Type) String() (s string) {
 
 type ID uint16
 
-// String returns a camel case human readable representation of the ID.
+// String returns a camel case human readable representation of the ID
+// as defined in the standard EXIF tag table. See [ID.StringGroup].
 func (id ID) String() string {
-	tag, ok := getTagdef(id)
+	return id.StringGroup(GroupNone)
+}
+
+// StringGroup returns a camel case human readable representation of the ID
+// as defined in the tag table used by group g.
+func (id ID) StringGroup(g Group) string {
+	tag, ok := getTagdef(g, id)
 	if !ok {
 		return "<unknown EXIF ID>"
 	}
 	return tag.Name
 }
 
-// Type returns the type of data the ID field would contain.
+// Type returns the type of data the ID field would contain as defined in
+// the standard EXIF tag table. See [ID.TypeGroup].
 func (id ID) Type() Type {
-	tg, _ := getTagdef(id)
+	return id.TypeGroup(GroupNone)
+}
+
+// TypeGroup returns the type of data the ID field would contain as defined
+// in the tag table used by group g.
+func (id ID) TypeGroup(g Group) Type {
+	tg, _ := getTagdef(g, id)
 	return tg.Type
 }
 
 // IsMandatory returns true if the tag is specified as mandatory in the EXIF spec.
 func (id ID) IsMandatory() bool {
-	tg, _ := getTagdef(id)
+	tg, _ := getTagdef(GroupNone, id)
 	return tg.flags.IsMandatory()
 }
 
 // IsStaticSize returns true if the ids data array size is of constrained length/size.
 func (id ID) IsStaticSize() bool {
-	tg, _ := getTagdef(id)
+	tg, _ := getTagdef(GroupNone, id)
 	return tg.arrayLen[1] != 0
 }
 
@@ -285,6 +481,16 @@ type tagdef struct {
 	enumString []string
 }
 
+// enumStringOf returns the enum string corresponding to value v.
+func (td *tagdef) enumStringOf(v int64) (string, error) {
+	for i, enum := range td.enum {
+		if enum == v {
+			return td.enumString[i], nil
+		}
+	}
+	return "", fmt.Errorf("%d <unexpected value of Exif enum>", v)
+}
+
 func newflags(unsafe, protected, avoid, writeConstrained, mandatory bool) flags {
 	return flags(b2u8(mandatory) | b2u8(unsafe)<<1 | b2u8(protected)<<2 |
 		b2u8(avoid)<<3 | b2u8(writeConstrained)<<4)
@@ -307,13 +513,13 @@ func b2u8(b bool) uint8 {
 
 // DecodeTypeData takes raw EXIF byte slice data and interprets it according to
 // the Type tp and the byte order. It returns an empty interface containing the
-// interpreted value if err is nil. This function should be used for tags of
-// constrained length.
+// interpreted value if err is nil. Data containing a single value is returned
+// as a scalar, data containing more than one value is returned as a slice.
 // It may return any of the following types:
-//   - int64 for integers.
-//   - float64 for floats.
-//   - rational.U64 for unsigned rational numbers.
-//   - rational.I64 for signed rational numbers.
+//   - int64 or []int64 for integers.
+//   - float64 or []float64 for floats.
+//   - rational.U64 or []rational.U64 for unsigned rational numbers.
+//   - rational.I64 or []rational.I64 for signed rational numbers.
 //   - []byte for undefined type (identical to input data).
 //   - string for String (ASCII) type which is just string(data).
 func DecodeTypeData(tp Type, order binary.ByteOrder, data []byte) (v any, err error) {
@@ -331,39 +537,228 @@ func DecodeTypeData(tp Type, order binary.ByteOrder, data []byte) (v any, err er
 		return data, nil
 	}
 	if count > 1 {
-		return nil, errors.New("slices not implemented yet")
+		return decodeTypeSlice(tp, order, data, count)
+	}
+	switch {
+	case tp.IsInt():
+		v = decodeInt(tp, order, data)
+	case tp == TypeFloat32:
+		v = float64(math.Float32frombits(order.Uint32(data[:4])))
+	case tp == TypeFloat64:
+		v = math.Float64frombits(order.Uint64(data[:8]))
+	case tp == TypeRational64:
+		v, err = rational.DecodeI64(order, data[:8])
+	case tp == TypeURational64:
+		v, err = rational.DecodeU64(order, data[:8])
+	default:
+		return nil, errors.New("unsupported data type: " + tp.String())
+	}
+	return v, err
+}
+
+// EncodeTypeData encodes the value v as EXIF data of Type tp in the given byte
+// order. It is the inverse operation of DecodeTypeData and accepts the types
+// DecodeTypeData returns. Integer values are checked to be within the range of tp.
+// String values are encoded as is, so the caller is responsible for the null terminator.
+// Empty values are encoded as empty data, i.e. a count of zero.
+func EncodeTypeData(tp Type, order binary.ByteOrder, v any) (data []byte, err error) {
+	sz := int(tp.Size())
+	if sz == 0 {
+		return nil, errors.New("invalid type")
+	}
+	switch {
+	case tp.IsBytes():
+		switch c := v.(type) {
+		case string:
+			data = []byte(c)
+		case []byte:
+			data = append([]byte{}, c...)
+		default:
+			return nil, fmt.Errorf("cannot encode %T as %s", v, tp.String())
+		}
+
+	case tp.IsInt():
+		ints, ok := v.([]int64)
+		if !ok {
+			i, err := toInt(v)
+			if err != nil {
+				return nil, fmt.Errorf("cannot encode %T as %s", v, tp.String())
+			}
+			ints = []int64{i}
+		}
+		min, max := tp.intRange()
+		data = make([]byte, sz*len(ints))
+		for i, val := range ints {
+			if val < min || val > max {
+				return nil, fmt.Errorf("value %d out of range for %s", val, tp.String())
+			}
+			switch sz {
+			case 1:
+				data[i] = byte(val)
+			case 2:
+				order.PutUint16(data[i*sz:], uint16(val))
+			case 4:
+				order.PutUint32(data[i*sz:], uint32(val))
+			case 8:
+				order.PutUint64(data[i*sz:], uint64(val))
+			}
+		}
+
+	case tp.IsFloat():
+		floats, ok := v.([]float64)
+		if !ok {
+			switch c := v.(type) {
+			case float64:
+				floats = []float64{c}
+			case float32:
+				floats = []float64{float64(c)}
+			default:
+				return nil, fmt.Errorf("cannot encode %T as %s", v, tp.String())
+			}
+		}
+		data = make([]byte, sz*len(floats))
+		for i, val := range floats {
+			if tp == TypeFloat32 {
+				order.PutUint32(data[i*sz:], math.Float32bits(float32(val)))
+			} else {
+				order.PutUint64(data[i*sz:], math.Float64bits(val))
+			}
+		}
+
+	case tp == TypeURational64:
+		rats, ok := v.([]rational.U64)
+		if !ok {
+			rat, ok := v.(rational.U64)
+			if !ok {
+				return nil, fmt.Errorf("cannot encode %T as %s", v, tp.String())
+			}
+			rats = []rational.U64{rat}
+		}
+		data = make([]byte, sz*len(rats))
+		for i := range rats {
+			rational.EncodeU64(order, data[i*sz:], rats[i])
+		}
+
+	case tp == TypeRational64:
+		rats, ok := v.([]rational.I64)
+		if !ok {
+			rat, ok := v.(rational.I64)
+			if !ok {
+				return nil, fmt.Errorf("cannot encode %T as %s", v, tp.String())
+			}
+			rats = []rational.I64{rat}
+		}
+		data = make([]byte, sz*len(rats))
+		for i := range rats {
+			rational.EncodeI64(order, data[i*sz:], rats[i])
+		}
+
+	default:
+		return nil, errors.New("unsupported data type: " + tp.String())
 	}
+	if data == nil {
+		data = []byte{}
+	}
+	return data, nil
+}
+
+// intRange returns the minimum and maximum values representable by integer type tp.
+func (tp Type) intRange() (min, max int64) {
 	switch tp {
 	case TypeUint8:
-		v = int64(data[0])
+		return 0, math.MaxUint8
 	case TypeUint16:
-		v = int64(order.Uint16(data[:2]))
-	case TypeUint32:
-		v = int64(order.Uint32(data[:4]))
+		return 0, math.MaxUint16
+	case TypeUint32, TypeIFD:
+		return 0, math.MaxUint32
+	case TypeUint64, TypeIFD64:
+		return 0, math.MaxInt64
+	case TypeInt64:
+		return math.MinInt64, math.MaxInt64
 	case TypeInt8:
-		v = int64(int8(data[0]))
+		return math.MinInt8, math.MaxInt8
 	case TypeInt16:
-		v = int64(int16(order.Uint16(data[:2])))
+		return math.MinInt16, math.MaxInt16
 	case TypeInt32:
-		v = int64(int32(order.Uint32(data[:4])))
-	case TypeFloat32:
-		v = float64(math.Float32frombits(order.Uint32(data[:4])))
-	case TypeFloat64:
-		v = math.Float64frombits(order.Uint64(data[:8]))
-	case TypeRational64:
-		v, err = rational.DecodeI64(order, data[:8])
-	case TypeURational64:
-		v, err = rational.DecodeU64(order, data[:8])
+		return math.MinInt32, math.MaxInt32
+	}
+	return 0, -1 // Empty range for non-integer types.
+}
+
+// decodeTypeSlice decodes count values of type tp contained in data.
+// The length of data must be count*tp.Size().
+func decodeTypeSlice(tp Type, order binary.ByteOrder, data []byte, count int) (v any, err error) {
+	sz := int(tp.Size())
+	switch {
+	case tp.IsInt():
+		ints := make([]int64, count)
+		for i := range ints {
+			ints[i] = decodeInt(tp, order, data[i*sz:])
+		}
+		v = ints
+	case tp == TypeFloat32:
+		floats := make([]float64, count)
+		for i := range floats {
+			floats[i] = float64(math.Float32frombits(order.Uint32(data[i*sz:])))
+		}
+		v = floats
+	case tp == TypeFloat64:
+		floats := make([]float64, count)
+		for i := range floats {
+			floats[i] = math.Float64frombits(order.Uint64(data[i*sz:]))
+		}
+		v = floats
+	case tp == TypeRational64:
+		rats := make([]rational.I64, count)
+		for i := range rats {
+			rats[i], err = rational.DecodeI64(order, data[i*sz:])
+			if err != nil {
+				return nil, fmt.Errorf("rational at index %d: %w", i, err)
+			}
+		}
+		v = rats
+	case tp == TypeURational64:
+		rats := make([]rational.U64, count)
+		for i := range rats {
+			rats[i], err = rational.DecodeU64(order, data[i*sz:])
+			if err != nil {
+				return nil, fmt.Errorf("rational at index %d: %w", i, err)
+			}
+		}
+		v = rats
 	default:
 		return nil, errors.New("unsupported data type: " + tp.String())
 	}
-	return v, err
+	return v, nil
+}
+
+// decodeInt decodes a single integer of integer type tp from the start of data.
+func decodeInt(tp Type, order binary.ByteOrder, data []byte) int64 {
+	switch tp {
+	case TypeUint8:
+		return int64(data[0])
+	case TypeUint16:
+		return int64(order.Uint16(data[:2]))
+	case TypeUint32, TypeIFD:
+		return int64(order.Uint32(data[:4]))
+	case TypeUint64, TypeInt64, TypeIFD64:
+		// Unsigned values exceeding the range of int64 wrap around.
+		return int64(order.Uint64(data[:8]))
+	case TypeInt8:
+		return int64(int8(data[0]))
+	case TypeInt16:
+		return int64(int16(order.Uint16(data[:2])))
+	case TypeInt32:
+		return int64(int32(order.Uint32(data[:4])))
+	}
+	panic("unreachable: decodeInt called with non-integer type " + tp.String())
 }
 
-// IsInt returns true if tp is a signed or unsigned integer type.
+// IsInt returns true if tp is a signed or unsigned integer type. IFD offset types are integer types.
 func (tp Type) IsInt() bool {
-	return tp == TypeInt8 || tp == TypeInt16 || tp == TypeInt32 ||
-		tp == TypeUint8 || tp == TypeUint16 || tp == TypeUint32
+	return tp == TypeInt8 || tp == TypeInt16 || tp == TypeInt32 || tp == TypeInt64 ||
+		tp == TypeUint8 || tp == TypeUint16 || tp == TypeUint32 || tp == TypeUint64 ||
+		tp == TypeIFD || tp == TypeIFD64
 }
 
 // IsFloat returns true if tp is of float32 (single) or float64 (double) type.
@@ -382,10 +777,10 @@ func (tp Type) IsBytes() bool {
 }
 
 // Bytes returns the bytes contained in the tag value if the tag is of
-// the TypeString or TypeUndefined Exif tag type.
+// the TypeString or TypeUndefined Exif tag type, or of unknown type.
 func (tag Tag) Bytes() (v []byte, err error) {
-	tp := tag.ID.Type()
-	if tp != TypeString && tp != TypeUndefined {
+	tp := tag.typ()
+	if tp != 0 && tp != TypeString && tp != TypeUndefined {
 		return nil, errors.New("Bytes undefined for type " + tp.String())
 	}
 	switch c := tag.data.(type) {
@@ -403,9 +798,10 @@ func (tag Tag) Bytes() (v []byte, err error) {
 
 // Int returns the integer value contained in the tag if the value is of integer type.
 // This function returns an error if the ID of the tag does not match a integer type
-// (signed or unsigned) or if the type contained is not a integer type.
+// (signed or unsigned) or if the type contained is not a integer type. Tags with
+// IDs of unknown type are not checked against the ID's type.
 func (tag Tag) Int() (int64, error) {
-	if !tag.ID.Type().IsInt() {
+	if tp := tag.typ(); tp != 0 && !tp.IsInt() {
 		return 0, errors.New("exif ID is not of integer type")
 	}
 	if tag.data == nil {
@@ -415,6 +811,9 @@ func (tag Tag) Int() (int64, error) {
 	if ok {
 		return v, nil
 	}
+	if vs, ok := tag.data.([]int64); ok {
+		return 0, fmt.Errorf("tag contains %d integers, use Ints method", len(vs))
+	}
 	v, err := toInt(tag.data)
 	if err == nil {
 		return v, nil
@@ -422,6 +821,28 @@ func (tag Tag) Int() (int64, error) {
 	return 0, fmt.Errorf("tag did not contain integer type %T (%s)", tag.data, err)
 }
 
+// Ints returns the integer values contained in the tag if the value is of integer type.
+// Tags containing a single integer return a slice of length 1.
+// This function returns an error if the ID of the tag does not match a integer type
+// (signed or unsigned) or if the type contained is not a integer type. Tags with
+// IDs of unknown type, such as StripOffsets, are not checked against the ID's type.
+func (tag Tag) Ints() ([]int64, error) {
+	if tp := tag.typ(); tp != 0 && !tp.IsInt() {
+		return nil, errors.New("exif ID is not of integer type")
+	}
+	switch c := tag.data.(type) {
+	case nil:
+		return nil, errors.New("nil tag value")
+	case []int64:
+		return c, nil
+	}
+	v, err := toInt(tag.data)
+	if err != nil {
+		return nil, fmt.Errorf("tag did not contain integer type %T (%s)", tag.data, err)
+	}
+	return []int64{v}, nil
+}
+
 // MustInt returns the integer contained in the tag's value.
 // It is a wrapper around Int that panics if Int returns an error.
 func (tag Tag) MustInt() int64 {
@@ -434,9 +855,9 @@ func (tag Tag) MustInt() int64 {
 
 // Float returns the float32 or float64 value contained in the tag if the value is of float type.
 // This function returns an error if the ID of the tag does not match a float type or if the type
-// contained is not a float type.
+// contained is not a float type. Tags with IDs of unknown type are not checked against the ID's type.
 func (tag Tag) Float() (float64, error) {
-	if !tag.ID.Type().IsFloat() {
+	if tp := tag.typ(); tp != 0 && !tp.IsFloat() {
 		return 0, errors.New("exif ID is not of float type")
 	}
 	if tag.data == nil {
@@ -450,9 +871,33 @@ func (tag Tag) Float() (float64, error) {
 	if ok {
 		return float64(v32), nil
 	}
+	if vs, ok := tag.data.([]float64); ok {
+		return 0, fmt.Errorf("tag contains %d floats, use Floats method", len(vs))
+	}
 	return 0, fmt.Errorf("tag did not contain float type: %T", tag.data)
 }
 
+// Floats returns the float values contained in the tag if the value is of float type.
+// Tags containing a single float return a slice of length 1.
+// This function returns an error if the ID of the tag does not match a float type or if the type
+// contained is not a float type.
+func (tag Tag) Floats() ([]float64, error) {
+	if tp := tag.typ(); tp != 0 && !tp.IsFloat() {
+		return nil, errors.New("exif ID is not of float type")
+	}
+	switch c := tag.data.(type) {
+	case nil:
+		return nil, errors.New("nil tag value")
+	case []float64:
+		return c, nil
+	case float64:
+		return []float64{c}, nil
+	case float32:
+		return []float64{float64(c)}, nil
+	}
+	return nil, fmt.Errorf("tag did not contain float type: %T", tag.data)
+}
+
 // MustFloat returns the float contained in the tag's value.
 // It is a wrapper around Float that panics if Float returns an error.
 func (tag Tag) MustFloat() float64 {
@@ -467,8 +912,9 @@ func (tag Tag) MustFloat() float64 {
 // the value implements the [rational.Rational] interface.
 // This function returns an error if the ID of the tag does not match a rational
 // type or if the type contained does not implement the rational.Rational interface.
+// Tags with IDs of unknown type are not checked against the ID's type.
 func (tag Tag) Rational() (rational.Rational, error) {
-	if !tag.ID.Type().IsRational() {
+	if tp := tag.typ(); tp != 0 && !tp.IsRational() {
 		return nil, errors.New("exif ID is not of rational type")
 	}
 	if tag.data == nil {
@@ -481,6 +927,38 @@ func (tag Tag) Rational() (rational.Rational, error) {
 	return v, nil
 }
 
+// Rationals returns the rational numbers contained in the tag if the value is
+// of signed or unsigned rational type. Tags containing a single rational number
+// return a slice of length 1. The elements of the returned slice are
+// all [rational.U64] or all [rational.I64].
+// This function returns an error if the ID of the tag does not match a rational
+// type or if the type contained is not a rational type.
+func (tag Tag) Rationals() ([]rational.Rational, error) {
+	if tp := tag.typ(); tp != 0 && !tp.IsRational() {
+		return nil, errors.New("exif ID is not of rational type")
+	}
+	var rats []rational.Rational
+	switch c := tag.data.(type) {
+	case nil:
+		return nil, errors.New("nil tag value")
+	case []rational.U64:
+		rats = make([]rational.Rational, len(c))
+		for i := range c {
+			rats[i] = c[i]
+		}
+	case []rational.I64:
+		rats = make([]rational.Rational, len(c))
+		for i := range c {
+			rats[i] = c[i]
+		}
+	case rational.Rational:
+		rats = []rational.Rational{c}
+	default:
+		return nil, fmt.Errorf("tag did not contain a rational type: %T", tag.data)
+	}
+	return rats, nil
+}
+
 // MustRational returns the rational number contained in the tag's value.
 // It is a wrapper around Rational that panics if Rational returns an error.
 func (tag Tag) MustRational() rational.Rational {
@@ -530,9 +1008,26 @@ var (
 	arrayLenInvalid = [2]int{-1, -1}
 )
 
+// tagkey identifies a tag definition by the group of its tag table and ID.
+type tagkey struct {
+	table Group
+	id    ID
+}
+
+// tagTable returns the group which identifies the tag table used for tags in g.
+// Tags of the IFD0, IFD1, ExifIFD, InteropIFD and SubIFD groups share the
+// standard EXIF table, identified by GroupNone. GPS and maker note groups,
+// which follow the SubIFD groups, each have their own table.
+func (g Group) tagTable() Group {
+	if g == GroupGPS || g > GroupSubIFD7 {
+		return g
+	}
+	return GroupNone
+}
+
 //go:inline
-func getTagdef(id ID) (tagdef, bool) {
-	tag, ok := tags[uint16(id)]
+func getTagdef(g Group, id ID) (tagdef, bool) {
+	tag, ok := tags[tagkey{table: g.tagTable(), id: id}]
 	return tag, ok
 	// if int(id) > len(tags) {
 	// 	return tagdef{}, false
@@ -541,7 +1036,7 @@ func getTagdef(id ID) (tagdef, bool) {
 }
 
 func stringTagInt(id ID, value int64) string {
-	tag, ok := getTagdef(id)
+	tag, ok := getTagdef(GroupNone, id)
 	if !ok || len(tag.enum) == 0 {
 		return strconv.FormatInt(value, 10)
 	}
@@ -562,8 +1057,10 @@ func stringTagInt(id ID, value int64) string {
 // The caller can use this offset to create a new reader that starts at the EXIF metadata
 // using [io.NewSectionReader].
 //
-// Note that this function assumes that the file format is compatible with the EXIF
-// standard and that the metadata start is indicated by the "Exif\x00\x00" pattern.
+// JPEG images are searched by walking their marker segments up to the APP1 segment
+// containing EXIF data, see [JPEGSegmentReader]. Other files are searched for
+// the first occurrence of the "Exif\x00\x00" pattern, which assumes that the file format
+// is compatible with the EXIF standard and that the metadata start is indicated by the pattern.
 // If the file does not contain EXIF metadata or uses a different format, this function
 // may return an error or an incorrect offset.
 //
@@ -579,6 +1076,13 @@ func FindStartOffset(rd io.ReaderAt, buffer []byte) (startOffset int64, err erro
 	if err != nil {
 		return -1, err
 	}
+	if n >= 2 && arr[0] == 0xff && arr[1] == markerSOI {
+		app1, err := findJPEGExif(rd)
+		if err != nil {
+			return -1, err
+		}
+		return app1.PayloadOffset() + int64(patternLen), nil
+	}
 	idx := bytes.Index(arr[:n], []byte(pattern))
 	if idx >= 0 {
 		return int64(idx + patternLen), nil // Quick return case.
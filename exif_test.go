@@ -3,7 +3,10 @@ package exif
 import (
 	"encoding/binary"
 	"os"
+	"reflect"
 	"testing"
+
+	"github.com/soypat/exif/rational"
 )
 
 func TestDecodeTypeData_integers(t *testing.T) {
@@ -46,6 +49,95 @@ func TestDecodeTypeData_integers(t *testing.T) {
 		})
 	}
 }
+
+func TestDecodeTypeData_slices(t *testing.T) {
+	testCases := []struct {
+		desc     string
+		data     []byte
+		tp       Type
+		order    binary.ByteOrder
+		expected any
+	}{
+		{
+			desc:     "uint16",
+			data:     []byte{0, 8, 0, 8, 0, 8},
+			tp:       TypeUint16,
+			order:    binary.BigEndian,
+			expected: []int64{8, 8, 8},
+		},
+		{
+			desc:     "int16",
+			data:     []byte{0xff, 0xff, 2, 0},
+			tp:       TypeInt16,
+			order:    binary.LittleEndian,
+			expected: []int64{-1, 2},
+		},
+		{
+			desc:     "float32",
+			data:     []byte{0x3f, 0x80, 0, 0, 0xc0, 0, 0, 0},
+			tp:       TypeFloat32,
+			order:    binary.BigEndian,
+			expected: []float64{1, -2},
+		},
+		{
+			desc:     "urational",
+			data:     []byte{0, 0, 0, 35, 0, 0, 0, 1, 0, 0, 0, 41, 0, 0, 0, 1, 0, 0, 0x12, 0x34, 0, 0, 0, 100},
+			tp:       TypeURational64,
+			order:    binary.BigEndian,
+			expected: []rational.U64{rational.NewU64(35, 1), rational.NewU64(41, 1), rational.NewU64(0x1234, 100)},
+		},
+		{
+			desc:     "rational",
+			data:     []byte{0xff, 0xff, 0xff, 0xff, 2, 0, 0, 0, 1, 0, 0, 0, 3, 0, 0, 0},
+			tp:       TypeRational64,
+			order:    binary.LittleEndian,
+			expected: []rational.I64{rational.NewI64(-1, 2), rational.NewI64(1, 3)},
+		},
+	}
+	for _, tC := range testCases {
+		t.Run(tC.desc, func(t *testing.T) {
+			v, err := DecodeTypeData(tC.tp, tC.order, tC.data)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !reflect.DeepEqual(v, tC.expected) {
+				t.Errorf("mismatch between %v and %v", v, tC.expected)
+			}
+		})
+	}
+}
+
+func TestTagDescribe_slices(t *testing.T) {
+	testCases := []struct {
+		id       ID
+		value    any
+		expected string
+	}{
+		{id: 0x0102, value: []int64{8, 8, 8}, expected: "8 8 8"},                                                                   // BitsPerSample.
+		{id: 0x0111, value: []int64{8, 1032, 2056}, expected: "8 1032 2056"},                                                       // StripOffsets.
+		{id: 0x0128, value: []int64{2, 3}, expected: "inches cm"},                                                                  // ResolutionUnit.
+		{id: 0x013e, value: []rational.U64{rational.NewU64(313, 1000), rational.NewU64(329, 1000)}, expected: "313/1000 329/1000"}, // WhitePoint.
+	}
+	for _, tC := range testCases {
+		tag := Tag{ID: tC.id, data: tC.value}
+		got, err := tag.Describe()
+		if err != nil {
+			t.Fatal(tC.id.String(), err)
+		}
+		if got != tC.expected {
+			t.Errorf("%s: got %q, want %q", tC.id.String(), got, tC.expected)
+		}
+	}
+	ints, err := Tag{ID: 0x0111, data: []int64{8, 1032}}.Ints()
+	if err != nil || len(ints) != 2 {
+		t.Errorf("StripOffsets Ints: got %v, %v", ints, err)
+	}
+	rats, err := Tag{ID: 0x011a, data: rational.NewU64(72, 1)}.Rationals() // XResolution.
+	if err != nil || len(rats) != 1 {
+		t.Errorf("XResolution Rationals: got %v, %v", rats, err)
+	}
+}
+
 func TestFindStartOffset(t *testing.T) {
 	testCases := []struct {
 		desc     string
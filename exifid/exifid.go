@@ -7,9 +7,41 @@ import "github.com/soypat/exif"
 
 // All Exif field/tag IDs.
 const (
+	GPSVersionID                  exif.ID = 0x0000
 	InteropIndex                  exif.ID = 0x0001
+	GPSLatitudeRef                exif.ID = 0x0001
 	InteropVersion                exif.ID = 0x0002
+	GPSLatitude                   exif.ID = 0x0002
+	GPSLongitudeRef               exif.ID = 0x0003
+	GPSLongitude                  exif.ID = 0x0004
+	GPSAltitudeRef                exif.ID = 0x0005
+	GPSAltitude                   exif.ID = 0x0006
+	GPSTimeStamp                  exif.ID = 0x0007
+	GPSSatellites                 exif.ID = 0x0008
+	GPSStatus                     exif.ID = 0x0009
+	GPSMeasureMode                exif.ID = 0x000a
 	ProcessingSoftware            exif.ID = 0x000b
+	GPSDOP                        exif.ID = 0x000b
+	GPSSpeedRef                   exif.ID = 0x000c
+	GPSSpeed                      exif.ID = 0x000d
+	GPSTrackRef                   exif.ID = 0x000e
+	GPSTrack                      exif.ID = 0x000f
+	GPSImgDirectionRef            exif.ID = 0x0010
+	GPSImgDirection               exif.ID = 0x0011
+	GPSMapDatum                   exif.ID = 0x0012
+	GPSDestLatitudeRef            exif.ID = 0x0013
+	GPSDestLatitude               exif.ID = 0x0014
+	GPSDestLongitudeRef           exif.ID = 0x0015
+	GPSDestLongitude              exif.ID = 0x0016
+	GPSDestBearingRef             exif.ID = 0x0017
+	GPSDestBearing                exif.ID = 0x0018
+	GPSDestDistanceRef            exif.ID = 0x0019
+	GPSDestDistance               exif.ID = 0x001a
+	GPSProcessingMethod           exif.ID = 0x001b
+	GPSAreaInformation            exif.ID = 0x001c
+	GPSDateStamp                  exif.ID = 0x001d
+	GPSDifferential               exif.ID = 0x001e
+	GPSHPositioningError          exif.ID = 0x001f
 	SubfileType                   exif.ID = 0x00fe
 	OldSubfileType                exif.ID = 0x00ff
 	ImageWidth                    exif.ID = 0x0100
@@ -478,4 +510,905 @@ const (
 	Shadows                       exif.ID = 0xfe52
 	Brightness                    exif.ID = 0xfe53
 	Smoothness                    exif.ID = 0xfe57
+	MoireFilter                   exif.ID = 0xfe58
+)
+
+// Canon maker note tag IDs.
+const (
+	CanonCameraSettings             exif.ID = 0x0001
+	CanonFocalLength                exif.ID = 0x0002
+	CanonFlashInfo                  exif.ID = 0x0003
+	CanonShotInfo                   exif.ID = 0x0004
+	CanonPanorama                   exif.ID = 0x0005
+	CanonImageType                  exif.ID = 0x0006
+	CanonFirmwareVersion            exif.ID = 0x0007
+	CanonFileNumber                 exif.ID = 0x0008
+	CanonOwnerName                  exif.ID = 0x0009
+	CanonSerialNumber               exif.ID = 0x000c
+	CanonCameraInfo                 exif.ID = 0x000d
+	CanonFileLength                 exif.ID = 0x000e
+	CanonCustomFunctions            exif.ID = 0x000f
+	CanonModelID                    exif.ID = 0x0010
+	CanonMovieInfo                  exif.ID = 0x0011
+	CanonAFInfo                     exif.ID = 0x0012
+	CanonThumbnailImageValidArea    exif.ID = 0x0013
+	CanonSerialNumberFormat         exif.ID = 0x0015
+	CanonSuperMacro                 exif.ID = 0x001a
+	CanonDateStampMode              exif.ID = 0x001c
+	CanonMyColors                   exif.ID = 0x001d
+	CanonFirmwareRevision           exif.ID = 0x001e
+	CanonCategories                 exif.ID = 0x0023
+	CanonFaceDetect1                exif.ID = 0x0024
+	CanonFaceDetect2                exif.ID = 0x0025
+	CanonAFInfo2                    exif.ID = 0x0026
+	CanonImageUniqueID              exif.ID = 0x0028
+	CanonFaceDetect3                exif.ID = 0x002f
+	CanonTimeInfo                   exif.ID = 0x0035
+	CanonBatteryType                exif.ID = 0x0038
+	CanonAFInfo3                    exif.ID = 0x003c
+	CanonRawDataOffset              exif.ID = 0x0081
+	CanonOriginalDecisionDataOffset exif.ID = 0x0083
+	CanonCustomFunctions1D          exif.ID = 0x0090
+	CanonPersonalFunctions          exif.ID = 0x0091
+	CanonPersonalFunctionValues     exif.ID = 0x0092
+	CanonFileInfo                   exif.ID = 0x0093
+	CanonAFPointsInFocus1D          exif.ID = 0x0094
+	CanonLensModel                  exif.ID = 0x0095
+	CanonInternalSerialNumber       exif.ID = 0x0096
+	CanonDustRemovalData            exif.ID = 0x0097
+	CanonCropInfo                   exif.ID = 0x0098
+	CanonCustomFunctions2           exif.ID = 0x0099
+	CanonAspectInfo                 exif.ID = 0x009a
+	CanonProcessingInfo             exif.ID = 0x00a0
+	CanonMeasuredColor              exif.ID = 0x00aa
+	CanonColorSpace                 exif.ID = 0x00b4
+	CanonPreviewImageInfo           exif.ID = 0x00b6
+	CanonVRDOffset                  exif.ID = 0x00d0
+	CanonSensorInfo                 exif.ID = 0x00e0
+	CanonColorData                  exif.ID = 0x4001
+	CanonCRWParam                   exif.ID = 0x4002
+	CanonFlavor                     exif.ID = 0x4005
+	CanonPictureStyleUserDef        exif.ID = 0x4008
+	CanonPictureStylePC             exif.ID = 0x4009
+	CanonCustomPictureStyleFileName exif.ID = 0x4010
+	CanonAFMicroAdj                 exif.ID = 0x4013
+	CanonVignettingCorr             exif.ID = 0x4015
+	CanonVignettingCorr2            exif.ID = 0x4016
+	CanonLightingOpt                exif.ID = 0x4018
+	CanonLensInfo                   exif.ID = 0x4019
+	CanonAmbienceInfo               exif.ID = 0x4020
+	CanonMultiExp                   exif.ID = 0x4021
+	CanonFilterInfo                 exif.ID = 0x4024
+	CanonHDRInfo                    exif.ID = 0x4025
+	CanonAFConfig                   exif.ID = 0x4028
+)
+
+// CanonCameraSettings maker note tag IDs.
+const (
+	CanonCameraSettingsMacroMode          exif.ID = 0x0001
+	CanonCameraSettingsSelfTimer          exif.ID = 0x0002
+	CanonCameraSettingsQuality            exif.ID = 0x0003
+	CanonCameraSettingsFlashMode          exif.ID = 0x0004
+	CanonCameraSettingsContinuousDrive    exif.ID = 0x0005
+	CanonCameraSettingsFocusMode          exif.ID = 0x0007
+	CanonCameraSettingsRecordMode         exif.ID = 0x0009
+	CanonCameraSettingsImageSize          exif.ID = 0x000a
+	CanonCameraSettingsEasyMode           exif.ID = 0x000b
+	CanonCameraSettingsDigitalZoom        exif.ID = 0x000c
+	CanonCameraSettingsContrast           exif.ID = 0x000d
+	CanonCameraSettingsSaturation         exif.ID = 0x000e
+	CanonCameraSettingsSharpness          exif.ID = 0x000f
+	CanonCameraSettingsCameraISO          exif.ID = 0x0010
+	CanonCameraSettingsMeteringMode       exif.ID = 0x0011
+	CanonCameraSettingsFocusRange         exif.ID = 0x0012
+	CanonCameraSettingsAFPoint            exif.ID = 0x0013
+	CanonCameraSettingsExposureMode       exif.ID = 0x0014
+	CanonCameraSettingsLensType           exif.ID = 0x0016
+	CanonCameraSettingsMaxFocalLength     exif.ID = 0x0017
+	CanonCameraSettingsMinFocalLength     exif.ID = 0x0018
+	CanonCameraSettingsFocalUnits         exif.ID = 0x0019
+	CanonCameraSettingsMaxAperture        exif.ID = 0x001a
+	CanonCameraSettingsMinAperture        exif.ID = 0x001b
+	CanonCameraSettingsFlashActivity      exif.ID = 0x001c
+	CanonCameraSettingsFlashBits          exif.ID = 0x001d
+	CanonCameraSettingsFocusContinuous    exif.ID = 0x0020
+	CanonCameraSettingsAESetting          exif.ID = 0x0021
+	CanonCameraSettingsImageStabilization exif.ID = 0x0022
+	CanonCameraSettingsDisplayAperture    exif.ID = 0x0023
+	CanonCameraSettingsZoomSourceWidth    exif.ID = 0x0024
+	CanonCameraSettingsZoomTargetWidth    exif.ID = 0x0025
+	CanonCameraSettingsSpotMeteringMode   exif.ID = 0x0027
+	CanonCameraSettingsPhotoEffect        exif.ID = 0x0028
+	CanonCameraSettingsManualFlashOutput  exif.ID = 0x0029
+	CanonCameraSettingsColorTone          exif.ID = 0x002a
+	CanonCameraSettingsSRAWQuality        exif.ID = 0x002e
+)
+
+// CanonFileInfo maker note tag IDs.
+const (
+	CanonFileInfoShutterCount                exif.ID = 0x0001
+	CanonFileInfoBracketMode                 exif.ID = 0x0003
+	CanonFileInfoBracketValue                exif.ID = 0x0004
+	CanonFileInfoBracketShotNumber           exif.ID = 0x0005
+	CanonFileInfoRawJpgQuality               exif.ID = 0x0006
+	CanonFileInfoRawJpgSize                  exif.ID = 0x0007
+	CanonFileInfoLongExposureNoiseReduction2 exif.ID = 0x0008
+	CanonFileInfoWBBracketMode               exif.ID = 0x0009
+	CanonFileInfoWBBracketValueAB            exif.ID = 0x000c
+	CanonFileInfoWBBracketValueGM            exif.ID = 0x000d
+	CanonFileInfoFilterEffect                exif.ID = 0x000e
+	CanonFileInfoToningEffect                exif.ID = 0x000f
+	CanonFileInfoMacroMagnification          exif.ID = 0x0010
+	CanonFileInfoLiveViewShooting            exif.ID = 0x0013
+	CanonFileInfoFocusDistanceUpper          exif.ID = 0x0014
+	CanonFileInfoFocusDistanceLower          exif.ID = 0x0015
+	CanonFileInfoShutterMode                 exif.ID = 0x0017
+	CanonFileInfoFlashExposureLock           exif.ID = 0x0019
+)
+
+// Nikon maker note tag IDs.
+const (
+	NikonMakerNoteVersion          exif.ID = 0x0001
+	NikonISO                       exif.ID = 0x0002
+	NikonColorMode                 exif.ID = 0x0003
+	NikonQuality                   exif.ID = 0x0004
+	NikonWhiteBalance              exif.ID = 0x0005
+	NikonSharpness                 exif.ID = 0x0006
+	NikonFocusMode                 exif.ID = 0x0007
+	NikonFlashSetting              exif.ID = 0x0008
+	NikonFlashType                 exif.ID = 0x0009
+	NikonWhiteBalanceFineTune      exif.ID = 0x000b
+	NikonWB_RBLevels               exif.ID = 0x000c
+	NikonProgramShift              exif.ID = 0x000d
+	NikonExposureDifference        exif.ID = 0x000e
+	NikonPreviewIFD                exif.ID = 0x0011
+	NikonFlashExposureComp         exif.ID = 0x0012
+	NikonISOSetting                exif.ID = 0x0013
+	NikonImageBoundary             exif.ID = 0x0016
+	NikonExternalFlashExposureComp exif.ID = 0x0017
+	NikonFlashExposureBracketValue exif.ID = 0x0018
+	NikonExposureBracketValue      exif.ID = 0x0019
+	NikonImageProcessing           exif.ID = 0x001a
+	NikonCropHiSpeed               exif.ID = 0x001b
+	NikonExposureTuning            exif.ID = 0x001c
+	NikonSerialNumber              exif.ID = 0x001d
+	NikonColorSpace                exif.ID = 0x001e
+	NikonVRInfo                    exif.ID = 0x001f
+	NikonImageAuthentication       exif.ID = 0x0020
+	NikonActiveDLighting           exif.ID = 0x0022
+	NikonPictureControlData        exif.ID = 0x0023
+	NikonWorldTime                 exif.ID = 0x0024
+	NikonISOInfo                   exif.ID = 0x0025
+	NikonVignetteControl           exif.ID = 0x002a
+	NikonDistortInfo               exif.ID = 0x002b
+	NikonImageAdjustment           exif.ID = 0x0080
+	NikonToneComp                  exif.ID = 0x0081
+	NikonAuxiliaryLens             exif.ID = 0x0082
+	NikonLensType                  exif.ID = 0x0083
+	NikonLens                      exif.ID = 0x0084
+	NikonManualFocusDistance       exif.ID = 0x0085
+	NikonDigitalZoom               exif.ID = 0x0086
+	NikonFlashMode                 exif.ID = 0x0087
+	NikonAFInfo                    exif.ID = 0x0088
+	NikonShootingMode              exif.ID = 0x0089
+	NikonLensFStops                exif.ID = 0x008b
+	NikonContrastCurve             exif.ID = 0x008c
+	NikonColorHue                  exif.ID = 0x008d
+	NikonSceneMode                 exif.ID = 0x008f
+	NikonLightSource               exif.ID = 0x0090
+	NikonShotInfo                  exif.ID = 0x0091
+	NikonHueAdjustment             exif.ID = 0x0092
+	NikonNEFCompression            exif.ID = 0x0093
+	NikonSaturationAdj             exif.ID = 0x0094
+	NikonNoiseReduction            exif.ID = 0x0095
+	NikonNEFLinearizationTable     exif.ID = 0x0096
+	NikonColorBalance              exif.ID = 0x0097
+	NikonLensData                  exif.ID = 0x0098
+	NikonRawImageCenter            exif.ID = 0x0099
+	NikonSensorPixelSize           exif.ID = 0x009a
+	NikonSceneAssist               exif.ID = 0x009c
+	NikonRetouchHistory            exif.ID = 0x009e
+	NikonImageDataSize             exif.ID = 0x00a2
+	NikonImageCount                exif.ID = 0x00a5
+	NikonDeletedImageCount         exif.ID = 0x00a6
+	NikonShutterCount              exif.ID = 0x00a7
+	NikonFlashInfo                 exif.ID = 0x00a8
+	NikonImageOptimization         exif.ID = 0x00a9
+	NikonSaturation                exif.ID = 0x00aa
+	NikonVariProgram               exif.ID = 0x00ab
+	NikonImageStabilization        exif.ID = 0x00ac
+	NikonAFResponse                exif.ID = 0x00ad
+	NikonMultiExposure             exif.ID = 0x00b0
+	NikonHighISONoiseReduction     exif.ID = 0x00b1
+	NikonToningEffect              exif.ID = 0x00b3
+	NikonPowerUpTime               exif.ID = 0x00b6
+	NikonAFInfo2                   exif.ID = 0x00b7
+	NikonFileInfo                  exif.ID = 0x00b8
+	NikonAFTune                    exif.ID = 0x00b9
+	NikonRetouchInfo               exif.ID = 0x00bb
+	NikonPrintIM                   exif.ID = 0x0e00
+	NikonCaptureData               exif.ID = 0x0e01
+	NikonCaptureVersion            exif.ID = 0x0e09
+	NikonCaptureOffsets            exif.ID = 0x0e0e
+	NikonScanIFD                   exif.ID = 0x0e10
+	NikonICCProfile                exif.ID = 0x0e1d
+	NikonCaptureOutput             exif.ID = 0x0e1e
+	NikonNEFBitDepth               exif.ID = 0x0e22
+)
+
+// NikonLensData maker note tag IDs.
+const (
+	NikonLensDataLensDataVersion       exif.ID = 0x0000
+	NikonLensDataExitPupilPosition     exif.ID = 0x0004
+	NikonLensDataAFAperture            exif.ID = 0x0005
+	NikonLensDataFocusPosition         exif.ID = 0x0008
+	NikonLensDataFocusDistance         exif.ID = 0x0009
+	NikonLensDataFocalLength           exif.ID = 0x000a
+	NikonLensDataLensIDNumber          exif.ID = 0x000b
+	NikonLensDataLensFStops            exif.ID = 0x000c
+	NikonLensDataMinFocalLength        exif.ID = 0x000d
+	NikonLensDataMaxFocalLength        exif.ID = 0x000e
+	NikonLensDataMaxApertureAtMinFocal exif.ID = 0x000f
+	NikonLensDataMaxApertureAtMaxFocal exif.ID = 0x0010
+	NikonLensDataMCUVersion            exif.ID = 0x0011
+	NikonLensDataEffectiveMaxAperture  exif.ID = 0x0012
+	NikonLensDataLensID                exif.ID = 0x0100
+)
+
+// NikonShotInfo maker note tag IDs.
+const (
+	NikonShotInfoShotInfoVersion exif.ID = 0x0000
+	NikonShotInfoFirmwareVersion exif.ID = 0x0004
+)
+
+// Sony maker note tag IDs.
+const (
+	SonyQuality                       exif.ID = 0x0102
+	SonyFlashExposureComp             exif.ID = 0x0104
+	SonyTeleconverter                 exif.ID = 0x0105
+	SonyWhiteBalanceFineTune          exif.ID = 0x0112
+	SonyCameraSettings                exif.ID = 0x0114
+	SonyWhiteBalance                  exif.ID = 0x0115
+	SonyExtraInfo                     exif.ID = 0x0116
+	SonyPrintIM                       exif.ID = 0x0e00
+	SonyMultiBurstMode                exif.ID = 0x1000
+	SonyMultiBurstImageWidth          exif.ID = 0x1001
+	SonyMultiBurstImageHeight         exif.ID = 0x1002
+	SonyPanorama                      exif.ID = 0x1003
+	SonyPreviewImage                  exif.ID = 0x2001
+	SonyRating                        exif.ID = 0x2002
+	SonyContrast                      exif.ID = 0x2004
+	SonySaturation                    exif.ID = 0x2005
+	SonySharpness                     exif.ID = 0x2006
+	SonyBrightness                    exif.ID = 0x2007
+	SonyLongExposureNoiseReduction    exif.ID = 0x2008
+	SonyHighISONoiseReduction         exif.ID = 0x2009
+	SonyHDR                           exif.ID = 0x200a
+	SonyMultiFrameNoiseReduction      exif.ID = 0x200b
+	SonyPictureEffect                 exif.ID = 0x200e
+	SonySoftSkinEffect                exif.ID = 0x200f
+	SonyVignettingCorrection          exif.ID = 0x2011
+	SonyLateralChromaticAberration    exif.ID = 0x2012
+	SonyDistortionCorrectionSetting   exif.ID = 0x2013
+	SonyWBShiftAB_GM                  exif.ID = 0x2014
+	SonyAutoPortraitFramed            exif.ID = 0x2016
+	SonyFlashAction                   exif.ID = 0x2017
+	SonyElectronicFrontCurtainShutter exif.ID = 0x201a
+	SonyFocusMode                     exif.ID = 0x201b
+	SonyAFAreaModeSetting             exif.ID = 0x201c
+	SonyFlexibleSpotPosition          exif.ID = 0x201d
+	SonyAFPointSelected               exif.ID = 0x201e
+	SonyAFTracking                    exif.ID = 0x2021
+	SonyMultiFrameNREffect            exif.ID = 0x2023
+	SonyWBShiftAB_GM_Precise          exif.ID = 0x2026
+	SonyFocusLocation                 exif.ID = 0x2027
+	SonyVariableLowPassFilter         exif.ID = 0x2028
+	SonyRAWFileType                   exif.ID = 0x2029
+	SonyPrioritySetInAWB              exif.ID = 0x202b
+	SonyMeteringMode2                 exif.ID = 0x202c
+	SonyExposureStandardAdjustment    exif.ID = 0x202d
+	SonyQuality2                      exif.ID = 0x202e
+	SonyPixelShiftInfo                exif.ID = 0x202f
+	SonySerialNumber                  exif.ID = 0x2031
+	SonyShadows                       exif.ID = 0x2032
+	SonyHighlights                    exif.ID = 0x2033
+	SonyFade                          exif.ID = 0x2034
+	SonySharpnessRange                exif.ID = 0x2035
+	SonyClarity                       exif.ID = 0x2036
+	SonyFocusFrameSize                exif.ID = 0x2037
+	SonyJPEGHEIFSwitch                exif.ID = 0x2039
+	SonyFileFormat                    exif.ID = 0xb000
+	SonyModelID                       exif.ID = 0xb001
+	SonyCreativeStyle                 exif.ID = 0xb020
+	SonyColorTemperature              exif.ID = 0xb021
+	SonyColorCompensationFilter       exif.ID = 0xb022
+	SonySceneMode                     exif.ID = 0xb023
+	SonyZoneMatching                  exif.ID = 0xb024
+	SonyDynamicRangeOptimizer         exif.ID = 0xb025
+	SonyImageStabilization            exif.ID = 0xb026
+	SonyLensType                      exif.ID = 0xb027
+	SonyMinoltaMakerNote              exif.ID = 0xb028
+	SonyColorMode                     exif.ID = 0xb029
+	SonyLensSpec                      exif.ID = 0xb02a
+	SonyFullImageSize                 exif.ID = 0xb02b
+	SonyPreviewImageSize              exif.ID = 0xb02c
+	SonyMacro                         exif.ID = 0xb040
+	SonyExposureMode                  exif.ID = 0xb041
+	SonyAFAreaMode                    exif.ID = 0xb043
+	SonyAFIlluminator                 exif.ID = 0xb044
+	SonyJPEGQuality                   exif.ID = 0xb047
+	SonyFlashLevel                    exif.ID = 0xb048
+	SonyReleaseMode                   exif.ID = 0xb049
+	SonySequenceNumber                exif.ID = 0xb04a
+	SonyAntiBlur                      exif.ID = 0xb04b
+	SonyFocusMode2                    exif.ID = 0xb04e
+	SonyHighISONoiseReduction2        exif.ID = 0xb050
+	SonyIntelligentAuto               exif.ID = 0xb052
+	SonyWhiteBalance2                 exif.ID = 0xb054
+)
+
+// Fujifilm maker note tag IDs.
+const (
+	FujifilmVersion                 exif.ID = 0x0000
+	FujifilmInternalSerialNumber    exif.ID = 0x0010
+	FujifilmQuality                 exif.ID = 0x1000
+	FujifilmSharpness               exif.ID = 0x1001
+	FujifilmWhiteBalance            exif.ID = 0x1002
+	FujifilmSaturation              exif.ID = 0x1003
+	FujifilmContrast                exif.ID = 0x1004
+	FujifilmColorTemperature        exif.ID = 0x1005
+	FujifilmWhiteBalanceFineTune    exif.ID = 0x100a
+	FujifilmNoiseReduction          exif.ID = 0x100b
+	FujifilmHighISONoiseReduction   exif.ID = 0x100e
+	FujifilmFujiFlashMode           exif.ID = 0x1010
+	FujifilmFlashExposureComp       exif.ID = 0x1011
+	FujifilmMacro                   exif.ID = 0x1020
+	FujifilmFocusMode               exif.ID = 0x1021
+	FujifilmAFMode                  exif.ID = 0x1022
+	FujifilmFocusPixel              exif.ID = 0x1023
+	FujifilmSlowSync                exif.ID = 0x1030
+	FujifilmPictureMode             exif.ID = 0x1031
+	FujifilmExposureCount           exif.ID = 0x1032
+	FujifilmEXRAuto                 exif.ID = 0x1033
+	FujifilmEXRMode                 exif.ID = 0x1034
+	FujifilmShadowTone              exif.ID = 0x1040
+	FujifilmHighlightTone           exif.ID = 0x1041
+	FujifilmDigitalZoom             exif.ID = 0x1044
+	FujifilmLensModulationOptimizer exif.ID = 0x1045
+	FujifilmGrainEffectRoughness    exif.ID = 0x1047
+	FujifilmColorChromeEffect       exif.ID = 0x1048
+	FujifilmBWAdjustment            exif.ID = 0x1049
+	FujifilmBWMagentaGreen          exif.ID = 0x104b
+	FujifilmGrainEffectSize         exif.ID = 0x104c
+	FujifilmCropMode                exif.ID = 0x104d
+	FujifilmColorChromeFXBlue       exif.ID = 0x104e
+	FujifilmShutterType             exif.ID = 0x1050
+	FujifilmAutoBracketing          exif.ID = 0x1100
+	FujifilmSequenceNumber          exif.ID = 0x1101
+	FujifilmDriveSettings           exif.ID = 0x1103
+	FujifilmPanoramaAngle           exif.ID = 0x1153
+	FujifilmPanoramaDirection       exif.ID = 0x1154
+	FujifilmAdvancedFilter          exif.ID = 0x1201
+	FujifilmColorMode               exif.ID = 0x1210
+	FujifilmBlurWarning             exif.ID = 0x1300
+	FujifilmFocusWarning            exif.ID = 0x1301
+	FujifilmExposureWarning         exif.ID = 0x1302
+	FujifilmGEImageSize             exif.ID = 0x1304
+	FujifilmDynamicRange            exif.ID = 0x1400
+	FujifilmFilmMode                exif.ID = 0x1401
+	FujifilmDynamicRangeSetting     exif.ID = 0x1402
+	FujifilmDevelopmentDynamicRange exif.ID = 0x1403
+	FujifilmMinFocalLength          exif.ID = 0x1404
+	FujifilmMaxFocalLength          exif.ID = 0x1405
+	FujifilmMaxApertureAtMinFocal   exif.ID = 0x1406
+	FujifilmMaxApertureAtMaxFocal   exif.ID = 0x1407
+	FujifilmAutoDynamicRange        exif.ID = 0x140b
+	FujifilmImageStabilization      exif.ID = 0x1422
+	FujifilmSceneRecognition        exif.ID = 0x1425
+	FujifilmRating                  exif.ID = 0x1431
+	FujifilmImageGeneration         exif.ID = 0x1436
+	FujifilmImageCount              exif.ID = 0x1438
+	FujifilmDRangePriority          exif.ID = 0x1443
+	FujifilmFlickerReduction        exif.ID = 0x1446
+	FujifilmFacesDetected           exif.ID = 0x4100
+	FujifilmFileSource              exif.ID = 0x8000
+	FujifilmOrderNumber             exif.ID = 0x8002
+	FujifilmFrameNumber             exif.ID = 0x8003
+	FujifilmParallax                exif.ID = 0xb211
+)
+
+// Olympus maker note tag IDs.
+const (
+	OlympusThumbnailImage       exif.ID = 0x0100
+	OlympusBodyFirmwareVersion  exif.ID = 0x0104
+	OlympusSpecialMode          exif.ID = 0x0200
+	OlympusQuality              exif.ID = 0x0201
+	OlympusMacro                exif.ID = 0x0202
+	OlympusBWMode               exif.ID = 0x0203
+	OlympusDigitalZoom          exif.ID = 0x0204
+	OlympusFocalPlaneDiagonal   exif.ID = 0x0205
+	OlympusLensDistortionParams exif.ID = 0x0206
+	OlympusCameraType           exif.ID = 0x0207
+	OlympusTextInfo             exif.ID = 0x0208
+	OlympusCameraID             exif.ID = 0x0209
+	OlympusEpsonImageWidth      exif.ID = 0x020b
+	OlympusEpsonImageHeight     exif.ID = 0x020c
+	OlympusEpsonSoftware        exif.ID = 0x020d
+	OlympusPreviewImage         exif.ID = 0x0280
+	OlympusPreCaptureFrames     exif.ID = 0x0300
+	OlympusWhiteBoard           exif.ID = 0x0301
+	OlympusOneTouchWB           exif.ID = 0x0302
+	OlympusWhiteBalanceBracket  exif.ID = 0x0303
+	OlympusWhiteBalanceBias     exif.ID = 0x0304
+	OlympusSceneMode            exif.ID = 0x0403
+	OlympusSerialNumber         exif.ID = 0x0404
+	OlympusFirmware             exif.ID = 0x0405
+	OlympusPrintIM              exif.ID = 0x0e00
+	OlympusDataDump             exif.ID = 0x0f00
+	OlympusShutterSpeedValue    exif.ID = 0x1000
+	OlympusISOValue             exif.ID = 0x1001
+	OlympusApertureValue        exif.ID = 0x1002
+	OlympusBrightnessValue      exif.ID = 0x1003
+	OlympusFlashMode            exif.ID = 0x1004
+	OlympusFlashDevice          exif.ID = 0x1005
+	OlympusExposureCompensation exif.ID = 0x1006
+	OlympusSensorTemperature    exif.ID = 0x1007
+	OlympusLensTemperature      exif.ID = 0x1008
+	OlympusFocusMode            exif.ID = 0x100b
+	OlympusManualFocusDistance  exif.ID = 0x100c
+	OlympusZoomStepCount        exif.ID = 0x100d
+	OlympusFocusStepCount       exif.ID = 0x100e
+	OlympusSharpness            exif.ID = 0x100f
+	OlympusFlashChargeLevel     exif.ID = 0x1010
+	OlympusColorMatrix          exif.ID = 0x1011
+	OlympusBlackLevel           exif.ID = 0x1012
+	OlympusWBMode               exif.ID = 0x1015
+	OlympusRedBalance           exif.ID = 0x1017
+	OlympusBlueBalance          exif.ID = 0x1018
+	OlympusFlashExposureComp    exif.ID = 0x1023
+	OlympusExternalFlashBounce  exif.ID = 0x1026
+	OlympusContrast             exif.ID = 0x1029
+	OlympusSharpnessFactor      exif.ID = 0x102a
+	OlympusColorControl         exif.ID = 0x102b
+	OlympusCompressionRatio     exif.ID = 0x1034
+	OlympusPreviewImageValid    exif.ID = 0x1035
+	OlympusPreviewImageStart    exif.ID = 0x1036
+	OlympusPreviewImageLength   exif.ID = 0x1037
+	OlympusCCDScanMode          exif.ID = 0x1039
+	OlympusNoiseReduction       exif.ID = 0x103a
+	OlympusFocusStepInfinity    exif.ID = 0x103b
+	OlympusFocusStepNear        exif.ID = 0x103c
+	OlympusEquipment            exif.ID = 0x2010
+	OlympusCameraSettings       exif.ID = 0x2020
+	OlympusRawDevelopment       exif.ID = 0x2030
+	OlympusRawDev2              exif.ID = 0x2031
+	OlympusImageProcessing      exif.ID = 0x2040
+	OlympusFocusInfo            exif.ID = 0x2050
+	OlympusRawInfo              exif.ID = 0x3000
+)
+
+// OlympusEquipment maker note tag IDs.
+const (
+	OlympusEquipmentEquipmentVersion        exif.ID = 0x0000
+	OlympusEquipmentCameraType2             exif.ID = 0x0100
+	OlympusEquipmentSerialNumber            exif.ID = 0x0101
+	OlympusEquipmentInternalSerialNumber    exif.ID = 0x0102
+	OlympusEquipmentFocalPlaneDiagonal      exif.ID = 0x0103
+	OlympusEquipmentBodyFirmwareVersion     exif.ID = 0x0104
+	OlympusEquipmentLensType                exif.ID = 0x0201
+	OlympusEquipmentLensSerialNumber        exif.ID = 0x0202
+	OlympusEquipmentLensModel               exif.ID = 0x0203
+	OlympusEquipmentLensFirmwareVersion     exif.ID = 0x0204
+	OlympusEquipmentMaxApertureAtMinFocal   exif.ID = 0x0205
+	OlympusEquipmentMaxApertureAtMaxFocal   exif.ID = 0x0206
+	OlympusEquipmentMinFocalLength          exif.ID = 0x0207
+	OlympusEquipmentMaxFocalLength          exif.ID = 0x0208
+	OlympusEquipmentMaxAperture             exif.ID = 0x020a
+	OlympusEquipmentLensProperties          exif.ID = 0x020b
+	OlympusEquipmentExtender                exif.ID = 0x0301
+	OlympusEquipmentExtenderSerialNumber    exif.ID = 0x0302
+	OlympusEquipmentExtenderModel           exif.ID = 0x0303
+	OlympusEquipmentExtenderFirmwareVersion exif.ID = 0x0304
+	OlympusEquipmentConversionLens          exif.ID = 0x0403
+	OlympusEquipmentFlashType               exif.ID = 0x1000
+	OlympusEquipmentFlashModel              exif.ID = 0x1001
+	OlympusEquipmentFlashFirmwareVersion    exif.ID = 0x1002
+	OlympusEquipmentFlashSerialNumber       exif.ID = 0x1003
+)
+
+// OlympusCameraSettings maker note tag IDs.
+const (
+	OlympusCameraSettingsCameraSettingsVersion   exif.ID = 0x0000
+	OlympusCameraSettingsPreviewImageValid       exif.ID = 0x0100
+	OlympusCameraSettingsPreviewImageStart       exif.ID = 0x0101
+	OlympusCameraSettingsPreviewImageLength      exif.ID = 0x0102
+	OlympusCameraSettingsExposureMode            exif.ID = 0x0200
+	OlympusCameraSettingsAELock                  exif.ID = 0x0201
+	OlympusCameraSettingsMeteringMode            exif.ID = 0x0202
+	OlympusCameraSettingsExposureShift           exif.ID = 0x0203
+	OlympusCameraSettingsNDFilter                exif.ID = 0x0204
+	OlympusCameraSettingsMacroMode               exif.ID = 0x0300
+	OlympusCameraSettingsFocusMode               exif.ID = 0x0301
+	OlympusCameraSettingsFocusProcess            exif.ID = 0x0302
+	OlympusCameraSettingsAFSearch                exif.ID = 0x0303
+	OlympusCameraSettingsAFAreas                 exif.ID = 0x0304
+	OlympusCameraSettingsAFPointSelected         exif.ID = 0x0305
+	OlympusCameraSettingsAFFineTune              exif.ID = 0x0306
+	OlympusCameraSettingsAFFineTuneAdj           exif.ID = 0x0307
+	OlympusCameraSettingsFlashMode               exif.ID = 0x0400
+	OlympusCameraSettingsFlashExposureComp       exif.ID = 0x0401
+	OlympusCameraSettingsWhiteBalance2           exif.ID = 0x0500
+	OlympusCameraSettingsWhiteBalanceTemperature exif.ID = 0x0501
+	OlympusCameraSettingsWhiteBalanceBracket     exif.ID = 0x0502
+	OlympusCameraSettingsCustomSaturation        exif.ID = 0x0503
+	OlympusCameraSettingsModifiedSaturation      exif.ID = 0x0504
+	OlympusCameraSettingsContrastSetting         exif.ID = 0x0505
+	OlympusCameraSettingsSharpnessSetting        exif.ID = 0x0506
+	OlympusCameraSettingsColorSpace              exif.ID = 0x0507
+	OlympusCameraSettingsSceneMode               exif.ID = 0x0509
+	OlympusCameraSettingsNoiseReduction          exif.ID = 0x050a
+	OlympusCameraSettingsDistortionCorrection    exif.ID = 0x050b
+	OlympusCameraSettingsShadingCompensation     exif.ID = 0x050c
+	OlympusCameraSettingsCompressionFactor       exif.ID = 0x050d
+	OlympusCameraSettingsGradation               exif.ID = 0x050f
+	OlympusCameraSettingsPictureMode             exif.ID = 0x0520
+	OlympusCameraSettingsPictureModeSaturation   exif.ID = 0x0521
+	OlympusCameraSettingsNoiseFilter             exif.ID = 0x0527
+	OlympusCameraSettingsArtFilter               exif.ID = 0x052c
+	OlympusCameraSettingsMagicFilter             exif.ID = 0x052f
+	OlympusCameraSettingsDriveMode               exif.ID = 0x0600
+	OlympusCameraSettingsPanoramaMode            exif.ID = 0x0601
+	OlympusCameraSettingsImageQuality2           exif.ID = 0x0603
+	OlympusCameraSettingsImageStabilization      exif.ID = 0x0604
+	OlympusCameraSettingsStackedImage            exif.ID = 0x0804
+	OlympusCameraSettingsManometerPressure       exif.ID = 0x0900
+	OlympusCameraSettingsManometerReading        exif.ID = 0x0901
+	OlympusCameraSettingsExtendedWBDetect        exif.ID = 0x0902
+	OlympusCameraSettingsRollAngle               exif.ID = 0x0903
+	OlympusCameraSettingsPitchAngle              exif.ID = 0x0904
+	OlympusCameraSettingsDateTimeUTC             exif.ID = 0x0908
+)
+
+// Panasonic maker note tag IDs.
+const (
+	PanasonicImageQuality               exif.ID = 0x0001
+	PanasonicFirmwareVersion            exif.ID = 0x0002
+	PanasonicWhiteBalance               exif.ID = 0x0003
+	PanasonicFocusMode                  exif.ID = 0x0007
+	PanasonicAFAreaMode                 exif.ID = 0x000f
+	PanasonicImageStabilization         exif.ID = 0x001a
+	PanasonicMacroMode                  exif.ID = 0x001c
+	PanasonicShootingMode               exif.ID = 0x001f
+	PanasonicAudio                      exif.ID = 0x0020
+	PanasonicDataDump                   exif.ID = 0x0021
+	PanasonicWhiteBalanceBias           exif.ID = 0x0023
+	PanasonicFlashBias                  exif.ID = 0x0024
+	PanasonicInternalSerialNumber       exif.ID = 0x0025
+	PanasonicExifVersion                exif.ID = 0x0026
+	PanasonicColorEffect                exif.ID = 0x0028
+	PanasonicTimeSincePowerOn           exif.ID = 0x0029
+	PanasonicBurstMode                  exif.ID = 0x002a
+	PanasonicSequenceNumber             exif.ID = 0x002b
+	PanasonicContrastMode               exif.ID = 0x002c
+	PanasonicNoiseReduction             exif.ID = 0x002d
+	PanasonicSelfTimer                  exif.ID = 0x002e
+	PanasonicRotation                   exif.ID = 0x0030
+	PanasonicAFAssistLamp               exif.ID = 0x0031
+	PanasonicColorMode                  exif.ID = 0x0032
+	PanasonicBabyAge                    exif.ID = 0x0033
+	PanasonicOpticalZoomMode            exif.ID = 0x0034
+	PanasonicConversionLens             exif.ID = 0x0035
+	PanasonicTravelDay                  exif.ID = 0x0036
+	PanasonicContrast                   exif.ID = 0x0039
+	PanasonicWorldTimeLocation          exif.ID = 0x003a
+	PanasonicTextStamp                  exif.ID = 0x003b
+	PanasonicProgramISO                 exif.ID = 0x003c
+	PanasonicAdvancedSceneType          exif.ID = 0x003d
+	PanasonicFacesDetected              exif.ID = 0x003f
+	PanasonicSaturation                 exif.ID = 0x0040
+	PanasonicSharpness                  exif.ID = 0x0041
+	PanasonicFilmMode                   exif.ID = 0x0042
+	PanasonicColorTempKelvin            exif.ID = 0x0044
+	PanasonicBracketSettings            exif.ID = 0x0045
+	PanasonicWBShiftAB                  exif.ID = 0x0046
+	PanasonicWBShiftGM                  exif.ID = 0x0047
+	PanasonicFlashCurtain               exif.ID = 0x0048
+	PanasonicLongExposureNoiseReduction exif.ID = 0x0049
+	PanasonicImageWidth                 exif.ID = 0x004b
+	PanasonicImageHeight                exif.ID = 0x004c
+	PanasonicAFPointPosition            exif.ID = 0x004d
+	PanasonicFaceDetInfo                exif.ID = 0x004e
+	PanasonicLensType                   exif.ID = 0x0051
+	PanasonicLensSerialNumber           exif.ID = 0x0052
+	PanasonicAccessoryType              exif.ID = 0x0053
+	PanasonicAccessorySerialNumber      exif.ID = 0x0054
+	PanasonicTransform                  exif.ID = 0x0059
+	PanasonicIntelligentExposure        exif.ID = 0x005d
+	PanasonicLensFirmwareVersion        exif.ID = 0x0060
+	PanasonicFaceRecInfo                exif.ID = 0x0061
+	PanasonicFlashWarning               exif.ID = 0x0062
+	PanasonicBabyName                   exif.ID = 0x0066
+	PanasonicLocation                   exif.ID = 0x0067
+	PanasonicCountry                    exif.ID = 0x0069
+	PanasonicState                      exif.ID = 0x006b
+	PanasonicCity                       exif.ID = 0x006d
+	PanasonicLandmark                   exif.ID = 0x006f
+	PanasonicIntelligentResolution      exif.ID = 0x0070
+	PanasonicBurstSpeed                 exif.ID = 0x0077
+	PanasonicIntelligentDRange          exif.ID = 0x0079
+	PanasonicClearRetouch               exif.ID = 0x007c
+	PanasonicCity2                      exif.ID = 0x0080
+	PanasonicManometerPressure          exif.ID = 0x0086
+	PanasonicPhotoStyle                 exif.ID = 0x0089
+	PanasonicShadingCompensation        exif.ID = 0x008a
+	PanasonicAccelerometerZ             exif.ID = 0x008c
+	PanasonicAccelerometerX             exif.ID = 0x008d
+	PanasonicAccelerometerY             exif.ID = 0x008e
+	PanasonicCameraOrientation          exif.ID = 0x008f
+	PanasonicRollAngle                  exif.ID = 0x0090
+	PanasonicPitchAngle                 exif.ID = 0x0091
+	PanasonicSweepPanoramaDirection     exif.ID = 0x0093
+	PanasonicTimerRecording             exif.ID = 0x0096
+	PanasonicInternalNDFilter           exif.ID = 0x009d
+	PanasonicHDR                        exif.ID = 0x009e
+	PanasonicShutterType                exif.ID = 0x009f
+	PanasonicClearRetouchValue          exif.ID = 0x00a3
+	PanasonicOutputLUT                  exif.ID = 0x00a7
+	PanasonicTouchAE                    exif.ID = 0x00ab
+	PanasonicTimeStamp                  exif.ID = 0x00af
+	PanasonicMultiExposure              exif.ID = 0x00b4
+	PanasonicRedEyeRemoval              exif.ID = 0x00b9
+	PanasonicVideoBurstMode             exif.ID = 0x00bb
+	PanasonicDiffractionCorrection      exif.ID = 0x00bc
+	PanasonicLensTypeMake               exif.ID = 0x00c4
+	PanasonicLensTypeModel              exif.ID = 0x00c5
+	PanasonicISO                        exif.ID = 0x00d1
+	PanasonicMonochromeGrainEffect      exif.ID = 0x00d2
+	PanasonicNoiseReductionStrength     exif.ID = 0x00d6
+	PanasonicPrintIM                    exif.ID = 0x0e00
+	PanasonicMakerNoteVersion           exif.ID = 0x8000
+	PanasonicSceneMode                  exif.ID = 0x8001
+	PanasonicWBRedLevel                 exif.ID = 0x8004
+	PanasonicWBGreenLevel               exif.ID = 0x8005
+	PanasonicWBBlueLevel                exif.ID = 0x8006
+	PanasonicFlashFired                 exif.ID = 0x8007
+	PanasonicBabyAge2                   exif.ID = 0x8010
+	PanasonicTransform2                 exif.ID = 0x8012
+)
+
+// Pentax maker note tag IDs.
+const (
+	PentaxVersion                    exif.ID = 0x0000
+	PentaxModelType                  exif.ID = 0x0001
+	PentaxPreviewImageSize           exif.ID = 0x0002
+	PentaxPreviewImageLength         exif.ID = 0x0003
+	PentaxPreviewImageStart          exif.ID = 0x0004
+	PentaxModelID                    exif.ID = 0x0005
+	PentaxDate                       exif.ID = 0x0006
+	PentaxTime                       exif.ID = 0x0007
+	PentaxQuality                    exif.ID = 0x0008
+	PentaxImageSize                  exif.ID = 0x0009
+	PentaxPictureMode                exif.ID = 0x000b
+	PentaxFlashMode                  exif.ID = 0x000c
+	PentaxFocusMode                  exif.ID = 0x000d
+	PentaxAFPointSelected            exif.ID = 0x000e
+	PentaxAFPointsInFocus            exif.ID = 0x000f
+	PentaxFocusPosition              exif.ID = 0x0010
+	PentaxExposureTime               exif.ID = 0x0012
+	PentaxFNumber                    exif.ID = 0x0013
+	PentaxISO                        exif.ID = 0x0014
+	PentaxLightReading               exif.ID = 0x0015
+	PentaxExposureCompensation       exif.ID = 0x0016
+	PentaxMeteringMode               exif.ID = 0x0017
+	PentaxAutoBracketing             exif.ID = 0x0018
+	PentaxWhiteBalance               exif.ID = 0x0019
+	PentaxWhiteBalanceMode           exif.ID = 0x001a
+	PentaxBlueBalance                exif.ID = 0x001b
+	PentaxRedBalance                 exif.ID = 0x001c
+	PentaxFocalLength                exif.ID = 0x001d
+	PentaxDigitalZoom                exif.ID = 0x001e
+	PentaxSaturation                 exif.ID = 0x001f
+	PentaxContrast                   exif.ID = 0x0020
+	PentaxSharpness                  exif.ID = 0x0021
+	PentaxWorldTimeLocation          exif.ID = 0x0022
+	PentaxHometownCity               exif.ID = 0x0023
+	PentaxDestinationCity            exif.ID = 0x0024
+	PentaxHometownDST                exif.ID = 0x0025
+	PentaxDestinationDST             exif.ID = 0x0026
+	PentaxDSPFirmwareVersion         exif.ID = 0x0027
+	PentaxCPUFirmwareVersion         exif.ID = 0x0028
+	PentaxFrameNumber                exif.ID = 0x0029
+	PentaxEffectiveLV                exif.ID = 0x002d
+	PentaxImageEditing               exif.ID = 0x0032
+	PentaxPictureMode2               exif.ID = 0x0033
+	PentaxDriveMode                  exif.ID = 0x0034
+	PentaxSensorSize                 exif.ID = 0x0035
+	PentaxColorSpace                 exif.ID = 0x0037
+	PentaxImageAreaOffset            exif.ID = 0x0038
+	PentaxRawImageSize               exif.ID = 0x0039
+	PentaxPreviewImageBorders        exif.ID = 0x003e
+	PentaxLensRec                    exif.ID = 0x003f
+	PentaxSensitivityAdjust          exif.ID = 0x0040
+	PentaxImageEditCount             exif.ID = 0x0041
+	PentaxCameraTemperature          exif.ID = 0x0047
+	PentaxAELock                     exif.ID = 0x0048
+	PentaxNoiseReduction             exif.ID = 0x0049
+	PentaxFlashExposureComp          exif.ID = 0x004d
+	PentaxImageTone                  exif.ID = 0x004f
+	PentaxColorTemperature           exif.ID = 0x0050
+	PentaxShakeReductionInfo         exif.ID = 0x005c
+	PentaxShutterCount               exif.ID = 0x005d
+	PentaxFaceInfo                   exif.ID = 0x0060
+	PentaxRawDevelopmentProcess      exif.ID = 0x0062
+	PentaxHue                        exif.ID = 0x0067
+	PentaxAWBInfo                    exif.ID = 0x0068
+	PentaxDynamicRangeExpansion      exif.ID = 0x0069
+	PentaxTimeInfo                   exif.ID = 0x006b
+	PentaxHighLowKeyAdj              exif.ID = 0x006c
+	PentaxContrastHighlight          exif.ID = 0x006d
+	PentaxContrastShadow             exif.ID = 0x006e
+	PentaxContrastHighlightShadowAdj exif.ID = 0x006f
+	PentaxFineSharpness              exif.ID = 0x0070
+	PentaxHighISONoiseReduction      exif.ID = 0x0071
+	PentaxAFAdjustment               exif.ID = 0x0072
+	PentaxMonochromeFilterEffect     exif.ID = 0x0073
+	PentaxMonochromeToning           exif.ID = 0x0074
+	PentaxFaceDetect                 exif.ID = 0x0076
+	PentaxFaceDetectFrameSize        exif.ID = 0x0077
+	PentaxShadowCorrection           exif.ID = 0x0079
+	PentaxISOAutoParameters          exif.ID = 0x007a
+	PentaxCrossProcess               exif.ID = 0x007b
+	PentaxLensCorr                   exif.ID = 0x007d
+	PentaxWhiteLevel                 exif.ID = 0x007e
+	PentaxBleachBypassToning         exif.ID = 0x007f
+	PentaxAspectRatio                exif.ID = 0x0080
+	PentaxBlurControl                exif.ID = 0x0082
+	PentaxHDR                        exif.ID = 0x0085
+	PentaxShutterType                exif.ID = 0x0087
+	PentaxNeutralDensityFilter       exif.ID = 0x0088
+	PentaxISO2                       exif.ID = 0x008b
+	PentaxIntervalShooting           exif.ID = 0x0092
+	PentaxSkinToneCorrection         exif.ID = 0x0095
+	PentaxClarityControl             exif.ID = 0x0096
+	PentaxBlackPoint                 exif.ID = 0x0200
+	PentaxWhitePoint                 exif.ID = 0x0201
+	PentaxColorMatrixA               exif.ID = 0x0203
+	PentaxColorMatrixB               exif.ID = 0x0204
+	PentaxCameraSettings             exif.ID = 0x0205
+	PentaxAEInfo                     exif.ID = 0x0206
+	PentaxLensInfo                   exif.ID = 0x0207
+	PentaxFlashInfo                  exif.ID = 0x0208
+	PentaxAEMeteringSegments         exif.ID = 0x0209
+	PentaxFlashMeteringSegments      exif.ID = 0x020a
+	PentaxSlaveFlashMeteringSegments exif.ID = 0x020b
+	PentaxWB_RGGBLevelsDaylight      exif.ID = 0x020d
+	PentaxCameraInfo                 exif.ID = 0x0215
+	PentaxBatteryInfo                exif.ID = 0x0216
+	PentaxAFInfo                     exif.ID = 0x021f
+	PentaxHuffmanTable               exif.ID = 0x0220
+	PentaxKelvinWB                   exif.ID = 0x0221
+	PentaxColorInfo                  exif.ID = 0x0222
+	PentaxEVStepInfo                 exif.ID = 0x0224
+	PentaxShotInfo                   exif.ID = 0x0226
+	PentaxFacePos                    exif.ID = 0x0227
+	PentaxFaceSize                   exif.ID = 0x0228
+	PentaxSerialNumber               exif.ID = 0x0229
+	PentaxFilterInfo                 exif.ID = 0x022a
+	PentaxLevelInfo                  exif.ID = 0x022b
+	PentaxArtist                     exif.ID = 0x022e
+	PentaxCopyright                  exif.ID = 0x022f
+	PentaxFirmwareVersion            exif.ID = 0x0230
+	PentaxContrastDetectAFArea       exif.ID = 0x0231
+	PentaxCrossProcessParams         exif.ID = 0x0235
+	PentaxLensInfoQ                  exif.ID = 0x0239
+	PentaxModel                      exif.ID = 0x023f
+	PentaxPixelShiftInfo             exif.ID = 0x0243
+	PentaxAFPointInfo                exif.ID = 0x0245
+	PentaxDataDump                   exif.ID = 0x03fe
+	PentaxTempInfo                   exif.ID = 0x03ff
+	PentaxToneCurve                  exif.ID = 0x0402
+	PentaxToneCurves                 exif.ID = 0x0403
+	PentaxPrintIM                    exif.ID = 0x0e00
+)
+
+// Apple maker note tag IDs.
+const (
+	AppleMakerNoteVersion           exif.ID = 0x0001
+	AppleAEMatrix                   exif.ID = 0x0002
+	AppleRunTime                    exif.ID = 0x0003
+	AppleAEStable                   exif.ID = 0x0004
+	AppleAETarget                   exif.ID = 0x0005
+	AppleAEAverage                  exif.ID = 0x0006
+	AppleAFStable                   exif.ID = 0x0007
+	AppleAccelerationVector         exif.ID = 0x0008
+	AppleHDRImageType               exif.ID = 0x000a
+	AppleBurstUUID                  exif.ID = 0x000b
+	AppleFocusDistanceRange         exif.ID = 0x000c
+	AppleOISMode                    exif.ID = 0x000f
+	AppleContentIdentifier          exif.ID = 0x0011
+	AppleImageCaptureType           exif.ID = 0x0014
+	AppleImageUniqueID              exif.ID = 0x0015
+	AppleLivePhotoVideoIndex        exif.ID = 0x0017
+	AppleImageProcessingFlags       exif.ID = 0x0019
+	AppleQualityHint                exif.ID = 0x001a
+	AppleLuminanceNoiseAmplitude    exif.ID = 0x001d
+	ApplePhotosAppFeatureFlags      exif.ID = 0x001f
+	AppleImageCaptureRequestID      exif.ID = 0x0020
+	AppleHDRHeadroom                exif.ID = 0x0021
+	AppleAFPerformance              exif.ID = 0x0023
+	AppleSceneFlags                 exif.ID = 0x0025
+	AppleSignalToNoiseRatioType     exif.ID = 0x0026
+	AppleSignalToNoiseRatio         exif.ID = 0x0027
+	ApplePhotoIdentifier            exif.ID = 0x002b
+	AppleColorTemperature           exif.ID = 0x002d
+	AppleCameraType                 exif.ID = 0x002e
+	AppleFocusPosition              exif.ID = 0x002f
+	AppleHDRGain                    exif.ID = 0x0030
+	AppleAFMeasuredDepth            exif.ID = 0x0038
+	AppleAFConfidence               exif.ID = 0x003d
+	AppleColorCorrectionMatrix      exif.ID = 0x003e
+	AppleGreenGhostMitigationStatus exif.ID = 0x003f
+	AppleSemanticStyle              exif.ID = 0x0040
+	AppleSemanticStyleRenderingVer  exif.ID = 0x0041
+	AppleSemanticStylePreset        exif.ID = 0x0042
+)
+
+// AppleRunTime maker note tag IDs.
+const (
+	AppleRunTimeRunTimeFlags     exif.ID = 0x0000
+	AppleRunTimeRunTimeValue     exif.ID = 0x0001
+	AppleRunTimeRunTimeScale     exif.ID = 0x0002
+	AppleRunTimeRunTimeEpoch     exif.ID = 0x0003
+	AppleRunTimeRunTimeSinceBoot exif.ID = 0x0004
+)
+
+// Samsung maker note tag IDs.
+const (
+	SamsungMakerNoteVersion          exif.ID = 0x0001
+	SamsungDeviceType                exif.ID = 0x0002
+	SamsungModelID                   exif.ID = 0x0003
+	SamsungPictureWizard             exif.ID = 0x0021
+	SamsungLocalLocationName         exif.ID = 0x0030
+	SamsungLocationName              exif.ID = 0x0031
+	SamsungPreviewIFD                exif.ID = 0x0035
+	SamsungRawDataByteOrder          exif.ID = 0x0040
+	SamsungWhiteBalanceSetup         exif.ID = 0x0041
+	SamsungCameraTemperature         exif.ID = 0x0043
+	SamsungRawDataCFAPattern         exif.ID = 0x0050
+	SamsungFaceDetect                exif.ID = 0x0100
+	SamsungFaceRecognition           exif.ID = 0x0120
+	SamsungFaceName                  exif.ID = 0x0123
+	SamsungFirmwareName              exif.ID = 0xa001
+	SamsungSerialNumber              exif.ID = 0xa002
+	SamsungLensType                  exif.ID = 0xa003
+	SamsungLensFirmware              exif.ID = 0xa004
+	SamsungInternalLensSerialNumber  exif.ID = 0xa005
+	SamsungSensorAreas               exif.ID = 0xa010
+	SamsungColorSpace                exif.ID = 0xa011
+	SamsungSmartRange                exif.ID = 0xa012
+	SamsungExposureCompensation      exif.ID = 0xa013
+	SamsungISO                       exif.ID = 0xa014
+	SamsungExposureTime              exif.ID = 0xa018
+	SamsungFNumber                   exif.ID = 0xa019
+	SamsungFocalLengthIn35mmFormat   exif.ID = 0xa01a
+	SamsungEncryptionKey             exif.ID = 0xa020
+	SamsungWB_RGGBLevelsUncorrected  exif.ID = 0xa021
+	SamsungWB_RGGBLevelsAuto         exif.ID = 0xa022
+	SamsungWB_RGGBLevelsIlluminator1 exif.ID = 0xa023
+	SamsungWB_RGGBLevelsIlluminator2 exif.ID = 0xa024
+	SamsungWB_RGGBLevelsBlack        exif.ID = 0xa028
+	SamsungColorMatrix               exif.ID = 0xa030
+	SamsungColorMatrixSRGB           exif.ID = 0xa031
+	SamsungColorMatrixAdobeRGB       exif.ID = 0xa032
+	SamsungToneCurve1                exif.ID = 0xa040
+	SamsungToneCurve2                exif.ID = 0xa041
+	SamsungToneCurve3                exif.ID = 0xa042
+	SamsungToneCurve4                exif.ID = 0xa043
+)
+
+// Google maker note tag IDs.
+const (
+	GoogleHDRPSignature exif.ID = 0x0000
+	GoogleHDRPVersion   exif.ID = 0x0004
+	GoogleHDRPData      exif.ID = 0x0005
 )
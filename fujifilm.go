@@ -0,0 +1,25 @@
+package exif
+
+import (
+	"encoding/binary"
+	"errors"
+	"strings"
+)
+
+// fujifilmHeader begins Fujifilm maker notes. It is followed by the little-endian
+// offset of the IFD. Offsets are relative to the start of the maker note.
+const fujifilmHeader = "FUJIFILM"
+
+func matchFujifilm(make string, header []byte) bool {
+	return strings.HasPrefix(string(header), fujifilmHeader)
+}
+
+// fujifilmIFD returns the location of the Fujifilm maker note IFD. Fujifilm maker
+// notes are little-endian regardless of the byte order of the EXIF data.
+func fujifilmIFD(header []byte, offset int64, order binary.ByteOrder) (int64, int64, binary.ByteOrder, error) {
+	if len(header) < len(fujifilmHeader)+4 {
+		return 0, 0, nil, errors.New("short Fujifilm maker note")
+	}
+	ifd := binary.LittleEndian.Uint32(header[len(fujifilmHeader):])
+	return offset + int64(ifd), offset, binary.LittleEndian, nil
+}
@@ -0,0 +1,11 @@
+package exif
+
+import "strings"
+
+// googleHeader begins Google HDR+ maker notes. It is followed by a version byte
+// and the HDR+ data, which is obfuscated and compressed and is not decoded.
+const googleHeader = "HDRP"
+
+func matchGoogle(make string, header []byte) bool {
+	return strings.HasPrefix(string(header), googleHeader)
+}
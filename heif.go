@@ -0,0 +1,328 @@
+package exif
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+)
+
+// heifBrands are the ftyp brands of HEIF images, including HEIC and AVIF,
+// which store items such as EXIF metadata in the meta box.
+var heifBrands = [...]string{
+	"mif1", "msf1", "mif2", "heic", "heix", "heim", "heis", "hevc", "hevx", "hevm", "hevs", "avif", "avis",
+}
+
+// maxBMFFMetaSize limits the size of the meta box children read into memory.
+const maxBMFFMetaSize = 16 << 20
+
+// heifItem is an item of a HEIF image located by the iloc box.
+type heifItem struct {
+	id uint32
+	// construction method of the item: 0 for file offsets, 1 for idat box offsets.
+	construction uint8
+	baseOffset   int64
+	extents      []heifExtent
+}
+
+type heifExtent struct {
+	offset, length int64
+}
+
+// FindHEIFExif searches the HEIF image in r, such as a HEIC or AVIF image, for
+// its Exif item and returns a reader of the TIFF formatted EXIF data, which may
+// be passed to [LazyDecoder.Decode].
+//
+// The item is found by walking the ftyp, meta, iinf, iloc and iref boxes. If the
+// image contains several Exif items the one describing the primary item is used.
+// Exif items stored in a single extent are read from r, items split across
+// several extents are read into memory.
+func FindHEIFExif(r io.ReaderAt) (*io.SectionReader, error) {
+	offset, size, data, err := findHEIFExif(r)
+	if err != nil {
+		return nil, err
+	}
+	if data != nil {
+		return io.NewSectionReader(bytes.NewReader(data), 0, int64(len(data))), nil
+	}
+	return io.NewSectionReader(r, offset, size), nil
+}
+
+// findHEIFExif returns the offset and size of the EXIF data of the HEIF image in r.
+// If the Exif item is split across several extents its data is returned instead.
+func findHEIFExif(r io.ReaderAt) (offset, size int64, data []byte, err error) {
+	ftyp, err := readBMFFBox(r, 0, math.MaxInt64)
+	if err != nil {
+		return 0, 0, nil, noEOF(err)
+	}
+	if ftyp.typ != "ftyp" {
+		return 0, 0, nil, errors.New("missing ISOBMFF ftyp box")
+	}
+	b, err := readBMFFPayload(r, ftyp, 4096)
+	if err != nil {
+		return 0, 0, nil, err
+	}
+	if !isHEIF(b) {
+		return 0, 0, nil, errors.New("ISOBMFF file is not a HEIF image")
+	}
+	meta, err := findBMFFBox(r, ftyp.end(), math.MaxInt64, "meta")
+	if err != nil {
+		return 0, 0, nil, err
+	}
+	item, idat, err := findHEIFExifItem(r, meta)
+	if err != nil {
+		return 0, 0, nil, err
+	}
+	var base int64
+	switch item.construction {
+	case 0:
+	case 1:
+		if idat.typ == "" {
+			return 0, 0, nil, errors.New("HEIF Exif item references missing idat box")
+		}
+		base = idat.offset
+	default:
+		return 0, 0, nil, fmt.Errorf("unsupported HEIF item construction method %d", item.construction)
+	}
+	base += item.baseOffset
+	if len(item.extents) == 0 {
+		return 0, 0, nil, errors.New("HEIF Exif item has no extents")
+	}
+	for _, ext := range item.extents {
+		if base < 0 || ext.offset < 0 || ext.length < 0 || base+ext.offset < 0 {
+			return 0, 0, nil, errors.New("invalid HEIF Exif item extent")
+		}
+	}
+	var hdr [4]byte
+	if len(item.extents) == 1 {
+		ext := item.extents[0]
+		if ext.length < 4 && ext.length != 0 {
+			return 0, 0, nil, errors.New("short HEIF Exif item")
+		}
+		_, err = r.ReadAt(hdr[:], base+ext.offset)
+		if err != nil {
+			return 0, 0, nil, noEOF(err)
+		}
+		// The data begins with the offset to the TIFF header, which is
+		// usually preceded by the "Exif\x00\x00" JPEG APP1 header.
+		tiffOffset := 4 + int64(binary.BigEndian.Uint32(hdr[:]))
+		if ext.length == 0 {
+			// Zero length extents span the rest of the file.
+			return base + ext.offset + tiffOffset, math.MaxInt64 - base - ext.offset - tiffOffset, nil, nil
+		}
+		if tiffOffset > ext.length {
+			return 0, 0, nil, errors.New("HEIF Exif TIFF header offset exceeds item size")
+		}
+		return base + ext.offset + tiffOffset, ext.length - tiffOffset, nil, nil
+	}
+	for _, ext := range item.extents {
+		if ext.length == 0 || ext.length > maxBMFFMetaSize-int64(len(data)) {
+			return 0, 0, nil, errors.New("invalid HEIF Exif item extent length")
+		}
+		n := len(data)
+		data = append(data, make([]byte, ext.length)...)
+		_, err = r.ReadAt(data[n:], base+ext.offset)
+		if err != nil {
+			return 0, 0, nil, noEOF(err)
+		}
+	}
+	if len(data) < 4 {
+		return 0, 0, nil, errors.New("short HEIF Exif item")
+	}
+	tiffOffset := 4 + int64(binary.BigEndian.Uint32(data))
+	if tiffOffset > int64(len(data)) {
+		return 0, 0, nil, errors.New("HEIF Exif TIFF header offset exceeds item size")
+	}
+	return 0, 0, data[tiffOffset:], nil
+}
+
+// isHEIF reports whether the ftyp box payload contains a HEIF brand
+// as major brand or compatible brand.
+func isHEIF(ftyp []byte) bool {
+	for i := 0; i+4 <= len(ftyp); i += 4 {
+		if i == 4 {
+			continue // Minor version.
+		}
+		for _, brand := range heifBrands {
+			if string(ftyp[i:i+4]) == brand {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// findHEIFExifItem walks the children of the meta box and returns the location of the
+// Exif item and the idat box, which is zero if absent.
+func findHEIFExifItem(r io.ReaderAt, meta bmffBox) (item heifItem, idat bmffBox, err error) {
+	var (
+		primary  uint32
+		exifIDs  []uint32
+		cdsc     = make(map[uint32][]uint32) // Content description references.
+		items    []heifItem
+		foundInf bool
+	)
+	off := meta.offset + 4 // meta is a full box.
+	for off < meta.end() {
+		box, err := readBMFFBox(r, off, meta.end())
+		if err != nil {
+			return heifItem{}, bmffBox{}, noEOF(err)
+		}
+		off = box.end()
+		switch box.typ {
+		case "idat":
+			idat = box
+			continue
+		case "pitm", "iinf", "iref", "iloc":
+		default:
+			continue
+		}
+		b, err := readBMFFPayload(r, box, maxBMFFMetaSize)
+		if err != nil {
+			return heifItem{}, bmffBox{}, err
+		}
+		d := bmffData{b: b}
+		switch box.typ {
+		case "pitm":
+			primary = parsePITM(&d)
+		case "iinf":
+			exifIDs = parseIINF(&d)
+			foundInf = true
+		case "iref":
+			parseIREF(&d, cdsc)
+		case "iloc":
+			items = parseILOC(&d)
+		}
+		if d.err != nil {
+			return heifItem{}, bmffBox{}, fmt.Errorf("parsing HEIF %s box: %w", box.typ, d.err)
+		}
+	}
+	switch {
+	case !foundInf:
+		return heifItem{}, bmffBox{}, errors.New("HEIF iinf box not found")
+	case len(exifIDs) == 0:
+		return heifItem{}, bmffBox{}, errors.New("HEIF image contains no Exif item")
+	}
+	exifID := exifIDs[0]
+	for _, id := range exifIDs {
+		for _, to := range cdsc[id] {
+			if to == primary {
+				exifID = id
+			}
+		}
+	}
+	for _, item := range items {
+		if item.id == exifID {
+			return item, idat, nil
+		}
+	}
+	return heifItem{}, bmffBox{}, fmt.Errorf("HEIF Exif item %d not found in iloc box", exifID)
+}
+
+// parsePITM returns the ID of the primary item.
+func parsePITM(d *bmffData) uint32 {
+	version, _ := d.fullBox()
+	if version == 0 {
+		return uint32(d.uint(2))
+	}
+	return uint32(d.uint(4))
+}
+
+// parseIINF returns the IDs of the items of type Exif.
+func parseIINF(d *bmffData) (exifIDs []uint32) {
+	version, _ := d.fullBox()
+	if version == 0 {
+		d.uint(2) // Entry count.
+	} else {
+		d.uint(4)
+	}
+	for len(d.b) > 0 && d.err == nil {
+		typ, payload := d.box()
+		if typ != "infe" {
+			continue
+		}
+		infe := bmffData{b: payload}
+		version, _ := infe.fullBox()
+		if version < 2 {
+			continue // Item types were introduced in version 2.
+		}
+		var id uint32
+		if version == 2 {
+			id = uint32(infe.uint(2))
+		} else {
+			id = uint32(infe.uint(4))
+		}
+		infe.uint(2) // Item protection index.
+		if infe.fourcc() == "Exif" && infe.err == nil {
+			exifIDs = append(exifIDs, id)
+		}
+	}
+	return exifIDs
+}
+
+// parseIREF adds the content description (cdsc) references to cdsc, keyed by the referencing item.
+func parseIREF(d *bmffData, cdsc map[uint32][]uint32) {
+	version, _ := d.fullBox()
+	idSize := 2
+	if version != 0 {
+		idSize = 4
+	}
+	for len(d.b) > 0 && d.err == nil {
+		typ, payload := d.box()
+		if typ != "cdsc" {
+			continue
+		}
+		ref := bmffData{b: payload}
+		from := uint32(ref.uint(idSize))
+		n := int(ref.uint(2))
+		for i := 0; i < n && ref.err == nil; i++ {
+			cdsc[from] = append(cdsc[from], uint32(ref.uint(idSize)))
+		}
+		if ref.err != nil {
+			d.err = ref.err
+		}
+	}
+}
+
+// parseILOC returns the locations of the items in the iloc box.
+func parseILOC(d *bmffData) []heifItem {
+	version, _ := d.fullBox()
+	sizes := d.uint(2)
+	offsetSize, lengthSize := int(sizes>>12), int(sizes>>8&0xf)
+	baseOffsetSize, indexSize := int(sizes>>4&0xf), int(sizes&0xf)
+	if version == 0 {
+		indexSize = 0 // Reserved.
+	}
+	var count int
+	if version < 2 {
+		count = int(d.uint(2))
+	} else {
+		count = int(d.uint(4))
+	}
+	var items []heifItem
+	for i := 0; i < count && d.err == nil; i++ {
+		var item heifItem
+		if version < 2 {
+			item.id = uint32(d.uint(2))
+		} else {
+			item.id = uint32(d.uint(4))
+		}
+		if version != 0 {
+			item.construction = uint8(d.uint(2) & 0xf)
+		}
+		d.uint(2) // Data reference index.
+		item.baseOffset = int64(d.uint(baseOffsetSize))
+		extents := int(d.uint(2))
+		for j := 0; j < extents && d.err == nil; j++ {
+			d.uint(indexSize)
+			item.extents = append(item.extents, heifExtent{
+				offset: int64(d.uint(offsetSize)),
+				length: int64(d.uint(lengthSize)),
+			})
+		}
+		items = append(items, item)
+	}
+	return items
+}
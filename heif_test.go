@@ -0,0 +1,117 @@
+package exif
+
+import (
+	"bytes"
+	"encoding/binary"
+	"testing"
+)
+
+func TestLazyDecoder_heif(t *testing.T) {
+	exif, err := Marshal(binary.BigEndian, []IFD{{Group: GroupIFD0, Tags: []Tag{
+		{ID: 0x010f, data: "Apple"}, // Make.
+	}}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	other, err := Marshal(binary.BigEndian, []IFD{{Group: GroupIFD0, Tags: []Tag{
+		{ID: 0x010f, data: "Other"},
+	}}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Run("HEIC", func(t *testing.T) {
+		// Exif item 3 describes primary item 1, Exif item 2 describes item 4.
+		exifItem := append(testU32(6), exifHeader...)
+		exifItem = append(exifItem, exif...)
+		otherItem := append(testU32(0), other...)
+		mdat := [][]byte{{0xde, 0xad}, otherItem, exifItem}
+		heic := testHEIF("heic", mdat, func(offsets []uint32) []byte {
+			return testBox("meta", testU32(0),
+				testBox("pitm", testU32(0), []byte{0, 1}),
+				testBox("iinf", testU32(0), []byte{0, 3},
+					testBox("infe", testU32(2<<24), []byte{0, 1, 0, 0}, []byte("hvc1\x00")),
+					testBox("infe", testU32(2<<24), []byte{0, 2, 0, 0}, []byte("Exif\x00")),
+					testBox("infe", testU32(2<<24), []byte{0, 3, 0, 0}, []byte("Exif\x00")),
+				),
+				testBox("iref", testU32(0),
+					testBox("cdsc", []byte{0, 2, 0, 1, 0, 4}),
+					testBox("cdsc", []byte{0, 3, 0, 1, 0, 1}),
+				),
+				testBox("iloc", testU32(1<<24), []byte{0x44, 0x00, 0, 3},
+					[]byte{0, 1, 0, 0, 0, 0, 0, 1}, testU32(offsets[0]), testU32(2),
+					[]byte{0, 2, 0, 0, 0, 0, 0, 1}, testU32(offsets[1]), testU32(uint32(len(otherItem))),
+					[]byte{0, 3, 0, 0, 0, 0, 0, 1}, testU32(offsets[2]), testU32(uint32(len(exifItem))),
+				),
+			)
+		})
+		testDecodeMake(t, heic, "Apple")
+	})
+	t.Run("AVIF idat extents", func(t *testing.T) {
+		exifItem := append(testU32(0), exif...)
+		avif := testHEIF("avif", [][]byte{{1, 2, 3}}, func([]uint32) []byte {
+			return testBox("meta", testU32(0),
+				testBox("iinf", testU32(1<<24), testU32(1),
+					testBox("infe", testU32(3<<24), testU32(7), []byte{0, 0}, []byte("Exif\x00")),
+				),
+				// Version 2 with 4 byte item IDs, Exif item in idat split in two extents.
+				testBox("iloc", testU32(2<<24), []byte{0x44, 0x00}, testU32(1),
+					testU32(7), []byte{0, 1, 0, 0, 0, 2},
+					testU32(uint32(len(exifItem)-10)), testU32(10),
+					testU32(0), testU32(uint32(len(exifItem)-10)),
+				),
+				testBox("idat", exifItem[10:], exifItem[:10]),
+			)
+		})
+		testDecodeMake(t, avif, "Apple")
+	})
+}
+
+func testDecodeMake(t *testing.T, file []byte, make string) {
+	t.Helper()
+	var decoder LazyDecoder
+	r := bytes.NewReader(file)
+	err := decoder.Decode(r)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tag, err := decoder.GetTag(r, GroupIFD0, 0x010f)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := tag.String(); got != "Make (string): "+make+"\x00" {
+		t.Errorf("got tag %q", got)
+	}
+}
+
+// testHEIF returns an ISOBMFF file of the given brand with an mdat box containing mdat items.
+// The meta box is generated by meta with the file offsets of the mdat items.
+func testHEIF(brand string, mdat [][]byte, meta func(offsets []uint32) []byte) []byte {
+	ftyp := testBox("ftyp", []byte(brand), testU32(0), []byte("mif1"+brand))
+	offsets := make([]uint32, len(mdat))
+	// Box sizes do not depend on offsets so the meta box is generated twice.
+	start := len(ftyp) + len(meta(offsets)) + 8
+	for i := range mdat {
+		offsets[i] = uint32(start)
+		start += len(mdat[i])
+	}
+	file := append(ftyp, meta(offsets)...)
+	return append(file, testBox("mdat", mdat...)...)
+}
+
+func testBox(typ string, payload ...[]byte) []byte {
+	size := 8
+	for _, p := range payload {
+		size += len(p)
+	}
+	box := append(testU32(uint32(size)), typ...)
+	for _, p := range payload {
+		box = append(box, p...)
+	}
+	return box
+}
+
+func testU32(v uint32) []byte {
+	b := make([]byte, 4)
+	binary.BigEndian.PutUint32(b, v)
+	return b
+}
@@ -0,0 +1,673 @@
+// Package brotli implements a decoder of the Brotli compressed data format
+// specified in RFC 7932. Data is decompressed into memory, which suits the small
+// metadata payloads it is used for.
+package brotli
+
+import (
+	"bufio"
+	"errors"
+	"io"
+)
+
+// ErrTooLarge is returned by Decode when the decompressed data exceeds the limit.
+var ErrTooLarge = errors.New("brotli: decompressed data exceeds size limit")
+
+var errCorrupt = errors.New("brotli: corrupt stream")
+
+// Decode decompresses the Brotli stream read from r. Decompression fails
+// with ErrTooLarge once more than limit bytes are decompressed.
+// Data following the end of the stream is not read.
+func Decode(r io.Reader, limit int) ([]byte, error) {
+	br, ok := r.(io.ByteReader)
+	if !ok {
+		br = bufio.NewReader(r)
+	}
+	d := decoder{
+		bits:  bitReader{r: br},
+		limit: limit,
+		dist:  [4]int{4, 11, 15, 16},
+	}
+	err := d.decode()
+	if err != nil {
+		return nil, err
+	}
+	return d.out, nil
+}
+
+// bitReader reads bits of the stream least significant bit first. Read errors
+// are sticky and make reads return zero bits.
+type bitReader struct {
+	r     io.ByteReader
+	val   uint64
+	nbits uint
+	err   error
+}
+
+// read returns the next n bits of the stream, where n is at most 32.
+func (br *bitReader) read(n uint) int {
+	for br.nbits < n {
+		b, err := br.r.ReadByte()
+		if err != nil {
+			if br.err == nil {
+				br.err = err
+				if err == io.EOF {
+					br.err = io.ErrUnexpectedEOF
+				}
+			}
+			return 0
+		}
+		br.val |= uint64(b) << br.nbits
+		br.nbits += 8
+	}
+	v := int(br.val & (1<<n - 1))
+	br.val >>= n
+	br.nbits -= n
+	return v
+}
+
+// align discards the bits up to the next byte boundary, which must be zero.
+func (br *bitReader) align() error {
+	if br.read(br.nbits%8) != 0 {
+		return errCorrupt
+	}
+	return br.err
+}
+
+// decoder holds the state of a stream which persists across meta-blocks.
+type decoder struct {
+	bits  bitReader
+	out   []byte
+	limit int
+	// window is the sliding window size. Distances beyond it or the
+	// start of the output reference the static dictionary.
+	window int
+	// dist holds the last four distances, the last one first.
+	dist [4]int
+}
+
+func (d *decoder) decode() error {
+	err := d.readWindowBits()
+	if err != nil {
+		return err
+	}
+	for {
+		last, err := d.decodeMetaBlock()
+		if err != nil {
+			return err
+		}
+		if last {
+			return nil
+		}
+	}
+}
+
+// readWindowBits reads the stream header containing the sliding window size.
+func (d *decoder) readWindowBits() error {
+	br := &d.bits
+	wbits := 16
+	if br.read(1) == 1 {
+		switch n := br.read(3); {
+		case n != 0:
+			wbits = 17 + n
+		default:
+			switch n = br.read(3); {
+			case n == 1:
+				return errors.New("brotli: large window streams are not supported")
+			case n != 0:
+				wbits = 8 + n
+			default:
+				wbits = 17
+			}
+		}
+	}
+	d.window = 1<<wbits - 16
+	return br.err
+}
+
+// blockSwitch holds the block type state of one of the literal, insert-and-copy
+// or distance categories within a meta-block.
+type blockSwitch struct {
+	ntypes     int
+	typeCode   prefixCode
+	countCode  prefixCode
+	last, prev int // Current and previous block type.
+	count      int // Remaining length of the current block.
+}
+
+func (d *decoder) readBlockSwitch(bs *blockSwitch) error {
+	br := &d.bits
+	bs.ntypes = readVarCount(br)
+	bs.last, bs.prev = 0, 1
+	bs.count = 1 << 24
+	if bs.ntypes < 2 {
+		return br.err
+	}
+	err := bs.typeCode.read(br, bs.ntypes+2)
+	if err != nil {
+		return err
+	}
+	err = bs.countCode.read(br, len(blockLengths))
+	if err != nil {
+		return err
+	}
+	bs.count = readBlockLength(br, &bs.countCode)
+	return br.err
+}
+
+// next decrements the remaining length of the current block, switching to
+// the next block if the current one has ended.
+func (bs *blockSwitch) next(br *bitReader) {
+	if bs.count == 0 && bs.ntypes > 1 {
+		typ := bs.typeCode.decode(br)
+		switch typ {
+		case 0:
+			typ = bs.prev
+		case 1:
+			typ = bs.last + 1
+		default:
+			typ -= 2
+		}
+		if typ >= bs.ntypes {
+			typ -= bs.ntypes
+		}
+		bs.last, bs.prev = typ, bs.last
+		bs.count = readBlockLength(br, &bs.countCode)
+	}
+	bs.count--
+}
+
+// readVarCount reads a number of block types or prefix trees, from 1 to 256.
+func readVarCount(br *bitReader) int {
+	if br.read(1) == 0 {
+		return 1
+	}
+	n := uint(br.read(3))
+	if n == 0 {
+		return 2
+	}
+	return 1<<n + 1 + br.read(n)
+}
+
+func readBlockLength(br *bitReader, code *prefixCode) int {
+	c := blockLengths[code.decode(br)]
+	return int(c.base) + br.read(uint(c.extra))
+}
+
+// decodeMetaBlock decodes the next meta-block, reporting whether it is the last.
+func (d *decoder) decodeMetaBlock() (last bool, err error) {
+	br := &d.bits
+	last = br.read(1) == 1
+	if last && br.read(1) == 1 {
+		return true, br.err // Empty last meta-block.
+	}
+	nibbles := br.read(2) + 4
+	if nibbles == 7 {
+		// Metadata, which is skipped.
+		if br.read(1) != 0 {
+			return false, errCorrupt
+		}
+		nbytes := uint(br.read(2))
+		skip := 0
+		if nbytes > 0 {
+			skip = br.read(8*nbytes) + 1
+		}
+		err = br.align()
+		for ; err == nil && skip > 0; skip-- {
+			br.read(8)
+			err = br.err
+		}
+		return last, err
+	}
+	length := br.read(4*uint(nibbles)) + 1
+	uncompressed := !last && br.read(1) == 1
+	if br.err != nil {
+		return false, br.err
+	}
+	if length > d.limit-len(d.out) {
+		return false, ErrTooLarge
+	}
+	if uncompressed {
+		err = br.align()
+		for ; err == nil && length > 0; length-- {
+			d.out = append(d.out, byte(br.read(8)))
+			err = br.err
+		}
+		return false, err
+	}
+	return last, d.decodeCompressed(length)
+}
+
+// decodeCompressed decodes the header and commands of a compressed meta-block
+// producing length bytes.
+func (d *decoder) decodeCompressed(length int) error {
+	br := &d.bits
+	var lit, cmd, dst blockSwitch
+	for _, bs := range []*blockSwitch{&lit, &cmd, &dst} {
+		err := d.readBlockSwitch(bs)
+		if err != nil {
+			return err
+		}
+	}
+	postfix := uint(br.read(2))
+	direct := br.read(4) << postfix
+	modes := make([]byte, lit.ntypes)
+	for i := range modes {
+		modes[i] = byte(br.read(2))
+	}
+	litMap, litTrees, err := readContextMap(br, 64*lit.ntypes)
+	if err != nil {
+		return err
+	}
+	dstMap, dstTrees, err := readContextMap(br, 4*dst.ntypes)
+	if err != nil {
+		return err
+	}
+	litCodes, err := readPrefixCodes(br, litTrees, 256)
+	if err != nil {
+		return err
+	}
+	cmdCodes, err := readPrefixCodes(br, cmd.ntypes, 704)
+	if err != nil {
+		return err
+	}
+	dstCodes, err := readPrefixCodes(br, dstTrees, 16+direct+48<<postfix)
+	if err != nil {
+		return err
+	}
+
+	end := len(d.out) + length
+	for len(d.out) < end {
+		cmd.next(br)
+		c := cmdCodes[cmd.last].decode(br)
+		ins, cpy := insertCopy(c)
+		insertLen := int(insertLengths[ins].base) + br.read(uint(insertLengths[ins].extra))
+		copyLen := int(copyLengths[cpy].base) + br.read(uint(copyLengths[cpy].extra))
+		if br.err != nil {
+			return br.err
+		}
+		if insertLen > end-len(d.out) {
+			return errCorrupt
+		}
+		for i := 0; i < insertLen; i++ {
+			lit.next(br)
+			var p1, p2 byte
+			if n := len(d.out); n > 1 {
+				p1, p2 = d.out[n-1], d.out[n-2]
+			} else if n == 1 {
+				p1 = d.out[0]
+			}
+			ctx := literalContext(modes[lit.last], p1, p2)
+			tree := litMap[64*lit.last+int(ctx)]
+			d.out = append(d.out, byte(litCodes[tree].decode(br)))
+		}
+		if br.err != nil {
+			return br.err
+		}
+		if len(d.out) == end {
+			break // The copy length of the last command is ignored.
+		}
+
+		var distance int
+		code := 0 // Implicit distance code 0 reuses the last distance.
+		if c >= 128 {
+			dst.next(br)
+			ctx := copyLen - 2
+			if ctx > 3 {
+				ctx = 3
+			}
+			tree := dstMap[4*dst.last+ctx]
+			code = dstCodes[tree].decode(br)
+		}
+		switch {
+		case code < 16:
+			distance = d.dist[distanceShortCodes[code].index] + int(distanceShortCodes[code].delta)
+			if distance <= 0 {
+				return errCorrupt
+			}
+		case code < 16+direct:
+			distance = code - 15
+		default:
+			x := code - 16 - direct
+			nbits := 1 + uint(x)>>(postfix+1)
+			hcode := x >> postfix
+			lcode := x & (1<<postfix - 1)
+			offset := (2+hcode&1)<<nbits - 4
+			distance = (offset+br.read(nbits))<<postfix + lcode + direct + 1
+		}
+		if br.err != nil {
+			return br.err
+		}
+
+		maxDistance := len(d.out)
+		if maxDistance > d.window {
+			maxDistance = d.window
+		}
+		if distance > maxDistance {
+			word, err := dictionaryWord(distance-maxDistance-1, copyLen)
+			if err != nil {
+				return err
+			}
+			if len(word) > end-len(d.out) {
+				return errCorrupt
+			}
+			d.out = append(d.out, word...)
+			continue
+		}
+		if code != 0 {
+			d.dist = [4]int{distance, d.dist[0], d.dist[1], d.dist[2]}
+		}
+		if copyLen > end-len(d.out) {
+			return errCorrupt
+		}
+		// Copy byte by byte since the source may overlap the copied bytes.
+		start := len(d.out) - distance
+		for i := 0; i < copyLen; i++ {
+			d.out = append(d.out, d.out[start+i])
+		}
+	}
+	return br.err
+}
+
+// insertCopy returns the insert and copy length codes of an insert-and-copy command code.
+func insertCopy(c int) (ins, cpy int) {
+	cell := insertCopyCells[c>>6]
+	return int(cell[0]) + c>>3&7, int(cell[1]) + c&7
+}
+
+// literalContext returns the context ID of a literal given the mode of its block
+// type and the two previous bytes p1 and p2, p1 being the last.
+func literalContext(mode, p1, p2 byte) byte {
+	switch mode {
+	case 0: // LSB6.
+		return p1 & 0x3f
+	case 1: // MSB6.
+		return p1 >> 2
+	case 2: // UTF8.
+		return utf8Lut0[p1] | utf8Lut1[p2]
+	}
+	// Signed.
+	return signedLut(p1)<<3 | signedLut(p2)
+}
+
+// signedLut returns the context of a byte interpreted as a signed value.
+func signedLut(b byte) byte {
+	switch {
+	case b == 0:
+		return 0
+	case b < 16:
+		return 1
+	case b < 64:
+		return 2
+	case b < 128:
+		return 3
+	case b < 192:
+		return 4
+	case b < 240:
+		return 5
+	case b < 255:
+		return 6
+	}
+	return 7
+}
+
+// readContextMap reads a context map of the given size, returning the map
+// and the number of prefix trees it references.
+func readContextMap(br *bitReader, size int) (cmap []byte, ntrees int, err error) {
+	ntrees = readVarCount(br)
+	cmap = make([]byte, size)
+	if ntrees < 2 {
+		return cmap, ntrees, br.err
+	}
+	rleMax := 0
+	if br.read(1) == 1 {
+		rleMax = br.read(4) + 1
+	}
+	var code prefixCode
+	err = code.read(br, ntrees+rleMax)
+	if err != nil {
+		return nil, 0, err
+	}
+	for i := 0; i < size; {
+		c := code.decode(br)
+		switch {
+		case c == 0:
+			i++
+		case c <= rleMax:
+			// Run of zeros.
+			i += 1<<c + br.read(uint(c))
+			if i > size {
+				return nil, 0, errCorrupt
+			}
+		default:
+			cmap[i] = byte(c - rleMax)
+			i++
+		}
+		if br.err != nil {
+			return nil, 0, br.err
+		}
+	}
+	if br.read(1) == 1 {
+		inverseMoveToFront(cmap)
+	}
+	return cmap, ntrees, br.err
+}
+
+func inverseMoveToFront(v []byte) {
+	var mtf [256]byte
+	for i := range mtf {
+		mtf[i] = byte(i)
+	}
+	for i, idx := range v {
+		value := mtf[idx]
+		v[i] = value
+		copy(mtf[1:idx+1], mtf[:idx])
+		mtf[0] = value
+	}
+}
+
+func readPrefixCodes(br *bitReader, n, alphabetSize int) ([]prefixCode, error) {
+	codes := make([]prefixCode, n)
+	for i := range codes {
+		err := codes[i].read(br, alphabetSize)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return codes, nil
+}
+
+// prefixCode is a canonical prefix code. Codes are read from the stream
+// starting with their most significant bit.
+type prefixCode struct {
+	// count holds the number of codes of each length.
+	count [16]uint16
+	// symbols holds the symbols ordered by code.
+	symbols []uint16
+}
+
+// decode reads a symbol. Codes with a single symbol consume no bits.
+func (pc *prefixCode) decode(br *bitReader) int {
+	if len(pc.symbols) == 1 {
+		return int(pc.symbols[0])
+	}
+	code, first, index := 0, 0, 0
+	for n := 1; n < len(pc.count); n++ {
+		code |= br.read(1)
+		count := int(pc.count[n])
+		if code-first < count {
+			return int(pc.symbols[index+code-first])
+		}
+		index += count
+		first = (first + count) << 1
+		code <<= 1
+	}
+	// Unreachable for complete codes, but a read error yields zero bits.
+	return int(pc.symbols[0])
+}
+
+// build sets up the code from the code lengths of the symbols of the alphabet.
+// The code must be complete unless it has a single symbol.
+func (pc *prefixCode) build(lengths []byte) error {
+	pc.count = [16]uint16{}
+	pc.symbols = pc.symbols[:0]
+	for _, n := range lengths {
+		pc.count[n]++
+	}
+	pc.count[0] = 0
+	var offsets [16]int
+	space := 1 << 15
+	for n := 1; n < len(pc.count); n++ {
+		offsets[n] = offsets[n-1] + int(pc.count[n-1])
+		space -= int(pc.count[n]) << (15 - n)
+	}
+	total := offsets[15] + int(pc.count[15])
+	if total == 0 || (total > 1 && space != 0) {
+		return errCorrupt
+	}
+	pc.symbols = make([]uint16, total)
+	for sym, n := range lengths {
+		if n != 0 {
+			pc.symbols[offsets[n]] = uint16(sym)
+			offsets[n]++
+		}
+	}
+	return nil
+}
+
+// read reads the description of a prefix code of an alphabet of the given size.
+func (pc *prefixCode) read(br *bitReader, alphabetSize int) error {
+	lengths := make([]byte, alphabetSize)
+	hskip := br.read(2)
+	if hskip == 1 {
+		// Simple prefix code of up to four symbols.
+		var nbits uint
+		for 1<<nbits < alphabetSize {
+			nbits++
+		}
+		nsym := br.read(2) + 1
+		var syms [4]int
+		for i := 0; i < nsym; i++ {
+			syms[i] = br.read(nbits)
+			if syms[i] >= alphabetSize {
+				return errCorrupt
+			}
+			for j := 0; j < i; j++ {
+				if syms[j] == syms[i] {
+					return errCorrupt
+				}
+			}
+		}
+		var codeLengths []byte
+		switch nsym {
+		case 1:
+			pc.count = [16]uint16{}
+			pc.symbols = []uint16{uint16(syms[0])}
+			return br.err
+		case 2:
+			codeLengths = []byte{1, 1}
+		case 3:
+			codeLengths = []byte{1, 2, 2}
+		case 4:
+			codeLengths = []byte{2, 2, 2, 2}
+			if br.read(1) == 1 {
+				codeLengths = []byte{1, 2, 3, 3}
+			}
+		}
+		for i, n := range codeLengths {
+			lengths[syms[i]] = n
+		}
+		if br.err != nil {
+			return br.err
+		}
+		return pc.build(lengths)
+	}
+
+	// Complex prefix code, whose code lengths are themselves prefix coded.
+	var clLengths [len(codeLengthOrder)]byte
+	space, used := 32, 0
+	for i := hskip; i < len(codeLengthOrder) && space > 0; i++ {
+		n := readCodeLengthLength(br)
+		clLengths[codeLengthOrder[i]] = n
+		if n != 0 {
+			space -= 32 >> n
+			used++
+		}
+	}
+	if br.err != nil {
+		return br.err
+	}
+	if used != 1 && space != 0 {
+		return errCorrupt
+	}
+	var clCode prefixCode
+	err := clCode.build(clLengths[:])
+	if err != nil {
+		return err
+	}
+	prevLen := byte(8)
+	repeat, repeatLen := 0, byte(0)
+	space = 1 << 15
+	for sym := 0; sym < alphabetSize && space > 0; {
+		c := byte(clCode.decode(br))
+		if br.err != nil {
+			return br.err
+		}
+		if c < 16 {
+			repeat = 0
+			lengths[sym] = c
+			sym++
+			if c != 0 {
+				prevLen = c
+				space -= 1 << 15 >> c
+			}
+			continue
+		}
+		extra, newLen := uint(2), prevLen
+		if c == 17 {
+			extra, newLen = 3, 0
+		}
+		if repeatLen != newLen {
+			repeat, repeatLen = 0, newLen
+		}
+		old := repeat
+		if repeat > 0 {
+			repeat = (repeat - 2) << extra
+		}
+		repeat += br.read(extra) + 3
+		delta := repeat - old
+		if sym+delta > alphabetSize {
+			return errCorrupt
+		}
+		for i := 0; i < delta; i++ {
+			lengths[sym] = newLen
+			sym++
+		}
+		if newLen != 0 {
+			space -= delta << 15 >> newLen
+		}
+	}
+	if space != 0 {
+		return errCorrupt
+	}
+	return pc.build(lengths)
+}
+
+// readCodeLengthLength reads the code length of a symbol of the code length
+// alphabet, which is coded with a fixed prefix code of lengths 2 to 4.
+func readCodeLengthLength(br *bitReader) byte {
+	switch br.read(2) {
+	case 0:
+		return 0
+	case 1:
+		return 4
+	case 2:
+		return 3
+	}
+	if br.read(1) == 0 {
+		return 2
+	}
+	if br.read(1) == 0 {
+		return 1
+	}
+	return 5
+}
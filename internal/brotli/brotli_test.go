@@ -0,0 +1,82 @@
+package brotli
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"io"
+	"os"
+	"strings"
+	"testing"
+)
+
+// Test data was compressed with a reference encoder.
+func TestDecode(t *testing.T) {
+	var sequence []byte
+	for i := 0; i < 3000; i++ {
+		sequence = append(sequence, byte(i*i>>3), byte(i))
+	}
+	var random []byte
+	x := uint32(1)
+	for i := 0; i < 300; i++ {
+		// Xorshift pseudorandom numbers.
+		x ^= x << 13
+		x ^= x >> 17
+		x ^= x << 5
+		random = append(random, byte(x))
+	}
+	for _, tc := range []struct {
+		file string
+		want []byte
+	}{
+		// Static dictionary references and literal context maps.
+		{file: "text.br", want: []byte(strings.Repeat("The quick brown fox jumps over the lazy dog. ", 3) +
+			"Photographs taken with the camera are stored with their metadata.")},
+		// Block switches and a 1kB window.
+		{file: "sequence.br", want: sequence},
+		// Uncompressed meta-block.
+		{file: "random.br", want: random},
+	} {
+		t.Run(tc.file, func(t *testing.T) {
+			src, err := os.ReadFile("testdata/" + tc.file)
+			if err != nil {
+				t.Fatal(err)
+			}
+			got, err := Decode(bytes.NewReader(src), len(tc.want))
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !bytes.Equal(got, tc.want) {
+				t.Errorf("decompressed data mismatch")
+			}
+			_, err = Decode(bytes.NewReader(src), len(tc.want)-1)
+			if err != ErrTooLarge {
+				t.Errorf("expected ErrTooLarge, got %v", err)
+			}
+			_, err = Decode(bytes.NewReader(src[:len(src)-1]), len(tc.want))
+			if err == nil {
+				t.Error("expected error decoding truncated stream")
+			}
+		})
+	}
+}
+
+func TestDecode_empty(t *testing.T) {
+	// Window bits 16, followed by an empty last meta-block.
+	got, err := Decode(bytes.NewReader([]byte{0x06}), 0)
+	if err != nil || len(got) != 0 {
+		t.Errorf("got %q (%v), want empty data", got, err)
+	}
+	_, err = Decode(bytes.NewReader(nil), 0)
+	if err != io.ErrUnexpectedEOF {
+		t.Errorf("expected io.ErrUnexpectedEOF, got %v", err)
+	}
+}
+
+func TestDictionary(t *testing.T) {
+	sum := sha256.Sum256([]byte(dictionary))
+	const want = "20e42eb1b511c21806d4d227d07e5dd06877d8ce7b3a817f378f313653f35c70"
+	if got := hex.EncodeToString(sum[:]); got != want {
+		t.Errorf("dictionary checksum %s, want %s", got, want)
+	}
+}
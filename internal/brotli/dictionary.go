@@ -0,0 +1,80 @@
+package brotli
+
+import (
+	_ "embed"
+	"errors"
+)
+
+// dictionary is the static dictionary of RFC 7932 Appendix A. It holds words of
+// 4 to 24 bytes, grouped by length.
+//
+//go:embed dictionary.bin
+var dictionary string
+
+// dictionaryBits holds the base 2 logarithm of the number of words of each length.
+var dictionaryBits = [25]uint8{0, 0, 0, 0, 10, 10, 11, 11, 10, 10, 10, 10, 10, 9, 9, 8, 7, 7, 8, 7, 7, 6, 6, 5, 5}
+
+// dictionaryWord returns the transformed static dictionary word of the given length
+// referenced by id, which selects the word and the transform applied to it.
+func dictionaryWord(id, length int) ([]byte, error) {
+	if length < 4 || length >= len(dictionaryBits) {
+		return nil, errors.New("brotli: invalid static dictionary word length")
+	}
+	offset := 0
+	for n := 4; n < length; n++ {
+		offset += n << dictionaryBits[n]
+	}
+	nbits := dictionaryBits[length]
+	index := id & (1<<nbits - 1)
+	t := id >> nbits
+	if t >= len(transforms) {
+		return nil, errors.New("brotli: invalid static dictionary transform")
+	}
+	word := []byte(dictionary[offset+index*length : offset+(index+1)*length])
+	tr := transforms[t]
+	switch {
+	case tr.typ >= omitLast1 && tr.typ <= omitLast9:
+		word = word[:len(word)-omitted(word, int(tr.typ-omitLast1+1))]
+	case tr.typ >= omitFirst1 && tr.typ <= omitFirst9:
+		word = word[omitted(word, int(tr.typ-omitFirst1+1)):]
+	case tr.typ == uppercaseFirst:
+		toUpper(word)
+	case tr.typ == uppercaseAll:
+		for i := 0; i < len(word); {
+			i += toUpper(word[i:])
+		}
+	}
+	dst := make([]byte, 0, len(tr.prefix)+len(word)+len(tr.suffix))
+	dst = append(dst, tr.prefix...)
+	dst = append(dst, word...)
+	return append(dst, tr.suffix...), nil
+}
+
+// omitted returns the number of bytes of word omitted by a transform omitting n bytes.
+func omitted(word []byte, n int) int {
+	if n > len(word) {
+		return len(word)
+	}
+	return n
+}
+
+// toUpper converts the first UTF-8 character of word to upper case the way
+// RFC 7932 specifies, returning the number of bytes of the character.
+func toUpper(word []byte) int {
+	switch {
+	case word[0] < 0xc0:
+		if word[0] >= 'a' && word[0] <= 'z' {
+			word[0] ^= 32
+		}
+		return 1
+	case word[0] < 0xe0:
+		if len(word) > 1 {
+			word[1] ^= 32
+		}
+		return 2
+	}
+	if len(word) > 2 {
+		word[2] ^= 5
+	}
+	return 3
+}
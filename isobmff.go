@@ -0,0 +1,161 @@
+package exif
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+)
+
+// bmffBox is a box of an ISO base media file format (ISOBMFF) file, the container
+// format of HEIF, AVIF and JPEG XL images.
+type bmffBox struct {
+	typ string
+	// offset and size of the box's payload which follows the box header.
+	offset, size int64
+}
+
+// end returns the offset of the end of the box which is where the next box begins.
+func (b bmffBox) end() int64 {
+	return b.offset + b.size
+}
+
+// readBMFFBox reads the header of the box at off in r. The box must end before end.
+// It returns io.EOF if r ends at off.
+func readBMFFBox(r io.ReaderAt, off, end int64) (bmffBox, error) {
+	if end-off < 8 {
+		return bmffBox{}, errors.New("short ISOBMFF box header")
+	}
+	var hdr [16]byte
+	n, err := r.ReadAt(hdr[:8], off)
+	if n == 0 && err == io.EOF {
+		return bmffBox{}, io.EOF
+	} else if n != 8 {
+		return bmffBox{}, noEOF(err)
+	}
+	size := int64(binary.BigEndian.Uint32(hdr[:4]))
+	box := bmffBox{typ: string(hdr[4:8]), offset: off + 8}
+	switch size {
+	case 0:
+		// Box extends to the end of its parent or the file.
+		box.size = end - box.offset
+		return box, nil
+	case 1:
+		// 64 bit size follows the type.
+		if end-off < 16 {
+			return bmffBox{}, errors.New("short ISOBMFF box header")
+		}
+		_, err = r.ReadAt(hdr[8:16], off+8)
+		if err != nil {
+			return bmffBox{}, noEOF(err)
+		}
+		size = int64(binary.BigEndian.Uint64(hdr[8:16]))
+		box.offset += 8
+	}
+	headerSize := box.offset - off
+	if size < headerSize || size > end-off {
+		return bmffBox{}, fmt.Errorf("invalid ISOBMFF %q box size %d", box.typ, size)
+	}
+	box.size = size - headerSize
+	return box, nil
+}
+
+// findBMFFBox returns the first box of type typ among the sibling boxes between off and end.
+func findBMFFBox(r io.ReaderAt, off, end int64, typ string) (bmffBox, error) {
+	for off < end {
+		box, err := readBMFFBox(r, off, end)
+		if err == io.EOF {
+			break
+		} else if err != nil {
+			return bmffBox{}, err
+		}
+		if box.typ == typ {
+			return box, nil
+		}
+		off = box.end()
+	}
+	return bmffBox{}, fmt.Errorf("ISOBMFF %q box not found", typ)
+}
+
+// readBMFFPayload reads the payload of box which must not exceed maxSize bytes.
+func readBMFFPayload(r io.ReaderAt, box bmffBox, maxSize int64) ([]byte, error) {
+	if box.size > maxSize {
+		return nil, fmt.Errorf("ISOBMFF %q box size %d exceeds limit of %d bytes", box.typ, box.size, maxSize)
+	}
+	b := make([]byte, box.size)
+	_, err := r.ReadAt(b, box.offset)
+	if err != nil {
+		return nil, noEOF(err)
+	}
+	return b, nil
+}
+
+// bmffData decodes the big-endian fields of an in-memory box payload.
+// Reads past the end of the payload return zero values and set err.
+type bmffData struct {
+	b   []byte
+	err error
+}
+
+// uint decodes an unsigned integer of n bytes, where n is 0, 1, 2, 4 or 8.
+// Fields of variable size, such as the offsets in iloc boxes, may have zero size.
+func (d *bmffData) uint(n int) uint64 {
+	b := d.next(n)
+	switch len(b) {
+	case 1:
+		return uint64(b[0])
+	case 2:
+		return uint64(binary.BigEndian.Uint16(b))
+	case 4:
+		return uint64(binary.BigEndian.Uint32(b))
+	case 8:
+		return binary.BigEndian.Uint64(b)
+	}
+	if n != 0 && d.err == nil {
+		d.err = fmt.Errorf("invalid ISOBMFF field size %d", n)
+	}
+	return 0
+}
+
+// fourcc decodes a four character code.
+func (d *bmffData) fourcc() string {
+	return string(d.next(4))
+}
+
+// fullBox decodes the version and flags which begin the payload of a full box.
+func (d *bmffData) fullBox() (version uint8, flags uint32) {
+	v := uint32(d.uint(4))
+	return uint8(v >> 24), v & 0xffffff
+}
+
+// box decodes the next child box and returns its type and payload.
+func (d *bmffData) box() (typ string, payload []byte) {
+	size := d.uint(4)
+	typ = d.fourcc()
+	headerSize := uint64(8)
+	switch size {
+	case 0:
+		size = uint64(len(d.b)) + headerSize
+	case 1:
+		size = d.uint(8)
+		headerSize += 8
+	}
+	if d.err == nil && (size < headerSize || size-headerSize > uint64(len(d.b))) {
+		d.err = fmt.Errorf("invalid ISOBMFF %q box size %d", typ, size)
+	}
+	return typ, d.next(int(size - headerSize))
+}
+
+func (d *bmffData) next(n int) []byte {
+	if d.err != nil {
+		return nil
+	}
+	if n > len(d.b) || n < 0 {
+		d.err = errors.New("unexpected end of ISOBMFF box")
+		d.b = nil
+		return nil
+	}
+	b := d.b[:n]
+	d.b = d.b[n:]
+	return b
+}
@@ -0,0 +1,452 @@
+package exif
+
+import (
+	"bufio"
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+)
+
+// JPEG markers. The marker byte follows a 0xff byte in the stream.
+const (
+	markerTEM  = 0x01
+	markerRST0 = 0xd0
+	markerRST7 = 0xd7
+	markerSOI  = 0xd8
+	markerEOI  = 0xd9
+	markerSOS  = 0xda
+	markerAPP0 = 0xe0
+	markerAPP1 = 0xe1
+)
+
+const (
+	// exifHeader is the prefix of the APP1 segment payload containing EXIF data.
+	exifHeader = "Exif\x00\x00"
+	// maxSegmentPayload is the maximum size of a JPEG segment's payload, which
+	// is limited by the 16 bit length field which includes the length field itself.
+	maxSegmentPayload = 0xffff - 2
+	// MaxJPEGExifSize is the maximum size of EXIF data that fits in a JPEG APP1 segment.
+	MaxJPEGExifSize = maxSegmentPayload - len(exifHeader)
+)
+
+// ReplaceJPEGExif copies the JPEG image in src to dst replacing its EXIF APP1
+// segment with one containing exif, which is TIFF formatted EXIF data such as the
+// data returned by [Marshal]. All other segments and the entropy-coded image
+// data are copied unmodified.
+//
+// The new APP1 segment is written right after the SOI marker, or after the JFIF
+// APP0 segment if present, which is where an EXIF APP1 segment is expected.
+// All EXIF APP1 segments in src are discarded. If exif is nil the EXIF APP1 segment is removed.
+//
+// An error is returned if exif exceeds [MaxJPEGExifSize].
+func ReplaceJPEGExif(dst io.Writer, src io.Reader, exif []byte) error {
+	if len(exif) > MaxJPEGExifSize {
+		return fmt.Errorf("EXIF data size %d exceeds APP1 segment limit of %d bytes", len(exif), MaxJPEGExifSize)
+	}
+	scn := newJPEGScanner(src)
+	err := scn.start()
+	if err != nil {
+		return err
+	}
+	_, err = dst.Write([]byte{0xff, markerSOI})
+	if err != nil {
+		return err
+	}
+	written := exif == nil
+	for {
+		marker, payload, err := scn.next()
+		if err != nil {
+			return err
+		}
+		if !written && marker != markerAPP0 {
+			err = writeJPEGSegment(dst, markerAPP1, []byte(exifHeader), exif)
+			if err != nil {
+				return err
+			}
+			written = true
+		}
+		if marker == markerAPP1 && bytes.HasPrefix(payload, []byte(exifHeader)) {
+			continue // Discard original EXIF.
+		}
+		err = writeJPEGSegment(dst, marker, payload)
+		if err != nil {
+			return err
+		}
+		if marker == markerSOS || marker == markerEOI {
+			// Entropy-coded data follows SOS. Copy the rest of the stream as is.
+			_, err = io.Copy(dst, scn.r)
+			return err
+		}
+	}
+}
+
+// writeJPEGSegment writes a marker segment with the concatenation of payloads
+// to w. Markers without payload, such as EOI, are written without length field.
+func writeJPEGSegment(w io.Writer, marker byte, payloads ...[]byte) error {
+	if !markerHasLength(marker) {
+		_, err := w.Write([]byte{0xff, marker})
+		return err
+	}
+	length := 2
+	for _, p := range payloads {
+		length += len(p)
+	}
+	if length > 0xffff {
+		return fmt.Errorf("JPEG segment %#x payload exceeds 64KiB", marker)
+	}
+	_, err := w.Write([]byte{0xff, marker, byte(length >> 8), byte(length)})
+	for i := 0; err == nil && i < len(payloads); i++ {
+		_, err = w.Write(payloads[i])
+	}
+	return err
+}
+
+// markerHasLength reports whether the marker is followed by a length field and payload.
+func markerHasLength(marker byte) bool {
+	return !(marker == markerSOI || marker == markerEOI || marker == markerTEM ||
+		(marker >= markerRST0 && marker <= markerRST7))
+}
+
+// jpegScanner reads the marker segments of a JPEG stream up to
+// the start of entropy-coded data.
+type jpegScanner struct {
+	r   *bufio.Reader
+	buf []byte
+}
+
+func newJPEGScanner(r io.Reader) *jpegScanner {
+	return &jpegScanner{r: bufio.NewReader(r)}
+}
+
+// start reads the SOI marker which begins a JPEG stream.
+func (s *jpegScanner) start() error {
+	var soi [2]byte
+	_, err := io.ReadFull(s.r, soi[:])
+	if err != nil {
+		return err
+	}
+	if soi[0] != 0xff || soi[1] != markerSOI {
+		return errors.New("missing JPEG SOI marker")
+	}
+	return nil
+}
+
+// next reads the next marker segment and returns the marker and the segment's
+// payload, which excludes the length field. The payload is valid until the next call to next.
+func (s *jpegScanner) next() (marker byte, payload []byte, err error) {
+	b, err := s.r.ReadByte()
+	if err != nil {
+		return 0, nil, noEOF(err)
+	}
+	if b != 0xff {
+		return 0, nil, fmt.Errorf("expected JPEG marker, got %#x", b)
+	}
+	for b == 0xff {
+		// Markers may be preceded by any number of 0xff fill bytes.
+		b, err = s.r.ReadByte()
+		if err != nil {
+			return 0, nil, noEOF(err)
+		}
+	}
+	marker = b
+	if marker == 0 {
+		return 0, nil, errors.New("unexpected stuffed byte outside of entropy-coded data")
+	}
+	if !markerHasLength(marker) {
+		return marker, nil, nil
+	}
+	var lenbuf [2]byte
+	_, err = io.ReadFull(s.r, lenbuf[:])
+	if err != nil {
+		return 0, nil, noEOF(err)
+	}
+	length := int(lenbuf[0])<<8 | int(lenbuf[1])
+	if length < 2 {
+		return 0, nil, fmt.Errorf("invalid JPEG segment length %d for marker %#x", length, marker)
+	}
+	if cap(s.buf) < length-2 {
+		s.buf = make([]byte, length-2)
+	}
+	payload = s.buf[:length-2]
+	_, err = io.ReadFull(s.r, payload)
+	if err != nil {
+		return 0, nil, noEOF(err)
+	}
+	return marker, payload, nil
+}
+
+// copyEntropyData copies the entropy-coded data following an SOS segment to w,
+// up to the next marker which is not a stuffed byte or an RSTn marker. The marker
+// is left to be read by next. It returns io.EOF if the stream ends before a marker.
+func (s *jpegScanner) copyEntropyData(w io.Writer) error {
+	for {
+		n := s.r.Buffered()
+		if n < 2 {
+			n = 2
+		}
+		b, err := s.r.Peek(n)
+		if len(b) < 2 {
+			if len(b) > 0 {
+				_, err = w.Write(b)
+			}
+			if err == nil || err == io.EOF {
+				err = io.EOF
+			}
+			return err
+		}
+		i := 0
+		for ; i < len(b)-1; i++ {
+			if b[i] != 0xff {
+				continue
+			}
+			m := b[i+1]
+			if m == 0 || (m >= markerRST0 && m <= markerRST7) {
+				i++ // Stuffed byte or restart marker, both part of entropy-coded data.
+				continue
+			}
+			if m != 0xff {
+				break
+			}
+		}
+		marker := i < len(b)-1
+		_, err = w.Write(b[:i])
+		if err != nil {
+			return err
+		}
+		_, err = s.r.Discard(i)
+		if err != nil || marker {
+			return err
+		}
+		// Last byte is read again in case it is the 0xff of a marker.
+	}
+}
+
+// noEOF converts io.EOF to io.ErrUnexpectedEOF, for use where the end of data is unexpected.
+func noEOF(err error) error {
+	if err == io.EOF {
+		return io.ErrUnexpectedEOF
+	}
+	return err
+}
+
+// JPEGMarker identifies the type of a JPEG marker segment.
+// It is the byte that follows the 0xff prefix of the marker.
+type JPEGMarker byte
+
+// String returns the marker's abbreviation as defined in ITU T.81, i.e. "APP1" or "SOF0".
+func (m JPEGMarker) String() string {
+	switch {
+	case m == markerTEM:
+		return "TEM"
+	case m >= markerRST0 && m <= markerRST7:
+		return "RST" + strconv.Itoa(int(m-markerRST0))
+	case m >= markerAPP0 && m <= markerAPP0+15:
+		return "APP" + strconv.Itoa(int(m-markerAPP0))
+	case m >= 0xf0 && m <= 0xfd:
+		return "JPG" + strconv.Itoa(int(m-0xf0))
+	}
+	switch m {
+	case 0xc4:
+		return "DHT"
+	case 0xc8:
+		return "JPG"
+	case 0xcc:
+		return "DAC"
+	case markerSOI:
+		return "SOI"
+	case markerEOI:
+		return "EOI"
+	case markerSOS:
+		return "SOS"
+	case 0xdb:
+		return "DQT"
+	case 0xdc:
+		return "DNL"
+	case 0xdd:
+		return "DRI"
+	case 0xde:
+		return "DHP"
+	case 0xdf:
+		return "EXP"
+	case markerCOM:
+		return "COM"
+	}
+	if m >= 0xc0 && m <= 0xcf {
+		return "SOF" + strconv.Itoa(int(m-0xc0))
+	}
+	return "<unknown JPEG marker " + strconv.Itoa(int(m)) + ">"
+}
+
+// JPEGSegment is a marker segment of a JPEG image.
+type JPEGSegment struct {
+	Marker JPEGMarker
+	// Offset of the segment in the image, which is the offset
+	// of the 0xff byte that precedes the marker.
+	Offset int64
+	// Length of the segment's payload which excludes the marker and length field.
+	// It is zero for markers without payload such as SOI and EOI.
+	Length int
+}
+
+// PayloadOffset returns the offset of the segment's payload in the image.
+func (s JPEGSegment) PayloadOffset() int64 {
+	if !markerHasLength(byte(s.Marker)) {
+		return s.Offset + 2
+	}
+	return s.Offset + 4
+}
+
+// End returns the offset of the end of the segment's payload in the image.
+// Entropy-coded data following SOS segments is not included.
+func (s JPEGSegment) End() int64 {
+	return s.PayloadOffset() + int64(s.Length)
+}
+
+// JPEGSegmentReader walks the marker segments of a JPEG image without
+// reading their payloads. Entropy-coded data following SOS segments is skipped
+// along with the RSTn markers embedded in it.
+type JPEGSegmentReader struct {
+	r io.ReaderAt
+	// off is the offset of the next marker.
+	off     int64
+	started bool
+	entropy bool
+	done    bool
+}
+
+// NewJPEGSegmentReader returns a JPEGSegmentReader which reads
+// the JPEG image in r, which must begin with the SOI marker.
+func NewJPEGSegmentReader(r io.ReaderAt) *JPEGSegmentReader {
+	return &JPEGSegmentReader{r: r}
+}
+
+// Next returns the next segment of the image. It returns io.EOF after
+// the EOI segment or when the image data ends after a segment's payload.
+func (sr *JPEGSegmentReader) Next() (JPEGSegment, error) {
+	var buf [4]byte
+	if !sr.started {
+		_, err := sr.r.ReadAt(buf[:2], 0)
+		if err != nil {
+			return JPEGSegment{}, noEOF(err)
+		}
+		if buf[0] != 0xff || buf[1] != markerSOI {
+			return JPEGSegment{}, errors.New("missing JPEG SOI marker")
+		}
+		sr.started = true
+		sr.off = 2
+		return JPEGSegment{Marker: markerSOI}, nil
+	}
+	if sr.done {
+		return JPEGSegment{}, io.EOF
+	}
+	if sr.entropy {
+		err := sr.skipEntropyData()
+		if err != nil {
+			return JPEGSegment{}, err
+		}
+	}
+	n, err := sr.r.ReadAt(buf[:1], sr.off)
+	if n == 0 {
+		if err == nil {
+			// Reader returned no data without reporting why.
+			err = io.ErrUnexpectedEOF
+			sr.done = true
+		} else if err == io.EOF {
+			sr.done = true
+		}
+		return JPEGSegment{}, err
+	}
+	if buf[0] != 0xff {
+		return JPEGSegment{}, fmt.Errorf("expected JPEG marker at offset %d, got %#x", sr.off, buf[0])
+	}
+	for buf[0] == 0xff {
+		// Markers may be preceded by any number of 0xff fill bytes.
+		sr.off++
+		_, err = sr.r.ReadAt(buf[:1], sr.off)
+		if err != nil {
+			return JPEGSegment{}, noEOF(err)
+		}
+	}
+	sr.off++
+	// Fill bytes are not part of the segment.
+	seg := JPEGSegment{Marker: JPEGMarker(buf[0]), Offset: sr.off - 2}
+	switch {
+	case seg.Marker == 0:
+		return JPEGSegment{}, fmt.Errorf("unexpected stuffed byte at offset %d outside of entropy-coded data", sr.off-1)
+	case !markerHasLength(byte(seg.Marker)):
+		sr.done = seg.Marker == markerEOI
+		sr.entropy = seg.Marker >= markerRST0 && seg.Marker <= markerRST7
+		return seg, nil
+	}
+	_, err = sr.r.ReadAt(buf[:2], sr.off)
+	if err != nil {
+		return JPEGSegment{}, noEOF(err)
+	}
+	length := int(buf[0])<<8 | int(buf[1])
+	if length < 2 {
+		return JPEGSegment{}, fmt.Errorf("invalid JPEG segment length %d for marker %s", length, seg.Marker.String())
+	}
+	seg.Length = length - 2
+	sr.off += int64(length)
+	sr.entropy = seg.Marker == markerSOS
+	return seg, nil
+}
+
+// skipEntropyData advances the reader's offset past entropy-coded data
+// to the next marker that is not a stuffed byte or an RSTn marker.
+func (sr *JPEGSegmentReader) skipEntropyData() error {
+	var buf [512]byte
+	for {
+		n, err := sr.r.ReadAt(buf[:], sr.off)
+		if n < 2 {
+			if err == nil || err == io.EOF {
+				err = io.ErrUnexpectedEOF
+			}
+			return err
+		}
+		for i := 0; i < n-1; i++ {
+			if buf[i] != 0xff {
+				continue
+			}
+			m := buf[i+1]
+			if m == 0 || (m >= markerRST0 && m <= markerRST7) {
+				i++ // Stuffed byte or restart marker, both part of entropy-coded data.
+				continue
+			}
+			if m != 0xff {
+				sr.off += int64(i)
+				sr.entropy = false
+				return nil
+			}
+		}
+		// Last byte is read again in case it is the 0xff of a marker.
+		sr.off += int64(n - 1)
+	}
+}
+
+// findJPEGExif returns the first APP1 segment of the JPEG image in r which contains EXIF data.
+// The search stops at the first SOS segment since metadata precedes the image data.
+func findJPEGExif(r io.ReaderAt) (JPEGSegment, error) {
+	sr := NewJPEGSegmentReader(r)
+	var buf [len(exifHeader)]byte
+	for {
+		seg, err := sr.Next()
+		if err == io.EOF || seg.Marker == markerSOS {
+			return JPEGSegment{}, errors.New("EXIF APP1 segment not found in JPEG")
+		} else if err != nil {
+			return JPEGSegment{}, err
+		}
+		if seg.Marker != markerAPP1 || seg.Length < len(exifHeader) {
+			continue
+		}
+		_, err = r.ReadAt(buf[:], seg.PayloadOffset())
+		if err != nil {
+			return JPEGSegment{}, noEOF(err)
+		}
+		if string(buf[:]) == exifHeader {
+			return seg, nil
+		}
+	}
+}
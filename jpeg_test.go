@@ -0,0 +1,192 @@
+package exif
+
+import (
+	"bytes"
+	"encoding/binary"
+	"io"
+	"testing"
+)
+
+func TestReplaceJPEGExif(t *testing.T) {
+	jfif := []byte("JFIF\x00\x01\x01\x00\x00\x01\x00\x01\x00\x00")
+	dqt := bytes.Repeat([]byte{1}, 65)
+	sos := []byte{1, 1, 0, 0, 63, 0}
+	entropy := []byte{0x12, 0xff, 0x00, 0x34, 0xff, 0xd0, 0x56}
+	oldExif := buildTestTIFF(binary.BigEndian, []testEntry{{id: 0x010f, tp: TypeString, data: []byte("Old\x00")}})
+	newExif, err := Marshal(binary.LittleEndian, []IFD{{Group: GroupIFD0, Tags: []Tag{{ID: 0x010f, data: "New"}}}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	src := testJPEG(
+		testSegment{markerAPP0, jfif},
+		testSegment{markerAPP1, append([]byte(exifHeader), oldExif...)},
+		testSegment{0xdb, dqt},
+		testSegment{markerSOS, sos},
+	)
+	src = append(src, entropy...)
+	src = append(src, 0xff, markerEOI)
+	want := testJPEG(
+		testSegment{markerAPP0, jfif},
+		testSegment{markerAPP1, append([]byte(exifHeader), newExif...)},
+		testSegment{0xdb, dqt},
+		testSegment{markerSOS, sos},
+	)
+	want = append(want, entropy...)
+	want = append(want, 0xff, markerEOI)
+
+	var dst bytes.Buffer
+	err = ReplaceJPEGExif(&dst, bytes.NewReader(src), newExif)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(dst.Bytes(), want) {
+		t.Errorf("replaced EXIF mismatch:\ngot  %q\nwant %q", dst.Bytes(), want)
+	}
+
+	// Insertion when there is no EXIF segment.
+	noExif := testJPEG(testSegment{0xdb, dqt}, testSegment{markerSOS, sos})
+	dst.Reset()
+	err = ReplaceJPEGExif(&dst, bytes.NewReader(noExif), newExif)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want = testJPEG(testSegment{markerAPP1, append([]byte(exifHeader), newExif...)}, testSegment{0xdb, dqt}, testSegment{markerSOS, sos})
+	if !bytes.Equal(dst.Bytes(), want) {
+		t.Errorf("inserted EXIF mismatch:\ngot  %q\nwant %q", dst.Bytes(), want)
+	}
+
+	// Removal.
+	dst.Reset()
+	err = ReplaceJPEGExif(&dst, bytes.NewReader(src), nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if bytes.Contains(dst.Bytes(), []byte(exifHeader)) {
+		t.Error("EXIF not removed")
+	}
+
+	err = ReplaceJPEGExif(&dst, bytes.NewReader(src), make([]byte, MaxJPEGExifSize+1))
+	if err == nil {
+		t.Error("expected error for oversized EXIF")
+	}
+}
+
+type testSegment struct {
+	marker  byte
+	payload []byte
+}
+
+// testJPEG returns a JPEG stream starting with SOI followed by the segments.
+func testJPEG(segments ...testSegment) []byte {
+	var buf bytes.Buffer
+	buf.Write([]byte{0xff, markerSOI})
+	for _, seg := range segments {
+		writeJPEGSegment(&buf, seg.marker, seg.payload)
+	}
+	return buf.Bytes()
+}
+
+func TestJPEGSegmentReader(t *testing.T) {
+	exif, err := Marshal(binary.LittleEndian, []IFD{{Group: GroupIFD0, Tags: []Tag{
+		{ID: 0x010f, data: "Maker"}, // Make.
+	}}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	jpg := testJPEG(
+		testSegment{markerAPP0, []byte("JFIF\x00\x01\x01\x00\x00\x01\x00\x01\x00\x00")},
+		testSegment{markerCOM, []byte("Exif\x00\x00 in a comment")},
+		testSegment{markerAPP1, append([]byte(exifHeader), exif...)},
+		testSegment{0xdb, bytes.Repeat([]byte{1}, 65)},
+		testSegment{markerSOS, []byte{1, 1, 0, 0, 63, 0}},
+	)
+	// Entropy-coded data with stuffed bytes and a restart marker, followed by fill bytes and EOI.
+	jpg = append(jpg, 0x12, 0xff, 0x00, 0x34, 0xff, markerRST0, 0x56, 0xff, 0xff, 0xff, markerEOI)
+
+	want := []struct {
+		marker string
+		offset int64
+		length int
+	}{
+		{"SOI", 0, 0},
+		{"APP0", 2, 14},
+		{"COM", 20, 19},
+		{"APP1", 43, 6 + len(exif)},
+		{"DQT", 43 + 4 + 6 + int64(len(exif)), 65},
+		{"SOS", 43 + 4 + 6 + int64(len(exif)) + 69, 6},
+		{"EOI", int64(len(jpg)) - 2, 0},
+	}
+	sr := NewJPEGSegmentReader(bytes.NewReader(jpg))
+	for i := 0; ; i++ {
+		seg, err := sr.Next()
+		if err == io.EOF {
+			if i != len(want) {
+				t.Fatalf("got %d segments, want %d", i, len(want))
+			}
+			break
+		} else if err != nil {
+			t.Fatal(err)
+		}
+		if i >= len(want) {
+			t.Fatalf("unexpected segment %s", seg.Marker.String())
+		}
+		if seg.Marker.String() != want[i].marker || seg.Offset != want[i].offset || seg.Length != want[i].length {
+			t.Errorf("segment %d: got %s at %d length %d, want %s at %d length %d", i,
+				seg.Marker.String(), seg.Offset, seg.Length, want[i].marker, want[i].offset, want[i].length)
+		}
+	}
+
+	offset, err := FindStartOffset(bytes.NewReader(jpg), nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if offset != 43+4+6 {
+		t.Errorf("got EXIF start offset %d, want %d", offset, 43+4+6)
+	}
+	var decoder LazyDecoder
+	r := bytes.NewReader(jpg)
+	err = decoder.Decode(r)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if decoder.EndOfApp1() != want[4].offset {
+		t.Errorf("got end of APP1 %d, want %d", decoder.EndOfApp1(), want[4].offset)
+	}
+	tag, err := decoder.GetTag(r, GroupIFD0, 0x010f)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := tag.String(); got != "Make (string): Maker\x00" {
+		t.Errorf("got tag %q", got)
+	}
+}
+
+// emptyReaderAt reads from a bytes.Reader but returns no data and a nil error at
+// offsets past its end, which io.ReaderAt permits.
+type emptyReaderAt struct{ *bytes.Reader }
+
+func (r emptyReaderAt) ReadAt(p []byte, off int64) (int, error) {
+	if off >= r.Size() {
+		return 0, nil
+	}
+	return r.Reader.ReadAt(p, off)
+}
+
+func TestJPEGSegmentReader_emptyRead(t *testing.T) {
+	jpg := testJPEG(testSegment{markerCOM, []byte("comment")})
+	sr := NewJPEGSegmentReader(emptyReaderAt{bytes.NewReader(jpg)})
+	for i := 0; i < 2; i++ {
+		_, err := sr.Next()
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+	_, err := sr.Next()
+	if err != io.ErrUnexpectedEOF {
+		t.Errorf("expected io.ErrUnexpectedEOF, got %v", err)
+	}
+	_, err = sr.Next()
+	if err != io.EOF {
+		t.Errorf("expected io.EOF after end of data, got %v", err)
+	}
+}
@@ -0,0 +1,109 @@
+package exif
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+
+	"github.com/soypat/exif/internal/brotli"
+)
+
+// jxlSignature is the signature box which begins JPEG XL container files.
+const jxlSignature = "\x00\x00\x00\x0cJXL \r\n\x87\n"
+
+// FindJXLExif searches the boxes of the JPEG XL container in r for the Exif box and
+// returns a reader of the TIFF formatted EXIF data, which may be passed to [LazyDecoder.Decode].
+// Brotli compressed Exif boxes (brob) are decompressed into memory.
+//
+// Bare JPEG XL codestreams, which begin with 0xff0a, carry no metadata
+// and return an error.
+func FindJXLExif(r io.ReaderAt) (*io.SectionReader, error) {
+	offset, size, data, err := findJXLExif(r)
+	if err != nil {
+		return nil, err
+	}
+	if data != nil {
+		return io.NewSectionReader(bytes.NewReader(data), 0, int64(len(data))), nil
+	}
+	return io.NewSectionReader(r, offset, size), nil
+}
+
+// findJXLExif returns the offset and size of the EXIF data in the Exif box of the
+// JPEG XL container in r. If the box is Brotli compressed its decompressed data is returned instead.
+func findJXLExif(r io.ReaderAt) (offset, size int64, data []byte, err error) {
+	var sig [len(jxlSignature)]byte
+	_, err = r.ReadAt(sig[:], 0)
+	if string(sig[:2]) == "\xff\x0a" {
+		return 0, 0, nil, errors.New("bare JPEG XL codestream contains no metadata")
+	} else if err != nil {
+		return 0, 0, nil, noEOF(err)
+	}
+	if string(sig[:]) != jxlSignature {
+		return 0, 0, nil, errors.New("missing JPEG XL signature box")
+	}
+	for off := int64(len(sig)); ; {
+		box, err := readBMFFBox(r, off, math.MaxInt64)
+		if err == io.EOF {
+			return 0, 0, nil, errors.New("JPEG XL Exif box not found")
+		} else if err != nil {
+			return 0, 0, nil, err
+		}
+		off = box.end()
+		switch box.typ {
+		case "Exif":
+			if box.size < 4 {
+				return 0, 0, nil, errors.New("short JPEG XL Exif box")
+			}
+			var hdr [4]byte
+			_, err = r.ReadAt(hdr[:], box.offset)
+			if err != nil {
+				return 0, 0, nil, noEOF(err)
+			}
+			// The data begins with the offset to the TIFF header.
+			tiffOffset := 4 + int64(binary.BigEndian.Uint32(hdr[:]))
+			if tiffOffset > box.size {
+				return 0, 0, nil, errors.New("JPEG XL Exif TIFF header offset exceeds box size")
+			}
+			return box.offset + tiffOffset, box.size - tiffOffset, nil, nil
+
+		case "brob":
+			if box.size < 4 {
+				return 0, 0, nil, errors.New("short JPEG XL brob box")
+			}
+			var typ [4]byte
+			_, err = r.ReadAt(typ[:], box.offset)
+			if err != nil {
+				return 0, 0, nil, noEOF(err)
+			}
+			if string(typ[:]) != "Exif" {
+				continue
+			}
+			data, err = decompressBrob(r, box)
+			if err != nil {
+				return 0, 0, nil, err
+			}
+			if len(data) < 4 {
+				return 0, 0, nil, errors.New("short JPEG XL Exif box")
+			}
+			tiffOffset := 4 + int64(binary.BigEndian.Uint32(data))
+			if tiffOffset > int64(len(data)) {
+				return 0, 0, nil, errors.New("JPEG XL Exif TIFF header offset exceeds box size")
+			}
+			return 0, 0, data[tiffOffset:], nil
+		}
+	}
+}
+
+// decompressBrob returns the decompressed payload of a brob box, which follows the original box type.
+func decompressBrob(r io.ReaderAt, box bmffBox) ([]byte, error) {
+	data, err := brotli.Decode(io.NewSectionReader(r, box.offset+4, box.size-4), maxBMFFMetaSize)
+	if err == brotli.ErrTooLarge {
+		return nil, errors.New("decompressed JPEG XL Exif box exceeds size limit")
+	} else if err != nil {
+		return nil, fmt.Errorf("decompressing JPEG XL brob box: %w", err)
+	}
+	return data, nil
+}
@@ -0,0 +1,52 @@
+package exif
+
+import (
+	"bytes"
+	"encoding/binary"
+	"encoding/hex"
+	"strings"
+	"testing"
+)
+
+func TestLazyDecoder_jxl(t *testing.T) {
+	exif, err := Marshal(binary.LittleEndian, []IFD{{Group: GroupIFD0, Tags: []Tag{
+		{ID: 0x010f, data: "Maker"}, // Make.
+	}}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	payload := append(testU32(6), exifHeader...)
+	payload = append(payload, exif...)
+	ftyp := testBox("ftyp", []byte("jxl "), testU32(0), []byte("jxl "))
+	codestream := testBox("jxlc", []byte{0xff, 0x0a, 1, 2, 3, 4, 5, 6, 7, 8})
+
+	jxl := append([]byte(jxlSignature), ftyp...)
+	jxl = append(jxl, codestream...)
+	testDecodeMake(t, append(jxl, testBox("Exif", payload)...), "Maker")
+
+	// Brotli compressed box holding the same payload.
+	compressed, _ := hex.DecodeString("220500ff10e4ee3ef865798089074fd29646952422c54305b9ff3d542d1d6144939880401e")
+	brob := append(jxl, testBox("brob", []byte("Exif"), compressed)...)
+	testDecodeMake(t, brob, "Maker")
+
+	// Truncated compressed data.
+	brob = append(jxl, testBox("brob", []byte("Exif"), compressed[:len(compressed)-4])...)
+	var decoder LazyDecoder
+	err = decoder.Decode(bytes.NewReader(brob))
+	if err == nil || !strings.Contains(err.Error(), "brob") {
+		t.Errorf("expected Brotli decompression error, got %v", err)
+	}
+
+	// brob box too short to hold the original box type.
+	brob = append(jxl, testBox("brob", []byte("Ex"))...)
+	brob = append(brob, testBox("Exif", payload)...)
+	err = decoder.Decode(bytes.NewReader(brob))
+	if err == nil || !strings.Contains(err.Error(), "short JPEG XL brob box") {
+		t.Errorf("expected short brob box error, got %v", err)
+	}
+
+	err = decoder.Decode(bytes.NewReader(codestream[8:]))
+	if err == nil || !strings.Contains(err.Error(), "codestream") {
+		t.Errorf("expected bare codestream error, got %v", err)
+	}
+}
@@ -0,0 +1,350 @@
+package exif
+
+import (
+	"encoding/binary"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+	"sync"
+)
+
+// IDs of tags used to identify and decode maker notes.
+const (
+	idMake      ID = 0x010f
+	idModel     ID = 0x0110
+	idMakerNote ID = 0x927c
+)
+
+// makerNoteHeaderSize is the number of bytes at the start of a maker
+// note read to identify its format.
+const makerNoteHeaderSize = 32
+
+// MakerNoteDecoder decodes maker notes of a proprietary format. Decoders registered
+// with [RegisterMakerNoteDecoder] are consulted by [LazyDecoder.Decode] when it finds
+// a MakerNote tag (0x927c) in the ExifIFD.
+type MakerNoteDecoder interface {
+	// Match reports whether the decoder decodes the maker note given the Make
+	// tag of IFD0 and up to the first 32 bytes of the maker note.
+	Match(make string, header []byte) bool
+	// Decode decodes the maker note at offset base of r, which reads the EXIF
+	// data with offsets relative to the TIFF header. order is the byte order of
+	// the EXIF data. The first returned IFD is the maker note directory, which
+	// becomes a child of the ExifIFD. Other IFDs become children of the first.
+	// IFDs of GroupNone are assigned GroupMakerNote.
+	Decode(r io.ReaderAt, base int64, order binary.ByteOrder) ([]IFD, error)
+}
+
+var (
+	makerNoteDecodersMu sync.Mutex
+	makerNoteDecoders   []MakerNoteDecoder
+)
+
+// RegisterMakerNoteDecoder registers a decoder of maker notes. Registered decoders
+// are consulted in order of registration before the maker note formats supported
+// by the package, so they may replace them. It is usually called from an init function.
+func RegisterMakerNoteDecoder(d MakerNoteDecoder) {
+	makerNoteDecodersMu.Lock()
+	defer makerNoteDecodersMu.Unlock()
+	makerNoteDecoders = append(makerNoteDecoders, d)
+}
+
+// registeredMakerNoteDecoders returns the decoders registered with RegisterMakerNoteDecoder.
+func registeredMakerNoteDecoders() []MakerNoteDecoder {
+	makerNoteDecodersMu.Lock()
+	defer makerNoteDecodersMu.Unlock()
+	return makerNoteDecoders[:len(makerNoteDecoders):len(makerNoteDecoders)]
+}
+
+// makerNote describes the format of a camera vendor's maker note,
+// which is stored in the MakerNote tag of the ExifIFD.
+type makerNote struct {
+	group Group
+	// match reports whether the maker note is of this format given the
+	// Make tag of IFD0 and the first bytes of the maker note.
+	match func(make string, header []byte) bool
+	// ifd returns the offset of the maker note's IFD and the offset its value offsets
+	// are relative to, both relative to the TIFF header, and the IFD's byte order.
+	// offset is the offset of the maker note and order the byte order of the EXIF data.
+	// If ifd is nil the maker note is decoded as a binary array of bytes. See newBinaryDir.
+	ifd func(header []byte, offset int64, order binary.ByteOrder) (ifdOffset, base int64, ifdOrder binary.ByteOrder, err error)
+	// expand decodes binary arrays of the maker note IFD into directories
+	// returned as children of dir. It may be nil.
+	expand func(lt *LazyDecoder, r io.ReaderAt, dir *Dir, model string) ([]*Dir, error)
+}
+
+// makerNotes is the registry of maker note formats decoded by LazyDecoder. Formats are
+// identified by the signature at the start of the maker note or, for maker notes
+// without a header, by the Make tag. They are matched in order.
+var makerNotes = []makerNote{
+	{group: GroupCanon, match: matchCanon, ifd: canonIFD, expand: expandCanon},
+	{group: GroupNikon, match: matchNikon, ifd: nikonIFD, expand: expandNikon},
+	{group: GroupSony, match: matchSony, ifd: sonyIFD},
+	{group: GroupFujifilm, match: matchFujifilm, ifd: fujifilmIFD},
+	{group: GroupOlympus, match: matchOlympus, ifd: olympusIFD, expand: expandOlympus},
+	{group: GroupPanasonic, match: matchPanasonic, ifd: panasonicIFD},
+	{group: GroupPentax, match: matchPentax, ifd: pentaxIFD},
+	{group: GroupApple, match: matchApple, ifd: appleIFD, expand: expandApple},
+	{group: GroupSamsung, match: matchSamsung, ifd: samsungIFD},
+	{group: GroupGoogle, match: matchGoogle},
+}
+
+// decodeMakerNote decodes the maker note of the ExifIFD exif if its format is known,
+// appending the maker note IFD and the directories decoded from it to the decoder.
+// Nothing is appended if the maker note is not found or fails to decode.
+func (lt *LazyDecoder) decodeMakerNote(r io.ReaderAt, ifd0, exif *Dir) error {
+	lztag, ok := exif.tag(idMakerNote)
+	if !ok || lztag.dataOffset() <= 0 {
+		return nil // Maker notes are never small enough to be stored in place.
+	}
+	cameraMake, err := lt.stringTag(r, ifd0, idMake)
+	if err != nil {
+		return err
+	}
+	var header [makerNoteHeaderSize]byte
+	n, err := r.ReadAt(header[:], lztag.dataOffset())
+	if n < len(header) && err != nil && err != io.EOF {
+		return err
+	}
+	hdr := header[:n]
+	if n > lztag.length {
+		hdr = hdr[:lztag.length]
+	}
+	for _, d := range registeredMakerNoteDecoders() {
+		if !d.Match(cameraMake, hdr) {
+			continue
+		}
+		ifds, err := d.Decode(r, lztag.dataOffset(), lt.order)
+		if err != nil {
+			return fmt.Errorf("decoding maker note: %w", err)
+		}
+		if len(ifds) == 0 {
+			return nil
+		}
+		dirs, err := newIFDDirs(ifds, lt.order)
+		if err != nil {
+			return fmt.Errorf("decoding maker note: %w", err)
+		}
+		dir := dirs[0]
+		dir.Offset = lztag.dataOffset()
+		dir.Parent = exif
+		dir.ParentTag = idMakerNote
+		for _, child := range dirs[1:] {
+			child.Parent = dir
+			dir.Children = append(dir.Children, child)
+		}
+		exif.Children = append(exif.Children, dir)
+		lt.dirs = append(lt.dirs, dirs...)
+		return nil
+	}
+	for _, mn := range makerNotes {
+		if !mn.match(cameraMake, hdr) {
+			continue
+		}
+		dir, err := lt.decodeMakerNoteDir(r, mn, exif, lztag, hdr)
+		if err != nil {
+			return fmt.Errorf("decoding %s maker note: %w", mn.group.String(), err)
+		}
+		dir.Parent = exif
+		dir.ParentTag = idMakerNote
+		dirs := []*Dir{dir}
+		if mn.expand != nil {
+			model, _ := lt.stringTag(r, ifd0, idModel)
+			dir.Children, err = mn.expand(lt, r, dir, model)
+			if err != nil {
+				return fmt.Errorf("decoding %s maker note: %w", mn.group.String(), err)
+			}
+			dirs = append(dirs, dir.Children...)
+		}
+		exif.Children = append(exif.Children, dir)
+		lt.dirs = append(lt.dirs, dirs...)
+		return nil
+	}
+	return nil
+}
+
+// decodeMakerNoteDir decodes the directory of the maker note lztag of the ExifIFD exif
+// in the format mn. header holds the first bytes of the maker note.
+func (lt *LazyDecoder) decodeMakerNoteDir(r io.ReaderAt, mn makerNote, exif *Dir, lztag lazytag, header []byte) (*Dir, error) {
+	if mn.ifd == nil {
+		data, err := lt.tagData(r, lztag, exif)
+		if err != nil {
+			return nil, err
+		}
+		dir := newBinaryDir(append([]byte{}, data...), lt.order, mn.group, 1)
+		dir.Offset = lztag.dataOffset()
+		return dir, nil
+	}
+	offset, base, order, err := mn.ifd(header, lztag.dataOffset(), lt.order)
+	if err != nil {
+		return nil, err
+	}
+	dir, _, err := decodeDir(r, offset, order, lt.bigTIFF, base)
+	if err != nil {
+		return nil, err
+	}
+	dir.Group = mn.group
+	return dir, nil
+}
+
+// newIFDDirs returns directories holding the tags of the IFDs encoded in byte order
+// order, as returned by a MakerNoteDecoder. IFDs of GroupNone are assigned GroupMakerNote.
+func newIFDDirs(ifds []IFD, order binary.ByteOrder) ([]*Dir, error) {
+	dirs := make([]*Dir, len(ifds))
+	for i, ifd := range ifds {
+		g := ifd.Group
+		if g == GroupNone {
+			g = GroupMakerNote
+		}
+		// Offset zero denotes values stored in place, so data begins with padding.
+		dir := &Dir{Group: g, order: order, data: make([]byte, 1)}
+		for _, tag := range ifd.Tags {
+			tag.Group = g
+			entry, err := newEncentry(order, tag)
+			if err != nil {
+				return nil, fmt.Errorf("encoding %s tag %s: %w", g.String(), tag.ID.StringGroup(g), err)
+			}
+			lztag := lazytag{ID: entry.id, Type: entry.tp, length: len(entry.data)}
+			if lztag.length <= len(lztag.value) {
+				copy(lztag.value[:], entry.data)
+			} else {
+				lztag.offset = int64(len(dir.data))
+				dir.data = append(dir.data, entry.data...)
+			}
+			dir.tags = append(dir.tags, lztag)
+		}
+		dirs[i] = dir
+	}
+	return dirs, nil
+}
+
+// stringTag returns the value of the ASCII tag with the given ID of dir with trailing
+// null bytes and spaces removed. It returns an empty string if the tag is not found.
+func (lt *LazyDecoder) stringTag(r io.ReaderAt, dir *Dir, id ID) (string, error) {
+	lztag, ok := dir.tag(id)
+	if !ok {
+		return "", nil
+	}
+	data, err := lt.tagData(r, lztag, dir)
+	if err != nil {
+		return "", err
+	}
+	return strings.TrimRight(string(data), "\x00 "), nil
+}
+
+// decodeBinaryDir decodes the binary array contained in the tag with the given ID
+// of parent as a directory of group g. It returns nil if the tag is not found.
+// See newBinaryDir.
+func (lt *LazyDecoder) decodeBinaryDir(r io.ReaderAt, parent *Dir, id ID, g Group, unit int) (*Dir, error) {
+	lztag, ok := parent.tag(id)
+	if !ok {
+		return nil, nil
+	}
+	data, err := lt.tagData(r, lztag, parent)
+	if err != nil {
+		return nil, err
+	}
+	dir := newBinaryDir(append([]byte{}, data...), parent.order, g, unit)
+	dir.Offset = lztag.dataOffset()
+	dir.Parent = parent
+	dir.ParentTag = id
+	return dir, nil
+}
+
+// decodeSubIFD decodes the IFD pointed to by the tag with the given ID of the maker note
+// directory parent as a directory of group g. It returns nil if the tag is not found.
+// The tag either contains the IFD as an undefined value or its offset relative to
+// the maker note's base. Value offsets of the IFD are relative to the same base.
+func (lt *LazyDecoder) decodeSubIFD(r io.ReaderAt, parent *Dir, id ID, g Group) (*Dir, error) {
+	lztag, ok := parent.tag(id)
+	if !ok {
+		return nil, nil
+	}
+	offset := lztag.dataOffset()
+	if offset < 0 {
+		return nil, nil
+	}
+	if offset == 0 {
+		ptr := parent.pointer(id)
+		if ptr == 0 {
+			return nil, nil
+		}
+		offset = parent.base + ptr
+	}
+	dir, _, err := decodeDir(r, offset, parent.order, false, parent.base)
+	if err != nil {
+		return nil, err
+	}
+	dir.Group = g
+	dir.Parent = parent
+	dir.ParentTag = id
+	return dir, nil
+}
+
+// headerOrder returns the byte order of the "II" or "MM" byte order mark
+// found in a maker note header, or order if mark is neither.
+func headerOrder(mark []byte, order binary.ByteOrder) binary.ByteOrder {
+	switch string(mark) {
+	case "II":
+		return binary.LittleEndian
+	case "MM":
+		return binary.BigEndian
+	}
+	return order
+}
+
+// newBinaryDir returns a directory of group g containing the entries of the binary
+// array data which are defined in g's tag table. The ID of a tag is the index of the
+// entry in the array, in units of unit bytes. Entries which do not fit in data are omitted.
+// Entries of undefined length span the rest of the array.
+func newBinaryDir(data []byte, order binary.ByteOrder, g Group, unit int) *Dir {
+	dir := &Dir{Group: g, order: order, data: data}
+	table := g.tagTable()
+	for key, def := range tags {
+		if key.table != table {
+			continue
+		}
+		start := int(key.id) * unit
+		count := 1
+		switch {
+		case def.arrayLen[0] > 1:
+			count = def.arrayLen[0]
+		case def.arrayLen[1] == 0 && def.Type.Size() != 0:
+			// Entries of undefined length extend to the end of the array.
+			count = (len(data) - start) / int(def.Type.Size())
+		}
+		length := count * int(def.Type.Size())
+		if length == 0 || start+length > len(data) {
+			continue
+		}
+		lztag := lazytag{ID: key.id, Type: def.Type, length: length}
+		if length <= len(lztag.value) {
+			copy(lztag.value[:], data[start:start+length])
+		} else {
+			lztag.offset = int64(start)
+		}
+		dir.tags = append(dir.tags, lztag)
+	}
+	sort.Slice(dir.tags, func(i, j int) bool { return dir.tags[i].ID < dir.tags[j].ID })
+	return dir
+}
+
+// tag returns the tag with the given ID of the directory.
+func (d *Dir) tag(id ID) (lazytag, bool) {
+	for _, lztag := range d.tags {
+		if lztag.ID == id {
+			return lztag, true
+		}
+	}
+	return lazytag{}, false
+}
+
+// removeTag removes the tag with the given ID from the directory.
+func (d *Dir) removeTag(id ID) {
+	for i := range d.tags {
+		if d.tags[i].ID == id {
+			d.tags = append(d.tags[:i], d.tags[i+1:]...)
+			return
+		}
+	}
+}
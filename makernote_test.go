@@ -0,0 +1,544 @@
+package exif
+
+import (
+	"bytes"
+	"encoding/binary"
+	"io"
+	"strconv"
+	"testing"
+)
+
+// testU16s returns the 16 bit values v encoded in byte order order.
+func testU16s(order binary.ByteOrder, v ...uint16) []byte {
+	b := make([]byte, 2*len(v))
+	for i := range v {
+		order.PutUint16(b[2*i:], v[i])
+	}
+	return b
+}
+
+func TestLazyDecoder_canon(t *testing.T) {
+	order := binary.LittleEndian
+	settings := make([]uint16, 23)
+	settings[0] = 2 * 23
+	settings[1] = 2    // MacroMode: Normal.
+	settings[22] = 137 // LensType.
+	info := make([]uint16, 22)
+	info[0] = 2 * 22
+	info[1], info[2] = 0x0001, 0xe240 // ShutterCount 0x0001e240 with swapped halves.
+	info[20], info[21] = 250, 180     // FocusDistanceUpper and FocusDistanceLower.
+	for _, test := range []struct {
+		model        string
+		shutterCount int64
+	}{
+		{model: "Canon EOS-1D X\x00", shutterCount: 123456},
+		{model: "Canon EOS 5D Mark IV\x00"},
+	} {
+		tiff := buildTestTIFF(order,
+			[]testEntry{
+				{id: 0x010f, tp: TypeString, data: []byte("Canon\x00")},
+				{id: 0x0110, tp: TypeString, data: []byte(test.model)},
+				{id: 0x8769, tp: TypeUint32, dir: 1},
+			},
+			[]testEntry{{id: 0x927c, tp: TypeUndefined, undefDir: 2}},
+			[]testEntry{
+				{id: 0x0001, tp: TypeInt16, data: testU16s(order, settings...)},
+				{id: 0x000c, tp: TypeUint32, data: []byte{0x78, 0x56, 0x34, 0x12}},
+				{id: 0x0093, tp: TypeInt16, data: testU16s(order, info...)},
+				{id: 0x0095, tp: TypeString, data: []byte("EF24-70mm f/2.8L II USM\x00")},
+			},
+		)
+		var decoder LazyDecoder
+		r := bytes.NewReader(tiff)
+		err := decoder.Decode(r)
+		if err != nil {
+			t.Fatal(err)
+		}
+		canon := decoder.Dir(GroupCanon)
+		if canon == nil {
+			t.Fatal("Canon maker note not decoded")
+		}
+		exif := decoder.Dir(GroupExifIFD)
+		if canon.Parent != exif || canon.ParentTag != 0x927c || exif.Children[0] != canon {
+			t.Error("Canon maker note not linked to ExifIFD")
+		}
+		if len(canon.Children) != 2 || canon.Children[0].Group != GroupCanonCameraSettings || canon.Children[1].Group != GroupCanonFileInfo {
+			t.Fatalf("got %d Canon maker note children, want CanonCameraSettings and CanonFileInfo", len(canon.Children))
+		}
+		lens, err := decoder.GetTag(r, GroupCanon, 0x0095)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if s, _ := lens.Describe(); s != "EF24-70mm f/2.8L II USM\x00" {
+			t.Errorf("got LensModel %q", s)
+		}
+		for _, want := range []struct {
+			group Group
+			id    ID
+			value int64
+			desc  string
+		}{
+			{GroupCanon, 0x000c, 0x12345678, "305419896"},
+			{GroupCanonCameraSettings, 0x0001, 2, "Normal"},
+			{GroupCanonCameraSettings, 0x0016, 137, "137"},
+			{GroupCanonFileInfo, 0x0014, 250, "250"},
+			{GroupCanonFileInfo, 0x0015, 180, "180"},
+		} {
+			tag, err := decoder.GetTag(r, want.group, want.id)
+			if err != nil {
+				t.Fatalf("%s %s: %v", want.group.String(), want.id.StringGroup(want.group), err)
+			}
+			v, err := tag.Int()
+			desc, _ := tag.Describe()
+			if err != nil || v != want.value || desc != want.desc {
+				t.Errorf("%s: got %d %q (%v), want %d %q", tag.ID.StringGroup(tag.Group), v, desc, err, want.value, want.desc)
+			}
+		}
+		tag, err := decoder.GetTag(r, GroupCanonFileInfo, 0x0001)
+		if test.shutterCount == 0 {
+			if err == nil {
+				t.Errorf("%s: unexpected ShutterCount", test.model)
+			}
+			continue
+		}
+		if err != nil {
+			t.Fatal(err)
+		}
+		if v, _ := tag.Int(); v != test.shutterCount {
+			t.Errorf("got ShutterCount %d, want %d", v, test.shutterCount)
+		}
+	}
+}
+
+func TestLazyDecoder_nikon(t *testing.T) {
+	const serial, count = 3001234, 0x00012345
+	order := binary.BigEndian
+	lensData := []byte("0204")
+	lensData = append(lensData, 0x22, 0x10, 0, 0, 0x50, 0xff, 0x30, 0x62, 0x7a, 0x40, 0x2d, 0x50, 0x2c, 0x3c, 0x4b, 0x4c)
+	nikonDecrypt(lensData[4:], serial, count) // Encryption is its own inverse.
+	shotInfo := append([]byte("0209"), "1.00 "...)
+	nikonDecrypt(shotInfo[4:], serial, count)
+	makerNote := append([]byte("Nikon\x00\x02\x10\x00\x00"), buildTestTIFF(order, []testEntry{
+		{id: 0x001d, tp: TypeString, data: []byte(strconv.Itoa(serial) + "\x00")},
+		{id: 0x0083, tp: TypeUint8, data: []byte{0x06}},
+		{id: 0x0091, tp: TypeUndefined, data: shotInfo},
+		{id: 0x0098, tp: TypeUndefined, data: lensData},
+		{id: 0x00a7, tp: TypeUint32, data: testU32(count)},
+	})...)
+	tiff := buildTestTIFF(binary.LittleEndian,
+		[]testEntry{
+			{id: 0x010f, tp: TypeString, data: []byte("NIKON CORPORATION\x00")},
+			{id: 0x8769, tp: TypeUint32, dir: 1},
+		},
+		[]testEntry{{id: 0x927c, tp: TypeUndefined, data: makerNote}},
+	)
+	var decoder LazyDecoder
+	r := bytes.NewReader(tiff)
+	err := decoder.Decode(r)
+	if err != nil {
+		t.Fatal(err)
+	}
+	nikon := decoder.Dir(GroupNikon)
+	if nikon == nil || len(nikon.Children) != 2 {
+		t.Fatal("Nikon maker note not decoded")
+	}
+	shutterCount, err := decoder.GetTag(r, GroupNikon, 0x00a7)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if v, _ := shutterCount.Int(); v != count {
+		t.Errorf("got ShutterCount %d, want %d", v, count)
+	}
+	for _, want := range []struct {
+		group Group
+		id    ID
+		desc  string
+	}{
+		{GroupNikonLensData, 0x0000, "0204"},
+		{GroupNikonLensData, 0x0009, "48"},  // FocusDistance, after the byte inserted by version 0204.
+		{GroupNikonLensData, 0x000b, "122"}, // LensIDNumber.
+		{GroupNikonLensData, 0x0012, "76"},  // EffectiveMaxAperture.
+		{GroupNikonLensData, 0x0100, "7A 40 2D 50 2C 3C 4B 06"},
+		{GroupNikonShotInfo, 0x0000, "0209"},
+		{GroupNikonShotInfo, 0x0004, "1.00 "},
+	} {
+		tag, err := decoder.GetTag(r, want.group, want.id)
+		if err != nil {
+			t.Fatalf("%s %s: %v", want.group.String(), want.id.StringGroup(want.group), err)
+		}
+		if desc, err := tag.Describe(); err != nil || desc != want.desc {
+			t.Errorf("%s: got %q (%v), want %q", tag.ID.StringGroup(tag.Group), desc, err, want.desc)
+		}
+	}
+}
+
+// buildTestIFD returns an IFD located at offset containing entries, followed by
+// their values which do not fit in the IFD. Pointer entries are not supported.
+func buildTestIFD(order binary.ByteOrder, offset int, entries []testEntry) []byte {
+	b := make([]byte, 2+12*len(entries)+4)
+	order.PutUint16(b, uint16(len(entries)))
+	for i, entry := range entries {
+		ptr := 2 + 12*i
+		order.PutUint16(b[ptr:], uint16(entry.id))
+		order.PutUint16(b[ptr+2:], uint16(entry.tp))
+		order.PutUint32(b[ptr+4:], uint32(len(entry.data)/int(entry.tp.Size())))
+		if len(entry.data) <= 4 {
+			copy(b[ptr+8:ptr+12], entry.data)
+		} else {
+			order.PutUint32(b[ptr+8:], uint32(offset+len(b)))
+			b = append(b, entry.data...)
+		}
+	}
+	return b
+}
+
+// buildMakerNoteTIFF builds TIFF data with the Make tag cameraMake and an ExifIFD
+// containing a MakerNote. makerNote returns the maker note given its offset,
+// which must not change the length of the maker note.
+func buildMakerNoteTIFF(order binary.ByteOrder, cameraMake string, makerNote func(offset int) []byte) []byte {
+	build := func(mn []byte) []byte {
+		return buildTestTIFF(order,
+			[]testEntry{
+				{id: 0x010f, tp: TypeString, data: []byte(cameraMake + "\x00")},
+				{id: 0x8769, tp: TypeUint32, dir: 1},
+			},
+			[]testEntry{{id: 0x927c, tp: TypeUndefined, data: mn}},
+		)
+	}
+	mn := makerNote(0)
+	// The maker note is the last value of the TIFF data.
+	return build(makerNote(len(build(mn)) - len(mn)))
+}
+
+func TestLazyDecoder_makerNotes(t *testing.T) {
+	le, be := binary.LittleEndian, binary.BigEndian
+	for _, test := range []struct {
+		name      string
+		order     binary.ByteOrder
+		make      string
+		group     Group
+		makerNote func(offset int, entries []testEntry) []byte
+		// mnOrder is the byte order of the maker note IFD.
+		mnOrder binary.ByteOrder
+		strID   ID
+		intID   ID
+		// intValue of the 16 bit tag intID, described as intDesc.
+		intValue uint16
+		intDesc  string
+	}{
+		{
+			name: "Sony DSC", order: le, make: "SONY", group: GroupSony, mnOrder: le,
+			makerNote: func(offset int, entries []testEntry) []byte {
+				return append([]byte("SONY DSC \x00\x00\x00"), buildTestIFD(le, offset+12, entries)...)
+			},
+			strID: 0xb020, intID: 0x2009, intValue: 1, intDesc: "Low",
+		},
+		{
+			name: "Sony without header", order: be, make: "SONY", group: GroupSony, mnOrder: be,
+			makerNote: func(offset int, entries []testEntry) []byte {
+				return buildTestIFD(be, offset, entries)
+			},
+			strID: 0xb020, intID: 0x2009, intValue: 1, intDesc: "Low",
+		},
+		{
+			name: "Fujifilm", order: be, make: "FUJIFILM", group: GroupFujifilm, mnOrder: le,
+			makerNote: func(offset int, entries []testEntry) []byte {
+				return append([]byte("FUJIFILM\x0c\x00\x00\x00"), buildTestIFD(le, 12, entries)...)
+			},
+			strID: 0x1000, intID: 0x1002, intValue: 2, intDesc: "Auto (ambiance priority)",
+		},
+		{
+			name: "Olympus", order: be, make: "OLYMPUS IMAGING CORP.", group: GroupOlympus, mnOrder: le,
+			makerNote: func(offset int, entries []testEntry) []byte {
+				return append([]byte("OLYMPUS\x00II\x03\x00"), buildTestIFD(le, 12, entries)...)
+			},
+			strID: 0x0207, intID: 0x0201, intValue: 3, intDesc: "SHQ",
+		},
+		{
+			name: "OM System", order: le, make: "OM Digital Solutions", group: GroupOlympus, mnOrder: be,
+			makerNote: func(offset int, entries []testEntry) []byte {
+				return append([]byte("OM SYSTEM\x00\x00\x00MM\x04\x00"), buildTestIFD(be, 16, entries)...)
+			},
+			strID: 0x0207, intID: 0x0201, intValue: 3, intDesc: "SHQ",
+		},
+		{
+			name: "Olympus old", order: le, make: "OLYMPUS OPTICAL CO.,LTD", group: GroupOlympus, mnOrder: le,
+			makerNote: func(offset int, entries []testEntry) []byte {
+				return append([]byte("OLYMP\x00\x01\x00"), buildTestIFD(le, offset+8, entries)...)
+			},
+			strID: 0x0207, intID: 0x0201, intValue: 3, intDesc: "SHQ",
+		},
+		{
+			name: "Panasonic", order: le, make: "Panasonic", group: GroupPanasonic, mnOrder: le,
+			makerNote: func(offset int, entries []testEntry) []byte {
+				return append([]byte("Panasonic\x00\x00\x00"), buildTestIFD(le, offset+12, entries)...)
+			},
+			strID: 0x0051, intID: 0x0003, intValue: 3, intDesc: "Cloudy",
+		},
+		{
+			name: "Samsung", order: le, make: "samsung", group: GroupSamsung, mnOrder: le,
+			makerNote: func(offset int, entries []testEntry) []byte {
+				return buildTestIFD(le, 0, entries)
+			},
+			strID: 0xa002, intID: 0xa011, intValue: 1, intDesc: "Adobe RGB",
+		},
+		{
+			name: "Pentax AOC", order: le, make: "PENTAX Corporation", group: GroupPentax, mnOrder: be,
+			makerNote: func(offset int, entries []testEntry) []byte {
+				return append([]byte("AOC\x00MM"), buildTestIFD(be, offset+6, entries)...)
+			},
+			strID: 0x0229, intID: 0x0019, intValue: 2, intDesc: "Shade",
+		},
+		{
+			name: "Pentax", order: be, make: "RICOH IMAGING COMPANY, LTD.", group: GroupPentax, mnOrder: le,
+			makerNote: func(offset int, entries []testEntry) []byte {
+				return append([]byte("PENTAX \x00II"), buildTestIFD(le, 10, entries)...)
+			},
+			strID: 0x0229, intID: 0x0019, intValue: 2, intDesc: "Shade",
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			entries := []testEntry{
+				{id: test.intID, tp: TypeUint16, data: testU16s(test.mnOrder, test.intValue)},
+				{id: test.strID, tp: TypeString, data: []byte("maker note string\x00")},
+			}
+			if test.intID > test.strID {
+				entries[0], entries[1] = entries[1], entries[0]
+			}
+			tiff := buildMakerNoteTIFF(test.order, test.make, func(offset int) []byte {
+				return test.makerNote(offset, entries)
+			})
+			var decoder LazyDecoder
+			r := bytes.NewReader(tiff)
+			err := decoder.Decode(r)
+			if err != nil {
+				t.Fatal(err)
+			}
+			dir := decoder.Dir(test.group)
+			if dir == nil {
+				t.Fatalf("%s maker note not decoded", test.group.String())
+			}
+			if dir.Parent != decoder.Dir(GroupExifIFD) || dir.ParentTag != 0x927c {
+				t.Errorf("%s maker note not linked to ExifIFD", test.group.String())
+			}
+			str, err := decoder.GetTag(r, test.group, test.strID)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if s, _ := str.Describe(); s != "maker note string\x00" {
+				t.Errorf("got %s %q", str.ID.StringGroup(test.group), s)
+			}
+			tag, err := decoder.GetTag(r, test.group, test.intID)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if desc, err := tag.Describe(); err != nil || desc != test.intDesc {
+				t.Errorf("got %s %q (%v), want %q", tag.ID.StringGroup(test.group), desc, err, test.intDesc)
+			}
+		})
+	}
+}
+
+func TestLazyDecoder_olympusSubIFDs(t *testing.T) {
+	order := binary.LittleEndian
+	const header = "OLYMPUS\x00II\x03\x00"
+	// Equipment is stored as an undefined value containing the IFD, which is the
+	// first value of the Olympus IFD. CameraSettings follows the Olympus IFD.
+	equipmentOffset := len(header) + 2 + 12*2 + 4
+	equipment := buildTestIFD(order, equipmentOffset, []testEntry{
+		{id: 0x0203, tp: TypeString, data: []byte("OLYMPUS M.12-40mm F2.8\x00")},
+	})
+	settingsOffset := equipmentOffset + len(equipment)
+	settings := buildTestIFD(order, settingsOffset, []testEntry{
+		{id: 0x0201, tp: TypeUint16, data: testU16s(order, 1)},
+	})
+	makerNote := append([]byte(header), buildTestIFD(order, len(header), []testEntry{
+		{id: 0x2010, tp: TypeUndefined, data: equipment},
+		{id: 0x2020, tp: TypeUint32, data: order.AppendUint32(nil, uint32(settingsOffset))},
+	})...)
+	makerNote = append(makerNote, settings...)
+	tiff := buildMakerNoteTIFF(binary.BigEndian, "OLYMPUS CORPORATION", func(int) []byte { return makerNote })
+	var decoder LazyDecoder
+	r := bytes.NewReader(tiff)
+	err := decoder.Decode(r)
+	if err != nil {
+		t.Fatal(err)
+	}
+	olympus := decoder.Dir(GroupOlympus)
+	if olympus == nil || len(olympus.Children) != 2 {
+		t.Fatal("Olympus maker note sub-IFDs not decoded")
+	}
+	for _, want := range []struct {
+		group Group
+		id    ID
+		desc  string
+	}{
+		{GroupOlympusEquipment, 0x0203, "OLYMPUS M.12-40mm F2.8\x00"},
+		{GroupOlympusCameraSettings, 0x0201, "On"},
+	} {
+		tag, err := decoder.GetTag(r, want.group, want.id)
+		if err != nil {
+			t.Fatalf("%s %s: %v", want.group.String(), want.id.StringGroup(want.group), err)
+		}
+		if desc, err := tag.Describe(); err != nil || desc != want.desc {
+			t.Errorf("%s: got %q (%v), want %q", tag.ID.StringGroup(tag.Group), desc, err, want.desc)
+		}
+	}
+}
+
+func TestLazyDecoder_apple(t *testing.T) {
+	order := binary.BigEndian
+	runTime := testBinaryPlist(
+		[]byte{0xd4, 1, 2, 3, 4, 5, 6, 7, 8},
+		append([]byte{0x55}, "flags"...),
+		append([]byte{0x55}, "value"...),
+		append([]byte{0x59}, "timescale"...),
+		append([]byte{0x55}, "epoch"...),
+		[]byte{0x10, 1},
+		[]byte{0x13, 0, 0, 0x70, 0x48, 0x86, 0x0d, 0xaf, 0x79}, // 123456789000057.
+		[]byte{0x12, 0x3b, 0x9a, 0xca, 0x00},                   // 1000000000.
+		[]byte{0x10, 0},
+	)
+	const header = "Apple iOS\x00\x00\x01MM"
+	makerNote := append([]byte(header), buildTestIFD(order, len(header), []testEntry{
+		{id: 0x0003, tp: TypeUndefined, data: runTime},
+		{id: 0x0011, tp: TypeString, data: []byte("6A4B2F1E-0C3D-4E5F-8A9B-7C6D5E4F3A2B\x00")},
+		{id: 0x0021, tp: TypeRational64, data: []byte{0, 0, 0, 3, 0, 0, 0, 2}},
+	})...)
+	tiff := buildMakerNoteTIFF(binary.LittleEndian, "Apple", func(int) []byte { return makerNote })
+	var decoder LazyDecoder
+	r := bytes.NewReader(tiff)
+	err := decoder.Decode(r)
+	if err != nil {
+		t.Fatal(err)
+	}
+	apple := decoder.Dir(GroupApple)
+	if apple == nil || len(apple.Children) != 1 || apple.Children[0].Group != GroupAppleRunTime {
+		t.Fatal("Apple maker note not decoded")
+	}
+	for _, want := range []struct {
+		group Group
+		id    ID
+		desc  string
+	}{
+		{GroupApple, 0x0011, "6A4B2F1E-0C3D-4E5F-8A9B-7C6D5E4F3A2B\x00"},
+		{GroupApple, 0x0021, "3/2"},
+		{GroupAppleRunTime, 0x0000, "1"},
+		{GroupAppleRunTime, 0x0001, "123456789000057"},
+		{GroupAppleRunTime, 0x0002, "1000000000"},
+		{GroupAppleRunTime, 0x0003, "0"},
+	} {
+		tag, err := decoder.GetTag(r, want.group, want.id)
+		if err != nil {
+			t.Fatalf("%s %s: %v", want.group.String(), want.id.StringGroup(want.group), err)
+		}
+		if desc, err := tag.Describe(); err != nil || desc != want.desc {
+			t.Errorf("%s: got %q (%v), want %q", tag.ID.StringGroup(tag.Group), desc, err, want.desc)
+		}
+	}
+	sinceBoot, err := decoder.GetTag(r, GroupAppleRunTime, 0x0004)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if v, _ := sinceBoot.Float(); v != 123456.789000057 {
+		t.Errorf("got RunTimeSinceBoot %v, want 123456.789000057", v)
+	}
+}
+
+func TestLazyDecoder_google(t *testing.T) {
+	payload := []byte{0x1f, 0x8b, 0x08, 0x00, 0xde, 0xad, 0xbe, 0xef}
+	makerNote := append([]byte("HDRP\x03"), payload...)
+	tiff := buildMakerNoteTIFF(binary.LittleEndian, "Google", func(int) []byte { return makerNote })
+	var decoder LazyDecoder
+	r := bytes.NewReader(tiff)
+	err := decoder.Decode(r)
+	if err != nil {
+		t.Fatal(err)
+	}
+	version, err := decoder.GetTag(r, GroupGoogle, 0x0004)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if v, _ := version.Int(); v != 3 {
+		t.Errorf("got HDRPVersion %d, want 3", v)
+	}
+	data, err := decoder.GetTag(r, GroupGoogle, 0x0005)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if b, _ := data.Bytes(); !bytes.Equal(b, payload) {
+		t.Errorf("got HDRPData %x, want %x", b, payload)
+	}
+}
+
+// testMakerNoteDecoder decodes maker notes beginning with "ACME" followed by a 16 bit
+// temperature and a null terminated serial number into two IFDs.
+type testMakerNoteDecoder struct{}
+
+func (testMakerNoteDecoder) Match(make string, header []byte) bool {
+	return make == "ACME Industrial" && bytes.HasPrefix(header, []byte("ACME"))
+}
+
+func (testMakerNoteDecoder) Decode(r io.ReaderAt, base int64, order binary.ByteOrder) ([]IFD, error) {
+	var buf [16]byte
+	_, err := r.ReadAt(buf[:], base+4)
+	if err != nil {
+		return nil, err
+	}
+	temperature, err := NewGroupTag(GroupMakerNote, 0x0001, int64(order.Uint16(buf[:])))
+	if err != nil {
+		return nil, err
+	}
+	serial, err := NewGroupTag(GroupMakerNote, 0x0002, string(buf[2:bytes.IndexByte(buf[2:], 0)+2]))
+	if err != nil {
+		return nil, err
+	}
+	settings, err := NewGroupTag(GroupMakerNote, 0x0001, []byte{1, 2, 3, 4, 5, 6, 7, 8, 9})
+	if err != nil {
+		return nil, err
+	}
+	return []IFD{
+		{Tags: []Tag{temperature, serial}},
+		{Tags: []Tag{settings}},
+	}, nil
+}
+
+func TestRegisterMakerNoteDecoder(t *testing.T) {
+	RegisterMakerNoteDecoder(testMakerNoteDecoder{})
+	makerNote := append([]byte("ACME\x01\x2c"), "SN-42\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00"...)
+	tiff := buildMakerNoteTIFF(binary.BigEndian, "ACME Industrial", func(int) []byte { return makerNote })
+	var decoder LazyDecoder
+	r := bytes.NewReader(tiff)
+	err := decoder.Decode(r)
+	if err != nil {
+		t.Fatal(err)
+	}
+	dir := decoder.Dir(GroupMakerNote)
+	if dir == nil || dir.Parent != decoder.Dir(GroupExifIFD) || dir.ParentTag != 0x927c {
+		t.Fatal("maker note not decoded by registered decoder")
+	}
+	if len(dir.Children) != 1 || dir.Children[0].Group != GroupMakerNote || dir.Children[0].Parent != dir {
+		t.Fatal("maker note children not linked")
+	}
+	temperature, err := decoder.GetTag(r, GroupMakerNote, 0x0001)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if v, _ := temperature.Int(); v != 300 {
+		t.Errorf("got temperature %d, want 300", v)
+	}
+	serial, err := decoder.GetTag(r, GroupMakerNote, 0x0002)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if v, _ := serial.Value().(string); v != "SN-42\x00" {
+		t.Errorf("got serial %q, want %q", v, "SN-42\x00")
+	}
+	settings, err := decoder.GetDirTag(r, dir.Children[0], 0x0001)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if v, _ := settings.Bytes(); !bytes.Equal(v, []byte{1, 2, 3, 4, 5, 6, 7, 8, 9}) {
+		t.Errorf("got settings %v", v)
+	}
+}
@@ -0,0 +1,254 @@
+package exif
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+)
+
+// nikonHeader begins Nikon type 3 maker notes. It is followed by a two byte
+// version, two zero bytes and a TIFF header which maker note offsets are relative to.
+const nikonHeader = "Nikon\x00\x02"
+
+// nikonTIFFOffset is the offset of the TIFF header in Nikon type 3 maker notes.
+const nikonTIFFOffset = 10
+
+// IDs of Nikon maker note tags used to decode the encrypted LensData and ShotInfo.
+const (
+	idNikonSerialNumber ID = 0x001d
+	idNikonLensType     ID = 0x0083
+	idNikonShotInfo     ID = 0x0091
+	idNikonLensData     ID = 0x0098
+	idNikonShutterCount ID = 0x00a7
+	// idNikonLensID is the ID of the composite LensID tag of the NikonLensData group.
+	idNikonLensID ID = 0x0100
+)
+
+// nikonLensIDFields are the IDs of the NikonLensData entries which, followed by
+// the LensType of the Nikon IFD, make up the composite LensID.
+var nikonLensIDFields = []ID{0x000b, 0x000c, 0x000d, 0x000e, 0x000f, 0x0010, 0x0011}
+
+func matchNikon(make string, header []byte) bool {
+	return strings.HasPrefix(string(header), nikonHeader)
+}
+
+// nikonIFD returns the location of the IFD of a Nikon type 3 maker note
+// from the TIFF header it contains.
+func nikonIFD(header []byte, offset int64, order binary.ByteOrder) (int64, int64, binary.ByteOrder, error) {
+	if len(header) < nikonTIFFOffset+8 {
+		return 0, 0, nil, errors.New("short Nikon maker note")
+	}
+	tiff := header[nikonTIFFOffset:]
+	switch string(tiff[:2]) {
+	case "II":
+		order = binary.LittleEndian
+	case "MM":
+		order = binary.BigEndian
+	default:
+		return 0, 0, nil, errors.New("invalid Nikon maker note byte order")
+	}
+	if order.Uint16(tiff[2:]) != 42 {
+		return 0, 0, nil, errors.New("invalid Nikon maker note TIFF header")
+	}
+	base := offset + nikonTIFFOffset
+	return base + int64(order.Uint32(tiff[4:])), base, order, nil
+}
+
+// expandNikon decrypts and decodes the LensData and ShotInfo of the Nikon maker
+// note. Encrypted data is skipped if the SerialNumber or ShutterCount is missing.
+func expandNikon(lt *LazyDecoder, r io.ReaderAt, dir *Dir, model string) ([]*Dir, error) {
+	var dirs []*Dir
+	serial, count, keyed, err := lt.nikonKey(r, dir, model)
+	if err != nil {
+		return nil, err
+	}
+	if lztag, ok := dir.tag(idNikonLensData); ok {
+		data, err := lt.tagData(r, lztag, dir)
+		if err != nil {
+			return nil, fmt.Errorf("reading LensData: %w", err)
+		}
+		data = append([]byte{}, data...)
+		if len(data) > 4 && (keyed || !nikonEncrypted(data)) {
+			if nikonEncrypted(data) {
+				nikonDecrypt(data[4:], serial, count)
+			}
+			lens := newBinaryDir(nikonLensData(data), dir.order, GroupNikonLensData, 1)
+			lens.Offset = lztag.dataOffset()
+			lens.Parent = dir
+			lens.ParentTag = idNikonLensData
+			if string(data[:4]) == "0100" {
+				// Version 0100 lacks the entries preceding LensIDNumber.
+				for _, id := range []ID{0x0004, 0x0005, 0x0008, 0x0009, 0x000a, 0x0012} {
+					lens.removeTag(id)
+				}
+			}
+			if lensType, ok := dir.tag(idNikonLensType); ok && lensType.length == 1 {
+				lens.addLensID(lensType.value[0])
+			}
+			dirs = append(dirs, lens)
+		}
+	}
+	if lztag, ok := dir.tag(idNikonShotInfo); ok {
+		data, err := lt.tagData(r, lztag, dir)
+		if err != nil {
+			return nil, fmt.Errorf("reading ShotInfo: %w", err)
+		}
+		data = append([]byte{}, data...)
+		if len(data) > 4 && (keyed || !nikonEncrypted(data)) {
+			encrypted := nikonEncrypted(data)
+			if encrypted {
+				nikonDecrypt(data[4:], serial, count)
+			}
+			shot := newBinaryDir(data, dir.order, GroupNikonShotInfo, 1)
+			shot.Offset = lztag.dataOffset()
+			shot.Parent = dir
+			shot.ParentTag = idNikonShotInfo
+			if !encrypted {
+				shot.removeTag(0x0004) // FirmwareVersion.
+			}
+			dirs = append(dirs, shot)
+		}
+	}
+	return dirs, nil
+}
+
+// nikonKey returns the serial number and shutter count which key the encryption
+// of the Nikon maker note dir. keyed is false if the shutter count is missing.
+// Serial numbers which are not numeric are replaced by a model dependent default.
+func (lt *LazyDecoder) nikonKey(r io.ReaderAt, dir *Dir, model string) (serial, count uint32, keyed bool, err error) {
+	lztag, ok := dir.tag(idNikonShutterCount)
+	if !ok {
+		return 0, 0, false, nil
+	}
+	tag, err := lt.getTag(r, lztag, dir)
+	if err != nil {
+		return 0, 0, false, fmt.Errorf("reading ShutterCount: %w", err)
+	}
+	v, err := tag.Int()
+	if err != nil {
+		return 0, 0, false, fmt.Errorf("reading ShutterCount: %w", err)
+	}
+	count = uint32(v)
+	s, err := lt.stringTag(r, dir, idNikonSerialNumber)
+	if err != nil {
+		return 0, 0, false, fmt.Errorf("reading SerialNumber: %w", err)
+	}
+	n, err := strconv.ParseUint(s, 10, 64)
+	switch {
+	case err == nil:
+		serial = uint32(n)
+	case strings.HasSuffix(model, "D50"):
+		serial = 0x22
+	default:
+		serial = 0x60
+	}
+	return serial, count, true, nil
+}
+
+// nikonEncrypted reports whether the LensData or ShotInfo data is encrypted,
+// which is the case from version 0200 on. The version occupies the first 4 bytes.
+func nikonEncrypted(data []byte) bool {
+	return string(data[:2]) != "01" && string(data[:2]) != "00"
+}
+
+// nikonLensData returns the decrypted LensData arranged in the layout of versions 0101 to 0203.
+// Versions 0400 and later have a different layout and only their version is kept.
+func nikonLensData(data []byte) []byte {
+	switch string(data[:4]) {
+	case "0100":
+		if len(data) < 13 {
+			return data[:4]
+		}
+		// LensIDNumber through MCUVersion begin at index 6.
+		lens := make([]byte, 18)
+		copy(lens, data[:4])
+		copy(lens[0x0b:], data[6:13])
+		return lens
+	case "0101", "0201", "0202", "0203":
+		return data
+	case "0204":
+		if len(data) < 10 {
+			return data[:4]
+		}
+		// Version 0204 contains an additional byte at index 9.
+		return append(data[:9:9], data[10:]...)
+	}
+	return data[:4]
+}
+
+// addLensID adds the composite LensID tag to the NikonLensData directory d,
+// if it contains the LensIDNumber through MCUVersion entries.
+func (d *Dir) addLensID(lensType byte) {
+	var id []string
+	for _, field := range nikonLensIDFields {
+		lztag, ok := d.tag(field)
+		if !ok {
+			return
+		}
+		id = append(id, fmt.Sprintf("%02X", lztag.value[0]))
+	}
+	id = append(id, fmt.Sprintf("%02X", lensType))
+	value := strings.Join(id, " ")
+	d.tags = append(d.tags, lazytag{ID: idNikonLensID, Type: TypeString, offset: int64(len(d.data)), length: len(value)})
+	d.data = append(d.data, value...)
+}
+
+// nikonDecrypt decrypts the LensData or ShotInfo data following their version
+// in place. The XOR stream is keyed by the camera's serial number and shutter
+// count, as implemented by dcraw and ExifTool.
+func nikonDecrypt(data []byte, serial, count uint32) {
+	var key byte
+	for i := 0; i < 4; i++ {
+		key ^= byte(count >> (8 * i))
+	}
+	ci := nikonXlat[0][byte(serial)]
+	cj := nikonXlat[1][key]
+	ck := byte(0x60)
+	for i := range data {
+		cj += ci * ck
+		ck++
+		data[i] ^= cj
+	}
+}
+
+var nikonXlat = [2][256]byte{
+	{
+		0xc1, 0xbf, 0x6d, 0x0d, 0x59, 0xc5, 0x13, 0x9d, 0x83, 0x61, 0x6b, 0x4f, 0xc7, 0x7f, 0x3d, 0x3d,
+		0x53, 0x59, 0xe3, 0xc7, 0xe9, 0x2f, 0x95, 0xa7, 0x95, 0x1f, 0xdf, 0x7f, 0x2b, 0x29, 0xc7, 0x0d,
+		0xdf, 0x07, 0xef, 0x71, 0x89, 0x3d, 0x13, 0x3d, 0x3b, 0x13, 0xfb, 0x0d, 0x89, 0xc1, 0x65, 0x1f,
+		0xb3, 0x0d, 0x6b, 0x29, 0xe3, 0xfb, 0xef, 0xa3, 0x6b, 0x47, 0x7f, 0x95, 0x35, 0xa7, 0x47, 0x4f,
+		0xc7, 0xf1, 0x59, 0x95, 0x35, 0x11, 0x29, 0x61, 0xf1, 0x3d, 0xb3, 0x2b, 0x0d, 0x43, 0x89, 0xc1,
+		0x9d, 0x9d, 0x89, 0x65, 0xf1, 0xe9, 0xdf, 0xbf, 0x3d, 0x7f, 0x53, 0x97, 0xe5, 0xe9, 0x95, 0x17,
+		0x1d, 0x3d, 0x8b, 0xfb, 0xc7, 0xe3, 0x67, 0xa7, 0x07, 0xf1, 0x71, 0xa7, 0x53, 0xb5, 0x29, 0x89,
+		0xe5, 0x2b, 0xa7, 0x17, 0x29, 0xe9, 0x4f, 0xc5, 0x65, 0x6d, 0x6b, 0xef, 0x0d, 0x89, 0x49, 0x2f,
+		0xb3, 0x43, 0x53, 0x65, 0x1d, 0x49, 0xa3, 0x13, 0x89, 0x59, 0xef, 0x6b, 0xef, 0x65, 0x1d, 0x0b,
+		0x59, 0x13, 0xe3, 0x4f, 0x9d, 0xb3, 0x29, 0x43, 0x2b, 0x07, 0x1d, 0x95, 0x59, 0x59, 0x47, 0xfb,
+		0xe5, 0xe9, 0x61, 0x47, 0x2f, 0x35, 0x7f, 0x17, 0x7f, 0xef, 0x7f, 0x95, 0x95, 0x71, 0xd3, 0xa3,
+		0x0b, 0x71, 0xa3, 0xad, 0x0b, 0x3b, 0xb5, 0xfb, 0xa3, 0xbf, 0x4f, 0x83, 0x1d, 0xad, 0xe9, 0x2f,
+		0x71, 0x65, 0xa3, 0xe5, 0x07, 0x35, 0x3d, 0x0d, 0xb5, 0xe9, 0xe5, 0x47, 0x3b, 0x9d, 0xef, 0x35,
+		0xa3, 0xbf, 0xb3, 0xdf, 0x53, 0xd3, 0x97, 0x53, 0x49, 0x71, 0x07, 0x35, 0x61, 0x71, 0x2f, 0x43,
+		0x2f, 0x11, 0xdf, 0x17, 0x97, 0xfb, 0x95, 0x3b, 0x7f, 0x6b, 0xd3, 0x25, 0xbf, 0xad, 0xc7, 0xc5,
+		0xc5, 0xb5, 0x8b, 0xef, 0x2f, 0xd3, 0x07, 0x6b, 0x25, 0x49, 0x95, 0x25, 0x49, 0x6d, 0x71, 0xc7,
+	},
+	{
+		0xa7, 0xbc, 0xc9, 0xad, 0x91, 0xdf, 0x85, 0xe5, 0xd4, 0x78, 0xd5, 0x17, 0x46, 0x7c, 0x29, 0x4c,
+		0x4d, 0x03, 0xe9, 0x25, 0x68, 0x11, 0x86, 0xb3, 0xbd, 0xf7, 0x6f, 0x61, 0x22, 0xa2, 0x26, 0x34,
+		0x2a, 0xbe, 0x1e, 0x46, 0x14, 0x68, 0x9d, 0x44, 0x18, 0xc2, 0x40, 0xf4, 0x7e, 0x5f, 0x1b, 0xad,
+		0x0b, 0x94, 0xb6, 0x67, 0xb4, 0x0b, 0xe1, 0xea, 0x95, 0x9c, 0x66, 0xdc, 0xe7, 0x5d, 0x6c, 0x05,
+		0xda, 0xd5, 0xdf, 0x7a, 0xef, 0xf6, 0xdb, 0x1f, 0x82, 0x4c, 0xc0, 0x68, 0x47, 0xa1, 0xbd, 0xee,
+		0x39, 0x50, 0x56, 0x4a, 0xdd, 0xdf, 0xa5, 0xf8, 0xc6, 0xda, 0xca, 0x90, 0xca, 0x01, 0x42, 0x9d,
+		0x8b, 0x0c, 0x73, 0x43, 0x75, 0x05, 0x94, 0xde, 0x24, 0xb3, 0x80, 0x34, 0xe5, 0x2c, 0xdc, 0x9b,
+		0x3f, 0xca, 0x33, 0x45, 0xd0, 0xdb, 0x5f, 0xf5, 0x52, 0xc3, 0x21, 0xda, 0xe2, 0x22, 0x72, 0x6b,
+		0x3e, 0xd0, 0x5b, 0xa8, 0x87, 0x8c, 0x06, 0x5d, 0x0f, 0xdd, 0x09, 0x19, 0x93, 0xd0, 0xb9, 0xfc,
+		0x8b, 0x0f, 0x84, 0x60, 0x33, 0x1c, 0x9b, 0x45, 0xf1, 0xf0, 0xa3, 0x94, 0x3a, 0x12, 0x77, 0x33,
+		0x4d, 0x44, 0x78, 0x28, 0x3c, 0x9e, 0xfd, 0x65, 0x57, 0x16, 0x94, 0x6b, 0xfb, 0x59, 0xd0, 0xc8,
+		0x22, 0x36, 0xdb, 0xd2, 0x63, 0x98, 0x43, 0xa1, 0x04, 0x87, 0x86, 0xf7, 0xa6, 0x26, 0xbb, 0xd6,
+		0x59, 0x4d, 0xbf, 0x6a, 0x2e, 0xaa, 0x2b, 0xef, 0xe6, 0x78, 0xb6, 0x4e, 0xe0, 0x2f, 0xdc, 0x7c,
+		0xbe, 0x57, 0x19, 0x32, 0x7e, 0x2a, 0xd0, 0xb8, 0xba, 0x29, 0x00, 0x3c, 0x52, 0x7d, 0xa8, 0x49,
+		0x3b, 0x2d, 0xeb, 0x25, 0x49, 0xfa, 0xa3, 0xaa, 0x39, 0xa7, 0xc5, 0xa7, 0x50, 0x11, 0x36, 0xfb,
+		0xc6, 0x67, 0x4a, 0xf5, 0xa5, 0x12, 0x65, 0x7e, 0xb0, 0xdf, 0xaf, 0x4e, 0xb3, 0x61, 0x7f, 0x2f,
+	},
+}
@@ -0,0 +1,65 @@
+package exif
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+)
+
+// Headers of Olympus maker notes. The "OLYMPUS\x00" and "OM SYSTEM\x00\x00\x00" headers
+// are followed by a byte order mark, a version and the IFD, with offsets relative to the
+// start of the maker note. The older "OLYMP\x00" header is followed by a version and
+// the IFD, with offsets relative to the TIFF header.
+const (
+	olympusHeader    = "OLYMPUS\x00"
+	olympusOMHeader  = "OM SYSTEM\x00\x00\x00"
+	olympusOldHeader = "OLYMP\x00"
+)
+
+// IDs of Olympus maker note tags pointing to sub-IFDs.
+const (
+	idOlympusEquipment      ID = 0x2010
+	idOlympusCameraSettings ID = 0x2020
+)
+
+func matchOlympus(make string, header []byte) bool {
+	h := string(header)
+	return strings.HasPrefix(h, olympusHeader) || strings.HasPrefix(h, olympusOMHeader) || strings.HasPrefix(h, olympusOldHeader)
+}
+
+// olympusIFD returns the location of the Olympus maker note IFD.
+func olympusIFD(header []byte, offset int64, order binary.ByteOrder) (int64, int64, binary.ByteOrder, error) {
+	h := string(header)
+	switch {
+	case strings.HasPrefix(h, olympusHeader) && len(h) >= 12:
+		return offset + 12, offset, headerOrder(header[8:10], order), nil
+	case strings.HasPrefix(h, olympusOMHeader) && len(h) >= 16:
+		return offset + 16, offset, headerOrder(header[12:14], order), nil
+	case strings.HasPrefix(h, olympusOldHeader) && len(h) >= 8:
+		return offset + 8, 0, order, nil
+	}
+	return 0, 0, nil, errors.New("short Olympus maker note")
+}
+
+// expandOlympus decodes the Equipment and CameraSettings sub-IFDs of the Olympus maker note.
+func expandOlympus(lt *LazyDecoder, r io.ReaderAt, dir *Dir, model string) ([]*Dir, error) {
+	var dirs []*Dir
+	for _, sub := range []struct {
+		id ID
+		g  Group
+	}{
+		{idOlympusEquipment, GroupOlympusEquipment},
+		{idOlympusCameraSettings, GroupOlympusCameraSettings},
+	} {
+		subdir, err := lt.decodeSubIFD(r, dir, sub.id, sub.g)
+		if err != nil {
+			return nil, fmt.Errorf("decoding %s: %w", sub.g.String(), err)
+		}
+		if subdir != nil {
+			dirs = append(dirs, subdir)
+		}
+	}
+	return dirs, nil
+}
@@ -0,0 +1,19 @@
+package exif
+
+import (
+	"encoding/binary"
+	"strings"
+)
+
+// panasonicHeader begins Panasonic maker notes and is followed by the IFD.
+const panasonicHeader = "Panasonic\x00\x00\x00"
+
+func matchPanasonic(make string, header []byte) bool {
+	return strings.HasPrefix(string(header), panasonicHeader)
+}
+
+// panasonicIFD returns the location of the Panasonic maker note IFD, which
+// follows the header. Value offsets are relative to the TIFF header.
+func panasonicIFD(header []byte, offset int64, order binary.ByteOrder) (int64, int64, binary.ByteOrder, error) {
+	return offset + int64(len(panasonicHeader)), 0, order, nil
+}
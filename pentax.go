@@ -0,0 +1,35 @@
+package exif
+
+import (
+	"encoding/binary"
+	"errors"
+	"strings"
+)
+
+// Headers of Pentax maker notes. "AOC\x00" is followed by a byte order mark and the IFD,
+// with offsets relative to the TIFF header. "PENTAX \x00" is followed by a byte order mark
+// at offset 8 and the IFD, with offsets relative to the start of the maker note.
+const (
+	pentaxAOCHeader = "AOC\x00"
+	pentaxHeader    = "PENTAX \x00"
+)
+
+func matchPentax(make string, header []byte) bool {
+	h := string(header)
+	return strings.HasPrefix(h, pentaxAOCHeader) || strings.HasPrefix(h, pentaxHeader)
+}
+
+// pentaxIFD returns the location of the Pentax maker note IFD. Maker notes of some
+// models have no byte order mark after the AOC header and use the EXIF byte order.
+func pentaxIFD(header []byte, offset int64, order binary.ByteOrder) (int64, int64, binary.ByteOrder, error) {
+	if strings.HasPrefix(string(header), pentaxHeader) {
+		if len(header) < 10 {
+			return 0, 0, nil, errors.New("short Pentax maker note")
+		}
+		return offset + 10, offset, headerOrder(header[8:10], order), nil
+	}
+	if len(header) < 6 {
+		return 0, 0, nil, errors.New("short Pentax maker note")
+	}
+	return offset + 6, 0, headerOrder(header[4:6], order), nil
+}
@@ -0,0 +1,304 @@
+package exif
+
+import (
+	"bytes"
+	"compress/zlib"
+	"encoding/binary"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"hash/crc32"
+	"io"
+	"strconv"
+)
+
+// pngSignature begins every PNG stream.
+const pngSignature = "\x89PNG\r\n\x1a\n"
+
+// PNG chunk types handled by this package.
+const (
+	pngChunkEXIF = "eXIf"
+	pngChunkIEND = "IEND"
+	pngChunkICCP = "iCCP"
+	pngChunkTEXT = "tEXt"
+	pngChunkZTXT = "zTXt"
+	pngChunkITXT = "iTXt"
+)
+
+// Limits of the size of PNG metadata read into memory.
+const (
+	maxPNGChunkSize = 16 << 20 // Length of chunks read.
+	maxPNGTextSize  = 16 << 20 // Decompressed text.
+)
+
+// pngKeywordXMP is the keyword of iTXt chunks containing XMP metadata.
+const pngKeywordXMP = "XML:com.adobe.xmp"
+
+// pngRawProfilePrefix begins the keyword of textual data chunks containing
+// hex encoded metadata profiles written by ImageMagick, i.e. "Raw profile type exif".
+const pngRawProfilePrefix = "Raw profile type "
+
+// isPNGText reports whether the chunk type is one of the textual data chunk types.
+func isPNGText(typ string) bool {
+	return typ == pngChunkTEXT || typ == pngChunkZTXT || typ == pngChunkITXT
+}
+
+// pngTextKeyword returns the keyword of a textual data chunk which
+// precedes the first null byte of the chunk data.
+func pngTextKeyword(data []byte) string {
+	for i := range data {
+		if data[i] == 0 {
+			return string(data[:i])
+		}
+	}
+	return ""
+}
+
+// readPNGChunkHeader reads the length and type of a PNG chunk.
+// The chunk data and CRC follow the header in r.
+func readPNGChunkHeader(r io.Reader) (length uint32, typ string, err error) {
+	var hdr [8]byte
+	_, err = io.ReadFull(r, hdr[:])
+	if err != nil {
+		return 0, "", err
+	}
+	length = binary.BigEndian.Uint32(hdr[:4])
+	if length > 1<<31-1 {
+		return 0, "", errors.New("PNG chunk length exceeds 2^31-1")
+	}
+	return length, string(hdr[4:8]), nil
+}
+
+// readPNGChunkData reads the data and CRC of a chunk of the given type and length,
+// which follow the chunk header in r. It returns an error if the CRC does not match
+// or the length exceeds maxPNGChunkSize.
+func readPNGChunkData(r io.Reader, typ string, length uint32) ([]byte, error) {
+	if length > maxPNGChunkSize {
+		return nil, fmt.Errorf("PNG %s chunk exceeds size limit", typ)
+	}
+	data := make([]byte, length+4)
+	_, err := io.ReadFull(r, data)
+	if err != nil {
+		return nil, noEOF(err)
+	}
+	data, crc := data[:length], binary.BigEndian.Uint32(data[length:])
+	if crc != pngCRC(typ, data) {
+		return nil, fmt.Errorf("PNG %s chunk CRC mismatch", typ)
+	}
+	return data, nil
+}
+
+// copyPNGChunkData copies the data and CRC of a chunk of the given type and length,
+// which follow the chunk header in r, to w. It returns an error if the CRC does not match.
+func copyPNGChunkData(w io.Writer, r io.Reader, typ string, length uint32) error {
+	crc := crc32.NewIEEE()
+	crc.Write([]byte(typ))
+	_, err := io.CopyN(io.MultiWriter(w, crc), r, int64(length))
+	if err != nil {
+		return noEOF(err)
+	}
+	var buf [4]byte
+	_, err = io.ReadFull(r, buf[:])
+	if err != nil {
+		return noEOF(err)
+	}
+	if binary.BigEndian.Uint32(buf[:]) != crc.Sum32() {
+		return fmt.Errorf("PNG %s chunk CRC mismatch", typ)
+	}
+	_, err = w.Write(buf[:])
+	return err
+}
+
+// writePNGChunk writes a chunk of type typ containing data to w.
+func writePNGChunk(w io.Writer, typ string, data []byte) error {
+	var buf [8]byte
+	binary.BigEndian.PutUint32(buf[:4], uint32(len(data)))
+	copy(buf[4:], typ)
+	_, err := w.Write(buf[:])
+	if err != nil {
+		return err
+	}
+	_, err = w.Write(data)
+	if err != nil {
+		return err
+	}
+	binary.BigEndian.PutUint32(buf[:4], pngCRC(typ, data))
+	_, err = w.Write(buf[:4])
+	return err
+}
+
+// pngCRC returns the CRC of a chunk which is calculated over the chunk type and data.
+func pngCRC(typ string, data []byte) uint32 {
+	crc := crc32.Update(0, crc32.IEEETable, []byte(typ))
+	return crc32.Update(crc, crc32.IEEETable, data)
+}
+
+// FindPNGExif searches the chunks of the PNG image in r for EXIF data and returns a
+// reader of the TIFF formatted EXIF data, which may be passed to [LazyDecoder.Decode].
+//
+// EXIF data is read from the eXIf chunk or, if absent, from a legacy textual data
+// chunk with keyword "Raw profile type exif" which contains hex encoded EXIF data.
+// The CRCs of these chunks are validated. For eXIf chunks the returned reader
+// reads from r, whereas legacy EXIF data is decoded into memory.
+func FindPNGExif(r io.ReaderAt) (*io.SectionReader, error) {
+	offset, size, legacy, err := findPNGExif(r)
+	if err != nil {
+		return nil, err
+	}
+	if legacy != nil {
+		return io.NewSectionReader(bytes.NewReader(legacy), 0, int64(len(legacy))), nil
+	}
+	return io.NewSectionReader(r, offset, size), nil
+}
+
+// findPNGExif returns the offset and size of the EXIF data in the eXIf chunk of
+// the PNG image in r. If the image contains no eXIf chunk the EXIF data decoded
+// from a legacy raw profile text chunk is returned instead.
+func findPNGExif(r io.ReaderAt) (offset, size int64, legacy []byte, err error) {
+	var sig [len(pngSignature)]byte
+	_, err = r.ReadAt(sig[:], 0)
+	if err != nil {
+		return 0, 0, nil, noEOF(err)
+	}
+	if string(sig[:]) != pngSignature {
+		return 0, 0, nil, errors.New("missing PNG signature")
+	}
+	off := int64(len(pngSignature))
+	for {
+		length, typ, err := readPNGChunkHeader(io.NewSectionReader(r, off, 8))
+		if err != nil {
+			return 0, 0, nil, noEOF(err)
+		}
+		dataOffset := off + 8
+		off = dataOffset + int64(length) + 4 // Skip data and CRC.
+		if size := readerSize(r); size >= 0 && off > size {
+			return 0, 0, nil, fmt.Errorf("PNG %s chunk exceeds end of data", typ)
+		}
+		switch {
+		case typ == pngChunkEXIF:
+			data, err := readPNGChunkData(io.NewSectionReader(r, dataOffset, int64(length)+4), typ, length)
+			if err != nil {
+				return 0, 0, nil, err
+			}
+			if bytes.HasPrefix(data, []byte(exifHeader)) {
+				// Some writers include the JPEG APP1 EXIF header.
+				return dataOffset + int64(len(exifHeader)), int64(length) - int64(len(exifHeader)), nil, nil
+			}
+			return dataOffset, int64(length), nil, nil
+
+		case legacy == nil && isPNGText(typ):
+			kw := make([]byte, 80) // Keywords are at most 79 bytes long.
+			if int(length) < len(kw) {
+				kw = kw[:length]
+			}
+			n, _ := r.ReadAt(kw, dataOffset)
+			if !isPNGRawProfileExif(pngTextKeyword(kw[:n])) {
+				continue
+			}
+			data, err := readPNGChunkData(io.NewSectionReader(r, dataOffset, int64(length)+4), typ, length)
+			if err != nil {
+				return 0, 0, nil, err
+			}
+			text, err := pngTextValue(typ, data)
+			if err != nil {
+				return 0, 0, nil, err
+			}
+			legacy, err = decodeRawProfile(text)
+			if err != nil {
+				return 0, 0, nil, err
+			}
+			legacy = trimExifHeader(legacy)
+
+		case typ == pngChunkIEND:
+			if legacy == nil {
+				return 0, 0, nil, errors.New("EXIF data not found in PNG")
+			}
+			return 0, 0, legacy, nil
+		}
+	}
+}
+
+// isPNGRawProfileExif reports whether keyword is that of a textual data chunk containing
+// a hex encoded EXIF profile, as written by ImageMagick ("exif") or ExifTool ("APP1").
+func isPNGRawProfileExif(keyword string) bool {
+	return keyword == pngRawProfilePrefix+"exif" || keyword == pngRawProfilePrefix+"APP1"
+}
+
+// pngTextValue returns the text of a tEXt, zTXt or iTXt chunk's data,
+// decompressing it if needed. Decompressed text is limited to maxPNGTextSize bytes.
+func pngTextValue(typ string, data []byte) ([]byte, error) {
+	i := bytes.IndexByte(data, 0)
+	if i < 0 {
+		return nil, fmt.Errorf("PNG %s chunk missing keyword separator", typ)
+	}
+	data = data[i+1:]
+	compressed := false
+	switch typ {
+	case pngChunkZTXT:
+		if len(data) < 1 || data[0] != 0 {
+			return nil, errors.New("unsupported PNG zTXt compression method")
+		}
+		data = data[1:]
+		compressed = true
+	case pngChunkITXT:
+		if len(data) < 2 {
+			return nil, errors.New("short PNG iTXt chunk")
+		}
+		if data[0] != 0 && data[1] != 0 {
+			return nil, errors.New("unsupported PNG iTXt compression method")
+		}
+		compressed = data[0] != 0
+		data = data[2:]
+		// Skip language tag and translated keyword.
+		for j := 0; j < 2; j++ {
+			i = bytes.IndexByte(data, 0)
+			if i < 0 {
+				return nil, errors.New("malformed PNG iTXt chunk")
+			}
+			data = data[i+1:]
+		}
+	}
+	if !compressed {
+		return data, nil
+	}
+	zr, err := zlib.NewReader(bytes.NewReader(data))
+	if err != nil {
+		return nil, fmt.Errorf("PNG %s chunk: %w", typ, err)
+	}
+	text, err := io.ReadAll(io.LimitReader(zr, maxPNGTextSize+1))
+	if err != nil {
+		return nil, fmt.Errorf("PNG %s chunk: %w", typ, err)
+	}
+	if len(text) > maxPNGTextSize {
+		return nil, fmt.Errorf("decompressed PNG %s chunk exceeds size limit", typ)
+	}
+	return text, nil
+}
+
+// decodeRawProfile decodes the hex encoded data of a raw profile written by ImageMagick,
+// which has the form "\n<profile name>\n<length>\n<hex data split in lines>".
+func decodeRawProfile(text []byte) ([]byte, error) {
+	fields := bytes.SplitN(bytes.TrimLeft(text, "\n"), []byte{'\n'}, 3)
+	if len(fields) != 3 {
+		return nil, errors.New("malformed raw profile header")
+	}
+	length, err := strconv.Atoi(string(bytes.TrimSpace(fields[1])))
+	if err != nil || length < 0 {
+		return nil, errors.New("invalid raw profile length")
+	}
+	hexdata := bytes.Map(func(r rune) rune {
+		if r == '\n' || r == '\r' || r == ' ' || r == '\t' {
+			return -1
+		}
+		return r
+	}, fields[2])
+	if len(hexdata) != 2*length {
+		return nil, fmt.Errorf("raw profile length %d does not match %d bytes of hex data", length, len(hexdata)/2)
+	}
+	profile := make([]byte, length)
+	_, err = hex.Decode(profile, hexdata)
+	if err != nil {
+		return nil, fmt.Errorf("decoding raw profile: %w", err)
+	}
+	return profile, nil
+}
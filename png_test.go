@@ -0,0 +1,115 @@
+package exif
+
+import (
+	"bytes"
+	"compress/zlib"
+	"encoding/binary"
+	"encoding/hex"
+	"fmt"
+	"strings"
+	"testing"
+)
+
+func TestLazyDecoder_png(t *testing.T) {
+	exif, err := Marshal(binary.BigEndian, []IFD{{Group: GroupIFD0, Tags: []Tag{
+		{ID: 0x010f, data: "Maker"},  // Make.
+		{ID: 0x0112, data: int64(3)}, // Orientation.
+	}}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	// Legacy raw profile as written by ImageMagick.
+	hexdata := hex.EncodeToString(append([]byte(exifHeader), exif...))
+	var profile strings.Builder
+	fmt.Fprintf(&profile, "\nexif\n%8d\n", len(hexdata)/2)
+	for len(hexdata) > 72 {
+		profile.WriteString(hexdata[:72] + "\n")
+		hexdata = hexdata[72:]
+	}
+	profile.WriteString(hexdata + "\n")
+	var ztxt bytes.Buffer
+	ztxt.WriteString("Raw profile type exif\x00\x00")
+	zw := zlib.NewWriter(&ztxt)
+	zw.Write([]byte(profile.String()))
+	zw.Close()
+
+	for _, tc := range []struct {
+		desc  string
+		typ   string
+		chunk []byte
+	}{
+		{desc: "eXIf", typ: pngChunkEXIF, chunk: exif},
+		{desc: "eXIf with header", typ: pngChunkEXIF, chunk: append([]byte(exifHeader), exif...)},
+		{desc: "zTXt", typ: pngChunkZTXT, chunk: ztxt.Bytes()},
+		{desc: "tEXt", typ: pngChunkTEXT, chunk: []byte("Raw profile type APP1\x00" + profile.String())},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			png := testPNG(tc.typ, tc.chunk)
+			var decoder LazyDecoder
+			r := bytes.NewReader(png)
+			err := decoder.Decode(r)
+			if err != nil {
+				t.Fatal(err)
+			}
+			tag, err := decoder.GetTag(r, GroupIFD0, 0x010f)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got := tag.String(); got != "Make (string): Maker\x00" {
+				t.Errorf("got tag %q", got)
+			}
+			sr, err := FindPNGExif(r)
+			if err != nil {
+				t.Fatal(err)
+			}
+			got := make([]byte, sr.Size())
+			_, err = sr.ReadAt(got, 0)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !bytes.Equal(got, exif) {
+				t.Errorf("FindPNGExif data mismatch")
+			}
+		})
+	}
+
+	// Compressed text decompressing to more than the size limit.
+	ztxt.Reset()
+	ztxt.WriteString("Raw profile type exif\x00\x00")
+	zw = zlib.NewWriter(&ztxt)
+	zw.Write([]byte("\nexif\n100\n"))
+	zw.Write(make([]byte, maxPNGTextSize))
+	zw.Close()
+	err = new(LazyDecoder).Decode(bytes.NewReader(testPNG(pngChunkZTXT, ztxt.Bytes())))
+	if err == nil || !strings.Contains(err.Error(), "size limit") {
+		t.Errorf("expected size limit error, got %v", err)
+	}
+
+	// Chunk declaring a length of 2^31-1 followed by a few bytes.
+	png := append([]byte(pngSignature), 0x7f, 0xff, 0xff, 0xff)
+	png = append(png, pngChunkEXIF+"Exif\x00\x00"...)
+	_, err = FindPNGExif(bytes.NewReader(png))
+	if err == nil || !strings.Contains(err.Error(), "end of data") {
+		t.Errorf("expected chunk length error, got %v", err)
+	}
+
+	png = testPNG(pngChunkEXIF, exif)
+	png[len(png)-16-len(exif)] ^= 0xff // Corrupt eXIf chunk data.
+	_, err = FindPNGExif(bytes.NewReader(png))
+	if err == nil || !strings.Contains(err.Error(), "CRC") {
+		t.Errorf("expected CRC mismatch error, got %v", err)
+	}
+}
+
+// testPNG returns a PNG stream with a chunk of type typ placed after
+// the image data, followed by the IEND chunk.
+func testPNG(typ string, chunk []byte) []byte {
+	var buf bytes.Buffer
+	buf.WriteString(pngSignature)
+	writePNGChunk(&buf, "IHDR", make([]byte, 13))
+	writePNGChunk(&buf, pngChunkTEXT, []byte("Comment\x00hello"))
+	writePNGChunk(&buf, "IDAT", []byte{1, 2, 3})
+	writePNGChunk(&buf, typ, chunk)
+	writePNGChunk(&buf, pngChunkIEND, nil)
+	return buf.Bytes()
+}
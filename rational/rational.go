@@ -72,6 +72,28 @@ func DecodeI64(order binary.ByteOrder, b []byte) (I64, error) {
 	return I64{denMinusOne: denominator - 1, num: numerator}, nil
 }
 
+// EncodeU64 puts the unsigned rational number r in b using the byte order. It is
+// the inverse operation of DecodeU64.
+func EncodeU64(order binary.ByteOrder, b []byte, r U64) error {
+	if len(b) < 8 {
+		return errShortBuf
+	}
+	order.PutUint32(b, r.num)
+	order.PutUint32(b[4:], r.denMinusOne+1)
+	return nil
+}
+
+// EncodeI64 puts the signed rational number r in b using the byte order. It is
+// the inverse operation of DecodeI64.
+func EncodeI64(order binary.ByteOrder, b []byte, r I64) error {
+	if len(b) < 8 {
+		return errShortBuf
+	}
+	order.PutUint32(b, uint32(r.num))
+	order.PutUint32(b[4:], uint32(r.denMinusOne+1))
+	return nil
+}
+
 func (i I64) Fraction() (numerator, denominator int) {
 	return int(i.num), int(i.denMinusOne + 1)
 }
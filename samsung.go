@@ -0,0 +1,19 @@
+package exif
+
+import (
+	"encoding/binary"
+	"strings"
+)
+
+// samsungType1Header begins Samsung type 1 maker notes, which are not IFDs.
+const samsungType1Header = "STMN"
+
+func matchSamsung(make string, header []byte) bool {
+	return strings.HasPrefix(strings.ToUpper(make), "SAMSUNG") && !strings.HasPrefix(string(header), samsungType1Header)
+}
+
+// samsungIFD returns the location of the Samsung type 2 maker note IFD, which begins
+// the maker note without a header. Value offsets are relative to the maker note.
+func samsungIFD(header []byte, offset int64, order binary.ByteOrder) (int64, int64, binary.ByteOrder, error) {
+	return offset, offset, order, nil
+}
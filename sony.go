@@ -0,0 +1,35 @@
+package exif
+
+import (
+	"encoding/binary"
+	"strings"
+)
+
+// Headers of Sony maker notes which are followed by three zero bytes and the IFD.
+// Other Sony maker notes begin with the IFD.
+const (
+	sonyDSCHeader = "SONY DSC \x00\x00\x00"
+	sonyCAMHeader = "SONY CAM \x00\x00\x00"
+)
+
+func matchSony(make string, header []byte) bool {
+	h := string(header)
+	switch {
+	case strings.HasPrefix(h, sonyDSCHeader), strings.HasPrefix(h, sonyCAMHeader):
+		return true
+	case strings.HasPrefix(h, "SONY"), strings.HasPrefix(h, "PREMI"):
+		// Maker notes of other Sony formats, i.e. "SONY PI\x00", are not IFDs.
+		return false
+	}
+	return strings.HasPrefix(make, "SONY")
+}
+
+// sonyIFD returns the location of the Sony maker note IFD, which follows the
+// header if present. Value offsets are relative to the TIFF header.
+func sonyIFD(header []byte, offset int64, order binary.ByteOrder) (int64, int64, binary.ByteOrder, error) {
+	h := string(header)
+	if strings.HasPrefix(h, sonyDSCHeader) || strings.HasPrefix(h, sonyCAMHeader) {
+		offset += int64(len(sonyDSCHeader))
+	}
+	return offset, 0, order, nil
+}
@@ -0,0 +1,305 @@
+package exif
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"io"
+	"strings"
+)
+
+// JPEG markers of segments which may contain metadata.
+const (
+	markerAPP2  = 0xe2
+	markerAPP13 = 0xed
+	markerCOM   = 0xfe
+)
+
+// Identifiers at the start of JPEG APPn segment payloads.
+const (
+	jpegXMPHeader    = "http://ns.adobe.com/xap/1.0/\x00"
+	jpegXMPExtHeader = "http://ns.adobe.com/xmp/extension/\x00"
+	jpegICCHeader    = "ICC_PROFILE\x00"
+	jpegMPFHeader    = "MPF\x00"
+)
+
+// StripOptions configures metadata removal performed by StripMetadata.
+type StripOptions struct {
+	// KeepTags lists the IDs of tags in IFD0 and the ExifIFD which are kept.
+	// Kept tags are re-encoded in a minimal EXIF block which replaces the original.
+	// Tags of other groups, such as GPS and the thumbnail's IFD1, are always removed.
+	// If no tags are kept the EXIF metadata is removed entirely.
+	KeepTags []ID
+	// StripComments removes JPEG COM segments and PNG textual data chunks.
+	StripComments bool
+	// StripICC removes embedded ICC color profiles.
+	StripICC bool
+}
+
+// StripMetadata copies the JPEG, PNG or WebP image in src to dst removing
+// EXIF, XMP and IPTC metadata. JPEG and PNG images are processed as a stream.
+// WebP images are read into memory since the RIFF container size must be known
+// before it is written.
+//
+// For JPEG images EXIF and XMP APP1 segments and APP13 segments, which contain
+// IPTC metadata, are removed. Data following the EOI marker of the primary image,
+// such as MPF secondary images which may contain metadata of their own, is removed
+// along with the MPF APP2 segment. For PNG images eXIf chunks and textual data chunks
+// containing XMP or raw EXIF/IPTC profiles are removed. For WebP images EXIF and XMP
+// chunks are removed. EXIF metadata which fails to decode is removed entirely.
+func StripMetadata(dst io.Writer, src io.Reader, opts StripOptions) error {
+	br := bufio.NewReader(src)
+	magic, err := br.Peek(12)
+	if err != nil && len(magic) < 8 {
+		return noEOF(err)
+	}
+	switch {
+	case magic[0] == 0xff && magic[1] == markerSOI:
+		return stripJPEG(dst, br, opts)
+	case string(magic[:8]) == pngSignature:
+		return stripPNG(dst, br, opts)
+	case isWebP(magic):
+		return stripWebP(dst, br, opts)
+	}
+	return errors.New("unsupported image format for metadata stripping")
+}
+
+func stripJPEG(dst io.Writer, src io.Reader, opts StripOptions) error {
+	scn := newJPEGScanner(src)
+	err := scn.start()
+	if err != nil {
+		return err
+	}
+	_, err = dst.Write([]byte{0xff, markerSOI})
+	if err != nil {
+		return err
+	}
+	for {
+		marker, payload, err := scn.next()
+		if err != nil {
+			return err
+		}
+		var strip bool
+		switch marker {
+		case markerAPP1:
+			strip = bytes.HasPrefix(payload, []byte(jpegXMPHeader)) || bytes.HasPrefix(payload, []byte(jpegXMPExtHeader))
+			if bytes.HasPrefix(payload, []byte(exifHeader)) {
+				strip = true
+				exif := keepExif(payload[len(exifHeader):], opts.KeepTags)
+				if exif != nil && len(exif) <= MaxJPEGExifSize {
+					err = writeJPEGSegment(dst, markerAPP1, []byte(exifHeader), exif)
+				}
+			}
+		case markerAPP13:
+			strip = true
+		case markerAPP2:
+			// MPF segments index the images following the primary image, which are removed.
+			strip = opts.StripICC && bytes.HasPrefix(payload, []byte(jpegICCHeader)) ||
+				bytes.HasPrefix(payload, []byte(jpegMPFHeader))
+		case markerCOM:
+			strip = opts.StripComments
+		}
+		if err != nil {
+			return err
+		}
+		if !strip {
+			err = writeJPEGSegment(dst, marker, payload)
+			if err != nil {
+				return err
+			}
+		}
+		switch marker {
+		case markerEOI:
+			// Data following the primary image, such as the secondary images of MPF
+			// files or vendor trailers, may carry metadata of its own and is dropped.
+			return nil
+		case markerSOS:
+			// Segments between scans of progressive images are stripped as well.
+			err = scn.copyEntropyData(dst)
+			if err == io.EOF {
+				return nil // Image ends without EOI marker.
+			}
+			if err != nil {
+				return err
+			}
+		}
+	}
+}
+
+func stripPNG(dst io.Writer, src io.Reader, opts StripOptions) error {
+	var sig [len(pngSignature)]byte
+	_, err := io.ReadFull(src, sig[:])
+	if err != nil {
+		return err
+	}
+	_, err = dst.Write(sig[:])
+	if err != nil {
+		return err
+	}
+	for {
+		length, typ, err := readPNGChunkHeader(src)
+		if err != nil {
+			return noEOF(err)
+		}
+		var hdr [8]byte
+		binary.BigEndian.PutUint32(hdr[:4], length)
+		copy(hdr[4:], typ)
+		switch {
+		case typ == pngChunkEXIF:
+			data, err := readPNGChunkData(src, typ, length)
+			if err != nil {
+				return err
+			}
+			if exif := keepExif(data, opts.KeepTags); exif != nil {
+				err = writePNGChunk(dst, pngChunkEXIF, exif)
+			}
+			if err != nil {
+				return err
+			}
+		case isPNGText(typ):
+			kw := make([]byte, 80) // Keywords are at most 79 bytes long.
+			if int(length) < len(kw) {
+				kw = kw[:length]
+			}
+			_, err = io.ReadFull(src, kw)
+			if err != nil {
+				return noEOF(err)
+			}
+			keyword := pngTextKeyword(kw)
+			isMetadata := keyword == pngKeywordXMP || strings.HasPrefix(keyword, pngRawProfilePrefix)
+			w := io.Discard
+			if !isMetadata && !opts.StripComments {
+				w = dst
+				_, err = dst.Write(hdr[:])
+				if err != nil {
+					return err
+				}
+			}
+			// Chunks are streamed since text may be arbitrarily long.
+			err = copyPNGChunkData(w, io.MultiReader(bytes.NewReader(kw), src), typ, length)
+			if err != nil {
+				return err
+			}
+		case typ == pngChunkICCP && opts.StripICC:
+			err = copyPNGChunkData(io.Discard, src, typ, length)
+			if err != nil {
+				return err
+			}
+		default:
+			// Copy chunk as is.
+			_, err = dst.Write(hdr[:])
+			if err == nil {
+				_, err = io.CopyN(dst, src, int64(length)+4)
+			}
+			if err != nil || typ == pngChunkIEND {
+				return noEOF(err)
+			}
+		}
+	}
+}
+
+func stripWebP(dst io.Writer, src io.Reader, opts StripOptions) error {
+	b, err := io.ReadAll(src)
+	if err != nil {
+		return err
+	}
+	chunks, err := parseWebP(b)
+	if err != nil {
+		return err
+	}
+	kept := chunks[:0]
+	var vp8x []byte
+	for _, chunk := range chunks {
+		switch chunk.fourcc {
+		case webpChunkVP8X:
+			if len(chunk.data) > 0 {
+				// Copy to modify flags without modifying src data.
+				chunk.data = append([]byte{}, chunk.data...)
+				vp8x = chunk.data
+				vp8x[0] &^= vp8xFlagEXIF | vp8xFlagXMP
+			}
+		case webpChunkXMP:
+			continue
+		case webpChunkICCP:
+			if opts.StripICC {
+				if vp8x != nil {
+					vp8x[0] &^= vp8xFlagICC
+				}
+				continue
+			}
+		case webpChunkEXIF:
+			exif := keepExif(trimExifHeader(chunk.data), opts.KeepTags)
+			if exif == nil {
+				continue
+			}
+			if vp8x != nil {
+				vp8x[0] |= vp8xFlagEXIF
+			}
+			chunk.data = exif
+		}
+		kept = append(kept, chunk)
+	}
+	_, err = dst.Write(appendWebP(nil, kept))
+	return err
+}
+
+// trimExifHeader removes the "Exif\x00\x00" prefix from data if present.
+func trimExifHeader(data []byte) []byte {
+	return bytes.TrimPrefix(data, []byte(exifHeader))
+}
+
+// keepExif decodes the TIFF formatted EXIF data in tiff and returns EXIF data
+// containing only the tags of IFD0 and the ExifIFD with IDs in keep, encoded in the
+// original byte order. It returns nil if no tags are kept or if the data fails to decode.
+func keepExif(tiff []byte, keep []ID) []byte {
+	if len(keep) == 0 {
+		return nil
+	}
+	var decoder LazyDecoder
+	r := bytes.NewReader(tiff)
+	err := decoder.Decode(r)
+	if err != nil {
+		return nil
+	}
+	ifds, err := decoder.MakeIFDs(r, func(ifd, size int, id ID) bool {
+		for _, k := range keep {
+			if k == id {
+				return true
+			}
+		}
+		return false
+	})
+	if err != nil {
+		return nil
+	}
+	var kept []IFD
+	var ntags int
+	for _, ifd := range ifds {
+		switch ifd.Group {
+		case GroupIFD0, GroupSubIFD, GroupExifIFD:
+			if ifd.Group != GroupIFD0 && (len(ifd.Tags) == 0 || hasGroup(kept, ifd.Group)) {
+				continue // Only first ExifIFD is kept.
+			}
+			kept = append(kept, ifd)
+			ntags += len(ifd.Tags)
+		}
+	}
+	if ntags == 0 {
+		return nil
+	}
+	exif, err := Marshal(decoder.order, kept)
+	if err != nil {
+		return nil
+	}
+	return exif
+}
+
+func hasGroup(ifds []IFD, g Group) bool {
+	for i := range ifds {
+		if ifds[i].Group == g {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,227 @@
+package exif
+
+import (
+	"bytes"
+	"encoding/binary"
+	"io"
+	"runtime"
+	"testing"
+)
+
+func TestStripMetadata_jpeg(t *testing.T) {
+	exif := testStripExif(t)
+	sos := []byte{1, 1, 0, 0, 63, 0}
+	entropy := []byte{0x12, 0xff, 0x00, 0x34}
+	icc := []byte(jpegICCHeader + "\x01\x01profile")
+	src := testJPEG(
+		testSegment{markerAPP0, []byte("JFIF\x00\x01\x01\x00\x00\x01\x00\x01\x00\x00")},
+		testSegment{markerAPP1, append([]byte(exifHeader), exif...)},
+		testSegment{markerAPP1, []byte(jpegXMPHeader + "<x:xmpmeta/>")},
+		testSegment{markerAPP13, []byte("Photoshop 3.0\x008BIM")},
+		testSegment{markerAPP2, icc},
+		testSegment{markerCOM, []byte("comment")},
+		testSegment{markerSOS, sos},
+	)
+	src = append(src, entropy...)
+
+	var dst bytes.Buffer
+	err := StripMetadata(&dst, bytes.NewReader(src), StripOptions{StripComments: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := testJPEG(
+		testSegment{markerAPP0, []byte("JFIF\x00\x01\x01\x00\x00\x01\x00\x01\x00\x00")},
+		testSegment{markerAPP2, icc},
+		testSegment{markerSOS, sos},
+	)
+	want = append(want, entropy...)
+	if !bytes.Equal(dst.Bytes(), want) {
+		t.Errorf("stripped JPEG mismatch:\ngot  %q\nwant %q", dst.Bytes(), want)
+	}
+
+	dst.Reset()
+	err = StripMetadata(&dst, bytes.NewReader(src), StripOptions{KeepTags: []ID{0x0112, 0xa001}, StripICC: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	stripped := dst.Bytes()
+	if bytes.Contains(stripped, []byte("Secret")) || bytes.Contains(stripped, []byte("ICC_PROFILE")) {
+		t.Error("metadata not stripped")
+	}
+	if !bytes.Contains(stripped, []byte("comment")) {
+		t.Error("comment stripped")
+	}
+	start := bytes.Index(stripped, []byte(exifHeader))
+	if start < 0 {
+		t.Fatal("kept EXIF not found")
+	}
+	testStripKept(t, stripped[start+len(exifHeader):])
+}
+
+func TestStripMetadata_jpegTrailer(t *testing.T) {
+	exif := testStripExif(t)
+	sos := []byte{1, 1, 0, 0, 63, 0}
+	// Entropy-coded data with a stuffed byte, a restart marker and fill bytes before EOI.
+	entropy := []byte{0x12, 0xff, 0x00, 0x34, 0xff, markerRST0, 0x56, 0xff, 0xff}
+	mpf := []byte(jpegMPFHeader + "MM\x00\x2a\x00\x00\x00\x08")
+	dht := []byte{0x00, 1, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0}
+	// The image is progressive, with a second scan after an XMP segment.
+	src := testJPEG(
+		testSegment{markerAPP2, mpf},
+		testSegment{markerSOS, sos},
+	)
+	src = append(src, entropy...)
+	src = append(src, testJPEG(
+		testSegment{markerAPP1, []byte(jpegXMPHeader + "<x:xmpmeta/>")},
+		testSegment{0xc4, dht}, // DHT.,
+		testSegment{markerSOS, sos},
+	)[2:]...)
+	src = append(src, entropy...)
+	src = append(src, 0xff, markerEOI)
+	// Secondary image of an MPF file, carrying GPS data.
+	src = append(src, testJPEG(
+		testSegment{markerAPP1, append([]byte(exifHeader), exif...)},
+		testSegment{markerSOS, sos},
+	)...)
+	src = append(src, entropy...)
+	src = append(src, 0xff, markerEOI)
+	src = append(src, "vendor trailer"...)
+
+	var dst bytes.Buffer
+	err := StripMetadata(&dst, bytes.NewReader(src), StripOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := testJPEG(testSegment{markerSOS, sos})
+	want = append(want, entropy...)
+	want = append(want, testJPEG(
+		testSegment{0xc4, dht}, // DHT.,
+		testSegment{markerSOS, sos},
+	)[2:]...)
+	want = append(want, entropy...)
+	want = append(want, 0xff, markerEOI)
+	if !bytes.Equal(dst.Bytes(), want) {
+		t.Errorf("stripped JPEG mismatch:\ngot  %q\nwant %q", dst.Bytes(), want)
+	}
+	if bytes.Contains(dst.Bytes(), []byte(exifHeader)) || bytes.Contains(dst.Bytes(), []byte("2023:01:01")) {
+		t.Error("GPS data of trailing image not stripped")
+	}
+}
+
+func TestStripMetadata_png(t *testing.T) {
+	exif := testStripExif(t)
+	var src bytes.Buffer
+	src.WriteString(pngSignature)
+	writePNGChunk(&src, "IHDR", make([]byte, 13))
+	writePNGChunk(&src, pngChunkEXIF, exif)
+	writePNGChunk(&src, pngChunkITXT, []byte(pngKeywordXMP+"\x00\x00\x00\x00\x00<x:xmpmeta/>"))
+	writePNGChunk(&src, pngChunkTEXT, []byte("Raw profile type exif\x00\nexif\n10\n4578696600004d4d"))
+	writePNGChunk(&src, pngChunkTEXT, []byte("Comment\x00hello"))
+	writePNGChunk(&src, "IDAT", []byte{1, 2, 3})
+	writePNGChunk(&src, pngChunkIEND, nil)
+
+	var dst bytes.Buffer
+	err := StripMetadata(&dst, bytes.NewReader(src.Bytes()), StripOptions{KeepTags: []ID{0x0112, 0xa001}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	var want bytes.Buffer
+	want.WriteString(pngSignature)
+	writePNGChunk(&want, "IHDR", make([]byte, 13))
+	writePNGChunk(&want, pngChunkEXIF, keepExif(exif, []ID{0x0112, 0xa001}))
+	writePNGChunk(&want, pngChunkTEXT, []byte("Comment\x00hello"))
+	writePNGChunk(&want, "IDAT", []byte{1, 2, 3})
+	writePNGChunk(&want, pngChunkIEND, nil)
+	if !bytes.Equal(dst.Bytes(), want.Bytes()) {
+		t.Errorf("stripped PNG mismatch:\ngot  %q\nwant %q", dst.Bytes(), want.Bytes())
+	}
+	testStripKept(t, keepExif(exif, []ID{0x0112, 0xa001}))
+}
+
+func TestStripMetadata_pngChunkLength(t *testing.T) {
+	var before, after runtime.MemStats
+	runtime.ReadMemStats(&before)
+	for _, typ := range []string{pngChunkEXIF, pngChunkTEXT, pngChunkZTXT, pngChunkITXT, pngChunkICCP} {
+		// Chunk declaring a length of 2^31-1 followed by a few bytes.
+		src := append([]byte(pngSignature), 0x7f, 0xff, 0xff, 0xff)
+		src = append(src, typ...)
+		src = append(src, "Raw profile type exif\x00"...)
+		err := StripMetadata(io.Discard, bytes.NewReader(src), StripOptions{StripICC: true})
+		if err == nil {
+			t.Errorf("%s: expected error for chunk exceeding input", typ)
+		}
+	}
+	runtime.ReadMemStats(&after)
+	if alloc := after.TotalAlloc - before.TotalAlloc; alloc > 16<<20 {
+		t.Errorf("allocated %d bytes stripping short chunks", alloc)
+	}
+}
+
+func TestStripMetadata_webp(t *testing.T) {
+	exif := testStripExif(t)
+	src := appendWebP(nil, []webpChunk{
+		{fourcc: webpChunkVP8X, data: []byte{vp8xFlagEXIF | vp8xFlagXMP | vp8xFlagICC, 0, 0, 0, 1, 0, 0, 1, 0, 0}},
+		{fourcc: webpChunkICCP, data: []byte("profile")},
+		{fourcc: "VP8 ", data: []byte{1, 2, 3}},
+		{fourcc: webpChunkEXIF, data: exif},
+		{fourcc: webpChunkXMP, data: []byte("<x:xmpmeta/>")},
+	})
+	var dst bytes.Buffer
+	err := StripMetadata(&dst, bytes.NewReader(src), StripOptions{StripICC: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := appendWebP(nil, []webpChunk{
+		{fourcc: webpChunkVP8X, data: []byte{0, 0, 0, 0, 1, 0, 0, 1, 0, 0}},
+		{fourcc: "VP8 ", data: []byte{1, 2, 3}},
+	})
+	if !bytes.Equal(dst.Bytes(), want) {
+		t.Errorf("stripped WebP mismatch:\ngot  %q\nwant %q", dst.Bytes(), want)
+	}
+}
+
+// testStripExif returns EXIF data containing IFD0, ExifIFD and GPS tags.
+func testStripExif(t *testing.T) []byte {
+	exif, err := Marshal(binary.BigEndian, []IFD{
+		{Group: GroupIFD0, Tags: []Tag{
+			{ID: 0x010f, data: "Secret Maker"}, // Make.
+			{ID: 0x0112, data: int64(6)},       // Orientation.
+		}},
+		{Group: GroupExifIFD, Tags: []Tag{
+			{ID: 0xa001, data: int64(1)},               // ColorSpace.
+			{ID: 0xa431, data: "Secret Serial Number"}, // SerialNumber.
+		}},
+		{Group: GroupGPS, Tags: []Tag{
+			{ID: 0x001d, Group: GroupGPS, data: "2023:01:01"}, // GPSDateStamp.
+		}},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return exif
+}
+
+// testStripKept checks EXIF data contains only the Orientation and ColorSpace tags.
+func testStripKept(t *testing.T, exif []byte) {
+	t.Helper()
+	var decoder LazyDecoder
+	r := bytes.NewReader(exif)
+	err := decoder.Decode(r)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ifds, err := decoder.MakeIFDs(r, func(ifd, size int, id ID) bool { return id != idExifOffset })
+	if err != nil {
+		t.Fatal(err)
+	}
+	var got []string
+	for _, ifd := range ifds {
+		for _, tag := range ifd.Tags {
+			got = append(got, tag.String())
+		}
+	}
+	want := []string{"Orientation (uint16): Rotate 90 CW", "ColorSpace (uint16): sRGB"}
+	if len(got) != len(want) || got[0] != want[0] || got[1] != want[1] {
+		t.Errorf("got kept tags %q, want %q", got, want)
+	}
+}
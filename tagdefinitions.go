@@ -3,501 +3,1401 @@
 
 package exif
 
-var tags = map[uint16]tagdef{
-	0x0001: {Name: "InteropIndex", Type: 2, flags: 2, arrayLen: [2]int{-1, 1}, ID: 0x0001},
-	0x0002: {Name: "InteropVersion", Type: 7, flags: 3, arrayLen: [2]int{-1, 1}, ID: 0x0002},
-	0x000b: {Name: "ProcessingSoftware", Type: 2, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x000b},
-	0x00fe: {Name: "SubfileType", Type: 4, flags: 2, arrayLen: [2]int{-1, 1}, ID: 0x00fe, enum: []int64{0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 16, 65537, 65540, 4294967295}, enumString: []string{"Full-resolution image", "Reduced-resolution image", "Single page of multi-page image", "Single page of multi-page reduced-resolution image", "Transparency mask", "Transparency mask of reduced-resolution image", "Transparency mask of multi-page image", "Transparency mask of reduced-resolution multi-page image", "Depth map", "Depth map of reduced-resolution image", "Enhanced image data", "Alternate reduced-resolution image", "Semantic Mask", "invalid"}},
-	0x00ff: {Name: "OldSubfileType", Type: 3, flags: 2, arrayLen: [2]int{-1, 1}, ID: 0x00ff, enum: []int64{1, 2, 3}, enumString: []string{"Full-resolution image", "Reduced-resolution image", "Single page of multi-page image"}},
-	0x0100: {Name: "ImageWidth", Type: 4, flags: 2, arrayLen: [2]int{-1, 1}, ID: 0x0100},
-	0x0101: {Name: "ImageHeight", Type: 4, flags: 2, arrayLen: [2]int{-1, 1}, ID: 0x0101},
-	0x0102: {Name: "BitsPerSample", Type: 3, flags: 2, arrayLen: [2]int{-1, 0}, ID: 0x0102},
-	0x0103: {Name: "Compression", Type: 3, flags: 3, arrayLen: [2]int{-1, 1}, ID: 0x0103},
-	0x0106: {Name: "PhotometricInterpretation", Type: 3, flags: 2, arrayLen: [2]int{-1, 1}, ID: 0x0106, enum: []int64{0, 1, 2, 3, 4, 5, 6, 8, 9, 10, 32803, 32844, 32845, 32892, 34892, 51177, 52527}, enumString: []string{"WhiteIsZero", "BlackIsZero", "RGB", "RGB Palette", "Transparency Mask", "CMYK", "YCbCr", "CIELab", "ICCLab", "ITULab", "Color Filter Array", "Pixar LogL", "Pixar LogLuv", "Sequential Color Filter", "Linear Raw", "Depth Map", "Semantic Mask"}},
-	0x0107: {Name: "Thresholding", Type: 3, flags: 2, arrayLen: [2]int{-1, 1}, ID: 0x0107, enum: []int64{1, 2, 3}, enumString: []string{"No dithering or halftoning", "Ordered dither or halftone", "Randomized dither"}},
-	0x0108: {Name: "CellWidth", Type: 3, flags: 2, arrayLen: [2]int{-1, 1}, ID: 0x0108},
-	0x0109: {Name: "CellLength", Type: 3, flags: 2, arrayLen: [2]int{-1, 1}, ID: 0x0109},
-	0x010a: {Name: "FillOrder", Type: 3, flags: 2, arrayLen: [2]int{-1, 1}, ID: 0x010a, enum: []int64{1, 2}, enumString: []string{"Normal", "Reversed"}},
-	0x010d: {Name: "DocumentName", Type: 2, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x010d},
-	0x010e: {Name: "ImageDescription", Type: 2, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x010e},
-	0x010f: {Name: "Make", Type: 2, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x010f},
-	0x0110: {Name: "Model", Type: 2, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x0110},
-	0x0111: {Name: "StripOffsets", Type: 0, flags: 0, arrayLen: [2]int{-1, -1}, ID: 0x0111},
-	0x0112: {Name: "Orientation", Type: 3, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x0112, enum: []int64{1, 2, 3, 4, 5, 6, 7, 8}, enumString: []string{"Horizontal (normal)", "Mirror horizontal", "Rotate 180", "Mirror vertical", "Mirror horizontal and rotate 270 CW", "Rotate 90 CW", "Mirror horizontal and rotate 90 CW", "Rotate 270 CW"}},
-	0x0115: {Name: "SamplesPerPixel", Type: 3, flags: 2, arrayLen: [2]int{-1, 1}, ID: 0x0115},
-	0x0116: {Name: "RowsPerStrip", Type: 4, flags: 2, arrayLen: [2]int{-1, 1}, ID: 0x0116},
-	0x0117: {Name: "StripByteCounts", Type: 0, flags: 0, arrayLen: [2]int{-1, -1}, ID: 0x0117},
-	0x0118: {Name: "MinSampleValue", Type: 3, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x0118},
-	0x0119: {Name: "MaxSampleValue", Type: 3, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x0119},
-	0x011a: {Name: "XResolution", Type: 5, flags: 1, arrayLen: [2]int{-1, 1}, ID: 0x011a},
-	0x011b: {Name: "YResolution", Type: 5, flags: 1, arrayLen: [2]int{-1, 1}, ID: 0x011b},
-	0x011c: {Name: "PlanarConfiguration", Type: 3, flags: 2, arrayLen: [2]int{-1, 1}, ID: 0x011c, enum: []int64{1, 2}, enumString: []string{"Chunky", "Planar"}},
-	0x011d: {Name: "PageName", Type: 2, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x011d},
-	0x011e: {Name: "XPosition", Type: 5, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x011e},
-	0x011f: {Name: "YPosition", Type: 5, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x011f},
-	0x0120: {Name: "FreeOffsets", Type: 0, flags: 0, arrayLen: [2]int{-1, -1}, ID: 0x0120},
-	0x0121: {Name: "FreeByteCounts", Type: 0, flags: 0, arrayLen: [2]int{-1, -1}, ID: 0x0121},
-	0x0122: {Name: "GrayResponseUnit", Type: 3, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x0122, enum: []int64{1, 2, 3, 4, 5}, enumString: []string{"0.1", "0.001", "0.0001", "1e-05", "1e-06"}},
-	0x0123: {Name: "GrayResponseCurve", Type: 0, flags: 0, arrayLen: [2]int{-1, -1}, ID: 0x0123},
-	0x0124: {Name: "T4Options", Type: 0, flags: 0, arrayLen: [2]int{-1, -1}, ID: 0x0124},
-	0x0125: {Name: "T6Options", Type: 0, flags: 0, arrayLen: [2]int{-1, -1}, ID: 0x0125},
-	0x0128: {Name: "ResolutionUnit", Type: 3, flags: 1, arrayLen: [2]int{-1, 1}, ID: 0x0128, enum: []int64{1, 2, 3}, enumString: []string{"None", "inches", "cm"}},
-	0x0129: {Name: "PageNumber", Type: 3, flags: 0, arrayLen: [2]int{2, 1}, ID: 0x0129},
-	0x012c: {Name: "ColorResponseUnit", Type: 0, flags: 0, arrayLen: [2]int{-1, -1}, ID: 0x012c},
-	0x012d: {Name: "TransferFunction", Type: 3, flags: 2, arrayLen: [2]int{768, 1}, ID: 0x012d},
-	0x0131: {Name: "Software", Type: 2, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x0131},
-	0x0132: {Name: "ModifyDate", Type: 2, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x0132},
-	0x013b: {Name: "Artist", Type: 2, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x013b},
-	0x013c: {Name: "HostComputer", Type: 2, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x013c},
-	0x013d: {Name: "Predictor", Type: 3, flags: 2, arrayLen: [2]int{-1, 1}, ID: 0x013d, enum: []int64{1, 2, 3, 34892, 34893, 34894, 34895}, enumString: []string{"None", "Horizontal differencing", "Floating point", "Horizontal difference X2", "Horizontal difference X4", "Floating point X2", "Floating point X4"}},
-	0x013e: {Name: "WhitePoint", Type: 5, flags: 0, arrayLen: [2]int{2, 1}, ID: 0x013e},
-	0x013f: {Name: "PrimaryChromaticities", Type: 5, flags: 0, arrayLen: [2]int{6, 1}, ID: 0x013f},
-	0x0140: {Name: "ColorMap", Type: 0, flags: 0, arrayLen: [2]int{-1, -1}, ID: 0x0140},
-	0x0141: {Name: "HalftoneHints", Type: 3, flags: 0, arrayLen: [2]int{2, 1}, ID: 0x0141},
-	0x0142: {Name: "TileWidth", Type: 4, flags: 2, arrayLen: [2]int{-1, 1}, ID: 0x0142},
-	0x0143: {Name: "TileLength", Type: 4, flags: 2, arrayLen: [2]int{-1, 1}, ID: 0x0143},
-	0x0144: {Name: "TileOffsets", Type: 0, flags: 0, arrayLen: [2]int{-1, -1}, ID: 0x0144},
-	0x0145: {Name: "TileByteCounts", Type: 0, flags: 0, arrayLen: [2]int{-1, -1}, ID: 0x0145},
-	0x0146: {Name: "BadFaxLines", Type: 0, flags: 0, arrayLen: [2]int{-1, -1}, ID: 0x0146},
-	0x0147: {Name: "CleanFaxData", Type: 0, flags: 0, arrayLen: [2]int{-1, -1}, ID: 0x0147, enum: []int64{0, 1, 2}, enumString: []string{"Clean", "Regenerated", "Unclean"}},
-	0x0148: {Name: "ConsecutiveBadFaxLines", Type: 0, flags: 0, arrayLen: [2]int{-1, -1}, ID: 0x0148},
-	0x014a: {Name: "SubIFD", Type: 0, flags: 0, arrayLen: [2]int{-1, -1}, ID: 0x014a},
-	0x014c: {Name: "InkSet", Type: 3, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x014c, enum: []int64{1, 2}, enumString: []string{"CMYK", "Not CMYK"}},
-	0x014d: {Name: "InkNames", Type: 0, flags: 0, arrayLen: [2]int{-1, -1}, ID: 0x014d},
-	0x014e: {Name: "NumberofInks", Type: 0, flags: 0, arrayLen: [2]int{-1, -1}, ID: 0x014e},
-	0x0150: {Name: "DotRange", Type: 0, flags: 0, arrayLen: [2]int{-1, -1}, ID: 0x0150},
-	0x0151: {Name: "TargetPrinter", Type: 2, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x0151},
-	0x0152: {Name: "ExtraSamples", Type: 0, flags: 0, arrayLen: [2]int{-1, -1}, ID: 0x0152, enum: []int64{0, 1, 2}, enumString: []string{"Unspecified", "Associated Alpha", "Unassociated Alpha"}},
-	0x0153: {Name: "SampleFormat", Type: 0, flags: 0, arrayLen: [2]int{-1, -1}, ID: 0x0153, enum: []int64{1, 2, 3}, enumString: []string{"Unsigned", "Signed", "Float"}},
-	0x0154: {Name: "SMinSampleValue", Type: 0, flags: 0, arrayLen: [2]int{-1, -1}, ID: 0x0154},
-	0x0155: {Name: "SMaxSampleValue", Type: 0, flags: 0, arrayLen: [2]int{-1, -1}, ID: 0x0155},
-	0x0156: {Name: "TransferRange", Type: 0, flags: 0, arrayLen: [2]int{-1, -1}, ID: 0x0156},
-	0x0157: {Name: "ClipPath", Type: 0, flags: 0, arrayLen: [2]int{-1, -1}, ID: 0x0157},
-	0x0158: {Name: "XClipPathUnits", Type: 0, flags: 0, arrayLen: [2]int{-1, -1}, ID: 0x0158},
-	0x0159: {Name: "YClipPathUnits", Type: 0, flags: 0, arrayLen: [2]int{-1, -1}, ID: 0x0159},
-	0x015a: {Name: "Indexed", Type: 0, flags: 0, arrayLen: [2]int{-1, -1}, ID: 0x015a, enum: []int64{0, 1}, enumString: []string{"Not indexed", "Indexed"}},
-	0x015b: {Name: "JPEGTables", Type: 0, flags: 0, arrayLen: [2]int{-1, -1}, ID: 0x015b},
-	0x015f: {Name: "OPIProxy", Type: 0, flags: 0, arrayLen: [2]int{-1, -1}, ID: 0x015f, enum: []int64{0, 1}, enumString: []string{"Higher resolution image does not exist", "Higher resolution image exists"}},
-	0x0190: {Name: "GlobalParametersIFD", Type: 0, flags: 0, arrayLen: [2]int{-1, -1}, ID: 0x0190},
-	0x0191: {Name: "ProfileType", Type: 0, flags: 0, arrayLen: [2]int{-1, -1}, ID: 0x0191, enum: []int64{0, 1}, enumString: []string{"Unspecified", "Group 3 FAX"}},
-	0x0192: {Name: "FaxProfile", Type: 0, flags: 0, arrayLen: [2]int{-1, -1}, ID: 0x0192, enum: []int64{0, 1, 2, 3, 4, 5, 6, 7, 255}, enumString: []string{"Unknown", "Minimal B&W lossless, S", "Extended B&W lossless, F", "Lossless JBIG B&W, J", "Lossy color and grayscale, C", "Lossless color and grayscale, L", "Mixed raster content, M", "Profile T", "Multi Profiles"}},
-	0x0193: {Name: "CodingMethods", Type: 0, flags: 0, arrayLen: [2]int{-1, -1}, ID: 0x0193},
-	0x0194: {Name: "VersionYear", Type: 0, flags: 0, arrayLen: [2]int{-1, -1}, ID: 0x0194},
-	0x0195: {Name: "ModeNumber", Type: 0, flags: 0, arrayLen: [2]int{-1, -1}, ID: 0x0195},
-	0x01b1: {Name: "Decode", Type: 0, flags: 0, arrayLen: [2]int{-1, -1}, ID: 0x01b1},
-	0x01b2: {Name: "DefaultImageColor", Type: 0, flags: 0, arrayLen: [2]int{-1, -1}, ID: 0x01b2},
-	0x01b3: {Name: "T82Options", Type: 0, flags: 0, arrayLen: [2]int{-1, -1}, ID: 0x01b3},
-	0x01b5: {Name: "JPEGTables", Type: 0, flags: 0, arrayLen: [2]int{-1, -1}, ID: 0x01b5},
-	0x0200: {Name: "JPEGProc", Type: 0, flags: 0, arrayLen: [2]int{-1, -1}, ID: 0x0200, enum: []int64{1, 14}, enumString: []string{"Baseline", "Lossless"}},
-	0x0201: {Name: "ThumbnailOffset", Type: 4, flags: 4, arrayLen: [2]int{-1, 1}, ID: 0x0201},
-	0x0202: {Name: "ThumbnailLength", Type: 4, flags: 4, arrayLen: [2]int{-1, 1}, ID: 0x0202},
-	0x0203: {Name: "JPEGRestartInterval", Type: 0, flags: 0, arrayLen: [2]int{-1, -1}, ID: 0x0203},
-	0x0205: {Name: "JPEGLosslessPredictors", Type: 0, flags: 0, arrayLen: [2]int{-1, -1}, ID: 0x0205},
-	0x0206: {Name: "JPEGPointTransforms", Type: 0, flags: 0, arrayLen: [2]int{-1, -1}, ID: 0x0206},
-	0x0207: {Name: "JPEGQTables", Type: 0, flags: 0, arrayLen: [2]int{-1, -1}, ID: 0x0207},
-	0x0208: {Name: "JPEGDCTables", Type: 0, flags: 0, arrayLen: [2]int{-1, -1}, ID: 0x0208},
-	0x0209: {Name: "JPEGACTables", Type: 0, flags: 0, arrayLen: [2]int{-1, -1}, ID: 0x0209},
-	0x0211: {Name: "YCbCrCoefficients", Type: 5, flags: 2, arrayLen: [2]int{3, 1}, ID: 0x0211},
-	0x0212: {Name: "YCbCrSubSampling", Type: 3, flags: 2, arrayLen: [2]int{2, 1}, ID: 0x0212},
-	0x0213: {Name: "YCbCrPositioning", Type: 3, flags: 3, arrayLen: [2]int{-1, 1}, ID: 0x0213, enum: []int64{1, 2}, enumString: []string{"Centered", "Co-sited"}},
-	0x0214: {Name: "ReferenceBlackWhite", Type: 5, flags: 0, arrayLen: [2]int{6, 1}, ID: 0x0214},
-	0x022f: {Name: "StripRowCounts", Type: 0, flags: 0, arrayLen: [2]int{-1, -1}, ID: 0x022f},
-	0x02bc: {Name: "ApplicationNotes", Type: 1, flags: 2, arrayLen: [2]int{-1, 1}, ID: 0x02bc},
-	0x03e7: {Name: "USPTOMiscellaneous", Type: 0, flags: 0, arrayLen: [2]int{-1, -1}, ID: 0x03e7},
-	0x1000: {Name: "RelatedImageFileFormat", Type: 2, flags: 2, arrayLen: [2]int{-1, 1}, ID: 0x1000},
-	0x1001: {Name: "RelatedImageWidth", Type: 3, flags: 2, arrayLen: [2]int{-1, 1}, ID: 0x1001},
-	0x1002: {Name: "RelatedImageHeight", Type: 3, flags: 2, arrayLen: [2]int{-1, 1}, ID: 0x1002},
-	0x4746: {Name: "Rating", Type: 3, flags: 8, arrayLen: [2]int{-1, 1}, ID: 0x4746},
-	0x4747: {Name: "XP_DIP_XML", Type: 0, flags: 0, arrayLen: [2]int{-1, -1}, ID: 0x4747},
-	0x4748: {Name: "StitchInfo", Type: 0, flags: 0, arrayLen: [2]int{-1, -1}, ID: 0x4748},
-	0x4749: {Name: "RatingPercent", Type: 3, flags: 8, arrayLen: [2]int{-1, 1}, ID: 0x4749},
-	0x7000: {Name: "SonyRawFileType", Type: 0, flags: 0, arrayLen: [2]int{-1, -1}, ID: 0x7000, enum: []int64{0, 1, 2, 3, 4}, enumString: []string{"Sony Uncompressed 14-bit RAW", "Sony Uncompressed 12-bit RAW", "Sony Compressed RAW", "Sony Lossless Compressed RAW", "Sony Lossless Compressed RAW 2"}},
-	0x7010: {Name: "SonyToneCurve", Type: 0, flags: 0, arrayLen: [2]int{-1, -1}, ID: 0x7010},
-	0x7031: {Name: "VignettingCorrection", Type: 3, flags: 2, arrayLen: [2]int{-1, 1}, ID: 0x7031, enum: []int64{256, 257, 272, 511}, enumString: []string{"Off", "Auto", "Auto (ILCE-1)", "No correction params available"}},
-	0x7032: {Name: "VignettingCorrParams", Type: 3, flags: 2, arrayLen: [2]int{17, 1}, ID: 0x7032},
-	0x7034: {Name: "ChromaticAberrationCorrection", Type: 3, flags: 2, arrayLen: [2]int{-1, 1}, ID: 0x7034, enum: []int64{0, 1, 255}, enumString: []string{"Off", "Auto", "No correction params available"}},
-	0x7035: {Name: "ChromaticAberrationCorrParams", Type: 3, flags: 2, arrayLen: [2]int{33, 1}, ID: 0x7035},
-	0x7036: {Name: "DistortionCorrection", Type: 3, flags: 2, arrayLen: [2]int{-1, 1}, ID: 0x7036, enum: []int64{0, 1, 17, 255}, enumString: []string{"Off", "Auto", "Auto fixed by lens", "No correction params available"}},
-	0x7037: {Name: "DistortionCorrParams", Type: 3, flags: 2, arrayLen: [2]int{17, 1}, ID: 0x7037},
-	0x74c7: {Name: "SonyCropTopLeft", Type: 4, flags: 2, arrayLen: [2]int{2, 1}, ID: 0x74c7},
-	0x74c8: {Name: "SonyCropSize", Type: 4, flags: 2, arrayLen: [2]int{2, 1}, ID: 0x74c8},
-	0x800d: {Name: "ImageID", Type: 0, flags: 0, arrayLen: [2]int{-1, -1}, ID: 0x800d},
-	0x80a3: {Name: "WangTag1", Type: 0, flags: 0, arrayLen: [2]int{-1, -1}, ID: 0x80a3},
-	0x80a4: {Name: "WangAnnotation", Type: 0, flags: 0, arrayLen: [2]int{-1, -1}, ID: 0x80a4},
-	0x80a5: {Name: "WangTag3", Type: 0, flags: 0, arrayLen: [2]int{-1, -1}, ID: 0x80a5},
-	0x80a6: {Name: "WangTag4", Type: 0, flags: 0, arrayLen: [2]int{-1, -1}, ID: 0x80a6},
-	0x80b9: {Name: "ImageReferencePoints", Type: 0, flags: 0, arrayLen: [2]int{-1, -1}, ID: 0x80b9},
-	0x80ba: {Name: "RegionXformTackPoint", Type: 0, flags: 0, arrayLen: [2]int{-1, -1}, ID: 0x80ba},
-	0x80bb: {Name: "WarpQuadrilateral", Type: 0, flags: 0, arrayLen: [2]int{-1, -1}, ID: 0x80bb},
-	0x80bc: {Name: "AffineTransformMat", Type: 0, flags: 0, arrayLen: [2]int{-1, -1}, ID: 0x80bc},
-	0x80e3: {Name: "Matteing", Type: 0, flags: 0, arrayLen: [2]int{-1, -1}, ID: 0x80e3},
-	0x80e4: {Name: "DataType", Type: 0, flags: 0, arrayLen: [2]int{-1, -1}, ID: 0x80e4},
-	0x80e5: {Name: "ImageDepth", Type: 0, flags: 0, arrayLen: [2]int{-1, -1}, ID: 0x80e5},
-	0x80e6: {Name: "TileDepth", Type: 0, flags: 0, arrayLen: [2]int{-1, -1}, ID: 0x80e6},
-	0x8214: {Name: "ImageFullWidth", Type: 0, flags: 0, arrayLen: [2]int{-1, -1}, ID: 0x8214},
-	0x8215: {Name: "ImageFullHeight", Type: 0, flags: 0, arrayLen: [2]int{-1, -1}, ID: 0x8215},
-	0x8216: {Name: "TextureFormat", Type: 0, flags: 0, arrayLen: [2]int{-1, -1}, ID: 0x8216},
-	0x8217: {Name: "WrapModes", Type: 0, flags: 0, arrayLen: [2]int{-1, -1}, ID: 0x8217},
-	0x8218: {Name: "FovCot", Type: 0, flags: 0, arrayLen: [2]int{-1, -1}, ID: 0x8218},
-	0x8219: {Name: "MatrixWorldToScreen", Type: 0, flags: 0, arrayLen: [2]int{-1, -1}, ID: 0x8219},
-	0x821a: {Name: "MatrixWorldToCamera", Type: 0, flags: 0, arrayLen: [2]int{-1, -1}, ID: 0x821a},
-	0x827d: {Name: "Model2", Type: 0, flags: 0, arrayLen: [2]int{-1, -1}, ID: 0x827d},
-	0x828d: {Name: "CFARepeatPatternDim", Type: 3, flags: 2, arrayLen: [2]int{2, 1}, ID: 0x828d},
-	0x828e: {Name: "CFAPattern2", Type: 1, flags: 2, arrayLen: [2]int{-1, 0}, ID: 0x828e},
-	0x828f: {Name: "BatteryLevel", Type: 0, flags: 0, arrayLen: [2]int{-1, -1}, ID: 0x828f},
-	0x8290: {Name: "KodakIFD", Type: 0, flags: 0, arrayLen: [2]int{-1, -1}, ID: 0x8290},
-	0x8298: {Name: "Copyright", Type: 2, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x8298},
-	0x829a: {Name: "ExposureTime", Type: 5, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x829a},
-	0x829d: {Name: "FNumber", Type: 5, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x829d},
-	0x82a5: {Name: "MDFileTag", Type: 0, flags: 0, arrayLen: [2]int{-1, -1}, ID: 0x82a5},
-	0x82a6: {Name: "MDScalePixel", Type: 0, flags: 0, arrayLen: [2]int{-1, -1}, ID: 0x82a6},
-	0x82a7: {Name: "MDColorTable", Type: 0, flags: 0, arrayLen: [2]int{-1, -1}, ID: 0x82a7},
-	0x82a8: {Name: "MDLabName", Type: 0, flags: 0, arrayLen: [2]int{-1, -1}, ID: 0x82a8},
-	0x82a9: {Name: "MDSampleInfo", Type: 0, flags: 0, arrayLen: [2]int{-1, -1}, ID: 0x82a9},
-	0x82aa: {Name: "MDPrepDate", Type: 0, flags: 0, arrayLen: [2]int{-1, -1}, ID: 0x82aa},
-	0x82ab: {Name: "MDPrepTime", Type: 0, flags: 0, arrayLen: [2]int{-1, -1}, ID: 0x82ab},
-	0x82ac: {Name: "MDFileUnits", Type: 0, flags: 0, arrayLen: [2]int{-1, -1}, ID: 0x82ac},
-	0x830e: {Name: "PixelScale", Type: 12, flags: 0, arrayLen: [2]int{3, 1}, ID: 0x830e},
-	0x8335: {Name: "AdventScale", Type: 0, flags: 0, arrayLen: [2]int{-1, -1}, ID: 0x8335},
-	0x8336: {Name: "AdventRevision", Type: 0, flags: 0, arrayLen: [2]int{-1, -1}, ID: 0x8336},
-	0x835c: {Name: "UIC1Tag", Type: 0, flags: 0, arrayLen: [2]int{-1, -1}, ID: 0x835c},
-	0x835d: {Name: "UIC2Tag", Type: 0, flags: 0, arrayLen: [2]int{-1, -1}, ID: 0x835d},
-	0x835e: {Name: "UIC3Tag", Type: 0, flags: 0, arrayLen: [2]int{-1, -1}, ID: 0x835e},
-	0x835f: {Name: "UIC4Tag", Type: 0, flags: 0, arrayLen: [2]int{-1, -1}, ID: 0x835f},
-	0x83bb: {Name: "IPTC-NAA", Type: 4, flags: 2, arrayLen: [2]int{-1, 1}, ID: 0x83bb},
-	0x847e: {Name: "IntergraphPacketData", Type: 0, flags: 0, arrayLen: [2]int{-1, -1}, ID: 0x847e},
-	0x847f: {Name: "IntergraphFlagRegisters", Type: 0, flags: 0, arrayLen: [2]int{-1, -1}, ID: 0x847f},
-	0x8480: {Name: "IntergraphMatrix", Type: 12, flags: 0, arrayLen: [2]int{-1, 0}, ID: 0x8480},
-	0x8481: {Name: "INGRReserved", Type: 0, flags: 0, arrayLen: [2]int{-1, -1}, ID: 0x8481},
-	0x8482: {Name: "ModelTiePoint", Type: 12, flags: 0, arrayLen: [2]int{-1, 0}, ID: 0x8482},
-	0x84e0: {Name: "Site", Type: 0, flags: 0, arrayLen: [2]int{-1, -1}, ID: 0x84e0},
-	0x84e1: {Name: "ColorSequence", Type: 0, flags: 0, arrayLen: [2]int{-1, -1}, ID: 0x84e1},
-	0x84e2: {Name: "IT8Header", Type: 0, flags: 0, arrayLen: [2]int{-1, -1}, ID: 0x84e2},
-	0x84e3: {Name: "RasterPadding", Type: 0, flags: 0, arrayLen: [2]int{-1, -1}, ID: 0x84e3, enum: []int64{0, 1, 2, 9, 10}, enumString: []string{"Byte", "Word", "Long Word", "Sector", "Long Sector"}},
-	0x84e4: {Name: "BitsPerRunLength", Type: 0, flags: 0, arrayLen: [2]int{-1, -1}, ID: 0x84e4},
-	0x84e5: {Name: "BitsPerExtendedRunLength", Type: 0, flags: 0, arrayLen: [2]int{-1, -1}, ID: 0x84e5},
-	0x84e6: {Name: "ColorTable", Type: 0, flags: 0, arrayLen: [2]int{-1, -1}, ID: 0x84e6},
-	0x84e7: {Name: "ImageColorIndicator", Type: 0, flags: 0, arrayLen: [2]int{-1, -1}, ID: 0x84e7, enum: []int64{0, 1}, enumString: []string{"Unspecified Image Color", "Specified Image Color"}},
-	0x84e8: {Name: "BackgroundColorIndicator", Type: 0, flags: 0, arrayLen: [2]int{-1, -1}, ID: 0x84e8, enum: []int64{0, 1}, enumString: []string{"Unspecified Background Color", "Specified Background Color"}},
-	0x84e9: {Name: "ImageColorValue", Type: 0, flags: 0, arrayLen: [2]int{-1, -1}, ID: 0x84e9},
-	0x84ea: {Name: "BackgroundColorValue", Type: 0, flags: 0, arrayLen: [2]int{-1, -1}, ID: 0x84ea},
-	0x84eb: {Name: "PixelIntensityRange", Type: 0, flags: 0, arrayLen: [2]int{-1, -1}, ID: 0x84eb},
-	0x84ec: {Name: "TransparencyIndicator", Type: 0, flags: 0, arrayLen: [2]int{-1, -1}, ID: 0x84ec},
-	0x84ed: {Name: "ColorCharacterization", Type: 0, flags: 0, arrayLen: [2]int{-1, -1}, ID: 0x84ed},
-	0x84ee: {Name: "HCUsage", Type: 0, flags: 0, arrayLen: [2]int{-1, -1}, ID: 0x84ee, enum: []int64{0, 1, 2}, enumString: []string{"CT", "Line Art", "Trap"}},
-	0x84ef: {Name: "TrapIndicator", Type: 0, flags: 0, arrayLen: [2]int{-1, -1}, ID: 0x84ef},
-	0x84f0: {Name: "CMYKEquivalent", Type: 0, flags: 0, arrayLen: [2]int{-1, -1}, ID: 0x84f0},
-	0x8546: {Name: "SEMInfo", Type: 2, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x8546},
-	0x8568: {Name: "AFCP_IPTC", Type: 0, flags: 0, arrayLen: [2]int{-1, -1}, ID: 0x8568},
-	0x85b8: {Name: "PixelMagicJBIGOptions", Type: 0, flags: 0, arrayLen: [2]int{-1, -1}, ID: 0x85b8},
-	0x85d7: {Name: "JPLCartoIFD", Type: 0, flags: 0, arrayLen: [2]int{-1, -1}, ID: 0x85d7},
-	0x85d8: {Name: "ModelTransform", Type: 12, flags: 0, arrayLen: [2]int{16, 1}, ID: 0x85d8},
-	0x8602: {Name: "WB_GRGBLevels", Type: 0, flags: 0, arrayLen: [2]int{-1, -1}, ID: 0x8602},
-	0x8606: {Name: "LeafData", Type: 0, flags: 0, arrayLen: [2]int{-1, -1}, ID: 0x8606},
-	0x8649: {Name: "PhotoshopSettings", Type: 0, flags: 0, arrayLen: [2]int{-1, -1}, ID: 0x8649},
-	0x8769: {Name: "ExifOffset", Type: 0, flags: 0, arrayLen: [2]int{-1, -1}, ID: 0x8769},
-	0x8773: {Name: "ICC_Profile", Type: 0, flags: 0, arrayLen: [2]int{-1, -1}, ID: 0x8773},
-	0x877f: {Name: "TIFF_FXExtensions", Type: 0, flags: 0, arrayLen: [2]int{-1, -1}, ID: 0x877f},
-	0x8780: {Name: "MultiProfiles", Type: 0, flags: 0, arrayLen: [2]int{-1, -1}, ID: 0x8780},
-	0x8781: {Name: "SharedData", Type: 0, flags: 0, arrayLen: [2]int{-1, -1}, ID: 0x8781},
-	0x8782: {Name: "T88Options", Type: 0, flags: 0, arrayLen: [2]int{-1, -1}, ID: 0x8782},
-	0x87ac: {Name: "ImageLayer", Type: 0, flags: 0, arrayLen: [2]int{-1, -1}, ID: 0x87ac},
-	0x87af: {Name: "GeoTiffDirectory", Type: 3, flags: 0, arrayLen: [2]int{5, 1}, ID: 0x87af},
-	0x87b0: {Name: "GeoTiffDoubleParams", Type: 12, flags: 0, arrayLen: [2]int{125, 1}, ID: 0x87b0},
-	0x87b1: {Name: "GeoTiffAsciiParams", Type: 2, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x87b1},
-	0x87be: {Name: "JBIGOptions", Type: 0, flags: 0, arrayLen: [2]int{-1, -1}, ID: 0x87be},
-	0x8822: {Name: "ExposureProgram", Type: 3, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x8822, enum: []int64{0, 1, 2, 3, 4, 5, 6, 7, 8, 9}, enumString: []string{"Not Defined", "Manual", "Program AE", "Aperture-priority AE", "Shutter speed priority AE", "Creative (Slow speed)", "Action (High speed)", "Portrait", "Landscape", "Bulb"}},
-	0x8824: {Name: "SpectralSensitivity", Type: 2, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x8824},
-	0x8825: {Name: "GPSInfo", Type: 0, flags: 0, arrayLen: [2]int{-1, -1}, ID: 0x8825},
-	0x8827: {Name: "ISO", Type: 3, flags: 0, arrayLen: [2]int{-1, 0}, ID: 0x8827},
-	0x8828: {Name: "Opto-ElectricConvFactor", Type: 0, flags: 0, arrayLen: [2]int{-1, -1}, ID: 0x8828},
-	0x8829: {Name: "Interlace", Type: 0, flags: 0, arrayLen: [2]int{-1, -1}, ID: 0x8829},
-	0x882a: {Name: "TimeZoneOffset", Type: 3, flags: 0, arrayLen: [2]int{-1, 0}, ID: 0x882a},
-	0x882b: {Name: "SelfTimerMode", Type: 3, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x882b},
-	0x8830: {Name: "SensitivityType", Type: 3, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x8830, enum: []int64{0, 1, 2, 3, 4, 5, 6, 7}, enumString: []string{"Unknown", "Standard Output Sensitivity", "Recommended Exposure Index", "ISO Speed", "Standard Output Sensitivity and Recommended Exposure Index", "Standard Output Sensitivity and ISO Speed", "Recommended Exposure Index and ISO Speed", "Standard Output Sensitivity, Recommended Exposure Index and ISO Speed"}},
-	0x8831: {Name: "StandardOutputSensitivity", Type: 4, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x8831},
-	0x8832: {Name: "RecommendedExposureIndex", Type: 4, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x8832},
-	0x8833: {Name: "ISOSpeed", Type: 4, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x8833},
-	0x8834: {Name: "ISOSpeedLatitudeyyy", Type: 4, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x8834},
-	0x8835: {Name: "ISOSpeedLatitudezzz", Type: 4, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x8835},
-	0x885c: {Name: "FaxRecvParams", Type: 0, flags: 0, arrayLen: [2]int{-1, -1}, ID: 0x885c},
-	0x885d: {Name: "FaxSubAddress", Type: 0, flags: 0, arrayLen: [2]int{-1, -1}, ID: 0x885d},
-	0x885e: {Name: "FaxRecvTime", Type: 0, flags: 0, arrayLen: [2]int{-1, -1}, ID: 0x885e},
-	0x8871: {Name: "FedexEDR", Type: 0, flags: 0, arrayLen: [2]int{-1, -1}, ID: 0x8871},
-	0x888a: {Name: "LeafSubIFD", Type: 0, flags: 0, arrayLen: [2]int{-1, -1}, ID: 0x888a},
-	0x9000: {Name: "ExifVersion", Type: 7, flags: 1, arrayLen: [2]int{-1, 1}, ID: 0x9000},
-	0x9003: {Name: "DateTimeOriginal", Type: 2, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x9003},
-	0x9004: {Name: "CreateDate", Type: 2, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x9004},
-	0x9009: {Name: "GooglePlusUploadCode", Type: 7, flags: 0, arrayLen: [2]int{-1, 0}, ID: 0x9009},
-	0x9010: {Name: "OffsetTime", Type: 2, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x9010},
-	0x9011: {Name: "OffsetTimeOriginal", Type: 2, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x9011},
-	0x9012: {Name: "OffsetTimeDigitized", Type: 2, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x9012},
-	0x9101: {Name: "ComponentsConfiguration", Type: 7, flags: 3, arrayLen: [2]int{4, 1}, ID: 0x9101, enum: []int64{0, 1, 2, 3}, enumString: []string{"-", "Y", "Cb", "Cr"}},
-	0x9102: {Name: "CompressedBitsPerPixel", Type: 5, flags: 2, arrayLen: [2]int{-1, 1}, ID: 0x9102},
-	0x9201: {Name: "ShutterSpeedValue", Type: 10, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x9201},
-	0x9202: {Name: "ApertureValue", Type: 5, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x9202},
-	0x9203: {Name: "BrightnessValue", Type: 10, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x9203},
-	0x9204: {Name: "ExposureCompensation", Type: 10, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x9204},
-	0x9205: {Name: "MaxApertureValue", Type: 5, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x9205},
-	0x9206: {Name: "SubjectDistance", Type: 5, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x9206},
-	0x9207: {Name: "MeteringMode", Type: 3, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x9207, enum: []int64{0, 1, 2, 3, 4, 5, 6, 255}, enumString: []string{"Unknown", "Average", "Center-weighted average", "Spot", "Multi-spot", "Multi-segment", "Partial", "Other"}},
-	0x9208: {Name: "LightSource", Type: 3, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x9208},
-	0x9209: {Name: "Flash", Type: 3, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x9209},
-	0x920a: {Name: "FocalLength", Type: 5, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x920a},
-	0x920b: {Name: "FlashEnergy", Type: 0, flags: 0, arrayLen: [2]int{-1, -1}, ID: 0x920b},
-	0x920c: {Name: "SpatialFrequencyResponse", Type: 0, flags: 0, arrayLen: [2]int{-1, -1}, ID: 0x920c},
-	0x920d: {Name: "Noise", Type: 0, flags: 0, arrayLen: [2]int{-1, -1}, ID: 0x920d},
-	0x920e: {Name: "FocalPlaneXResolution", Type: 0, flags: 0, arrayLen: [2]int{-1, -1}, ID: 0x920e},
-	0x920f: {Name: "FocalPlaneYResolution", Type: 0, flags: 0, arrayLen: [2]int{-1, -1}, ID: 0x920f},
-	0x9210: {Name: "FocalPlaneResolutionUnit", Type: 0, flags: 0, arrayLen: [2]int{-1, -1}, ID: 0x9210, enum: []int64{1, 2, 3, 4, 5}, enumString: []string{"None", "inches", "cm", "mm", "um"}},
-	0x9211: {Name: "ImageNumber", Type: 4, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x9211},
-	0x9212: {Name: "SecurityClassification", Type: 2, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x9212},
-	0x9213: {Name: "ImageHistory", Type: 2, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x9213},
-	0x9214: {Name: "SubjectArea", Type: 3, flags: 0, arrayLen: [2]int{-1, 0}, ID: 0x9214},
-	0x9215: {Name: "ExposureIndex", Type: 0, flags: 0, arrayLen: [2]int{-1, -1}, ID: 0x9215},
-	0x9216: {Name: "TIFF-EPStandardID", Type: 0, flags: 0, arrayLen: [2]int{-1, -1}, ID: 0x9216},
-	0x9217: {Name: "SensingMethod", Type: 0, flags: 0, arrayLen: [2]int{-1, -1}, ID: 0x9217, enum: []int64{1, 2, 3, 4, 5, 6, 7, 8}, enumString: []string{"Monochrome area", "One-chip color area", "Two-chip color area", "Three-chip color area", "Color sequential area", "Monochrome linear", "Trilinear", "Color sequential linear"}},
-	0x923a: {Name: "CIP3DataFile", Type: 0, flags: 0, arrayLen: [2]int{-1, -1}, ID: 0x923a},
-	0x923b: {Name: "CIP3Sheet", Type: 0, flags: 0, arrayLen: [2]int{-1, -1}, ID: 0x923b},
-	0x923c: {Name: "CIP3Side", Type: 0, flags: 0, arrayLen: [2]int{-1, -1}, ID: 0x923c},
-	0x923f: {Name: "StoNits", Type: 0, flags: 0, arrayLen: [2]int{-1, -1}, ID: 0x923f},
-	0x927c: {Name: "MakerNoteApple", Type: 7, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x927c},
-	0x9286: {Name: "UserComment", Type: 7, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x9286},
-	0x9290: {Name: "SubSecTime", Type: 2, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x9290},
-	0x9291: {Name: "SubSecTimeOriginal", Type: 2, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x9291},
-	0x9292: {Name: "SubSecTimeDigitized", Type: 2, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x9292},
-	0x932f: {Name: "MSDocumentText", Type: 0, flags: 0, arrayLen: [2]int{-1, -1}, ID: 0x932f},
-	0x9330: {Name: "MSPropertySetStorage", Type: 0, flags: 0, arrayLen: [2]int{-1, -1}, ID: 0x9330},
-	0x9331: {Name: "MSDocumentTextPosition", Type: 0, flags: 0, arrayLen: [2]int{-1, -1}, ID: 0x9331},
-	0x935c: {Name: "ImageSourceData", Type: 7, flags: 2, arrayLen: [2]int{-1, 1}, ID: 0x935c},
-	0x9400: {Name: "AmbientTemperature", Type: 10, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x9400},
-	0x9401: {Name: "Humidity", Type: 5, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x9401},
-	0x9402: {Name: "Pressure", Type: 5, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x9402},
-	0x9403: {Name: "WaterDepth", Type: 10, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x9403},
-	0x9404: {Name: "Acceleration", Type: 5, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x9404},
-	0x9405: {Name: "CameraElevationAngle", Type: 10, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x9405},
-	0x9c9b: {Name: "XPTitle", Type: 1, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x9c9b},
-	0x9c9c: {Name: "XPComment", Type: 1, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x9c9c},
-	0x9c9d: {Name: "XPAuthor", Type: 1, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x9c9d},
-	0x9c9e: {Name: "XPKeywords", Type: 1, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x9c9e},
-	0x9c9f: {Name: "XPSubject", Type: 1, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x9c9f},
-	0xa000: {Name: "FlashpixVersion", Type: 7, flags: 1, arrayLen: [2]int{-1, 1}, ID: 0xa000},
-	0xa001: {Name: "ColorSpace", Type: 3, flags: 1, arrayLen: [2]int{-1, 1}, ID: 0xa001, enum: []int64{1, 2, 65533, 65534, 65535}, enumString: []string{"sRGB", "Adobe RGB", "Wide Gamut RGB", "ICC Profile", "Uncalibrated"}},
-	0xa002: {Name: "ExifImageWidth", Type: 3, flags: 1, arrayLen: [2]int{-1, 1}, ID: 0xa002},
-	0xa003: {Name: "ExifImageHeight", Type: 3, flags: 1, arrayLen: [2]int{-1, 1}, ID: 0xa003},
-	0xa004: {Name: "RelatedSoundFile", Type: 2, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0xa004},
-	0xa005: {Name: "InteropOffset", Type: 0, flags: 0, arrayLen: [2]int{-1, -1}, ID: 0xa005},
-	0xa010: {Name: "SamsungRawPointersOffset", Type: 0, flags: 0, arrayLen: [2]int{-1, -1}, ID: 0xa010},
-	0xa011: {Name: "SamsungRawPointersLength", Type: 0, flags: 0, arrayLen: [2]int{-1, -1}, ID: 0xa011},
-	0xa101: {Name: "SamsungRawByteOrder", Type: 0, flags: 0, arrayLen: [2]int{-1, -1}, ID: 0xa101},
-	0xa102: {Name: "SamsungRawUnknown?", Type: 0, flags: 0, arrayLen: [2]int{-1, -1}, ID: 0xa102},
-	0xa20b: {Name: "FlashEnergy", Type: 5, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0xa20b},
-	0xa20c: {Name: "SpatialFrequencyResponse", Type: 0, flags: 0, arrayLen: [2]int{-1, -1}, ID: 0xa20c},
-	0xa20d: {Name: "Noise", Type: 0, flags: 0, arrayLen: [2]int{-1, -1}, ID: 0xa20d},
-	0xa20e: {Name: "FocalPlaneXResolution", Type: 5, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0xa20e},
-	0xa20f: {Name: "FocalPlaneYResolution", Type: 5, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0xa20f},
-	0xa210: {Name: "FocalPlaneResolutionUnit", Type: 3, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0xa210, enum: []int64{1, 2, 3, 4, 5}, enumString: []string{"None", "inches", "cm", "mm", "um"}},
-	0xa211: {Name: "ImageNumber", Type: 0, flags: 0, arrayLen: [2]int{-1, -1}, ID: 0xa211},
-	0xa212: {Name: "SecurityClassification", Type: 0, flags: 0, arrayLen: [2]int{-1, -1}, ID: 0xa212},
-	0xa213: {Name: "ImageHistory", Type: 0, flags: 0, arrayLen: [2]int{-1, -1}, ID: 0xa213},
-	0xa214: {Name: "SubjectLocation", Type: 3, flags: 0, arrayLen: [2]int{2, 1}, ID: 0xa214},
-	0xa215: {Name: "ExposureIndex", Type: 5, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0xa215},
-	0xa216: {Name: "TIFF-EPStandardID", Type: 0, flags: 0, arrayLen: [2]int{-1, -1}, ID: 0xa216},
-	0xa217: {Name: "SensingMethod", Type: 3, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0xa217, enum: []int64{1, 2, 3, 4, 5, 7, 8}, enumString: []string{"Not defined", "One-chip color area", "Two-chip color area", "Three-chip color area", "Color sequential area", "Trilinear", "Color sequential linear"}},
-	0xa300: {Name: "FileSource", Type: 7, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0xa300, enum: []int64{1, 2, 3}, enumString: []string{"Film Scanner", "Reflection Print Scanner", "Digital Camera"}},
-	0xa301: {Name: "SceneType", Type: 7, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0xa301, enum: []int64{1}, enumString: []string{"Directly photographed"}},
-	0xa302: {Name: "CFAPattern", Type: 7, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0xa302},
-	0xa401: {Name: "CustomRendered", Type: 3, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0xa401, enum: []int64{0, 1, 2, 3, 4, 6, 7, 8}, enumString: []string{"Normal", "Custom", "HDR (no original saved)", "HDR (original saved)", "Original (for HDR)", "Panorama", "Portrait HDR", "Portrait"}},
-	0xa402: {Name: "ExposureMode", Type: 3, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0xa402, enum: []int64{0, 1, 2}, enumString: []string{"Auto", "Manual", "Auto bracket"}},
-	0xa403: {Name: "WhiteBalance", Type: 3, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0xa403, enum: []int64{0, 1}, enumString: []string{"Auto", "Manual"}},
-	0xa404: {Name: "DigitalZoomRatio", Type: 5, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0xa404},
-	0xa405: {Name: "FocalLengthIn35mmFormat", Type: 3, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0xa405},
-	0xa406: {Name: "SceneCaptureType", Type: 3, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0xa406, enum: []int64{0, 1, 2, 3, 4}, enumString: []string{"Standard", "Landscape", "Portrait", "Night", "Other"}},
-	0xa407: {Name: "GainControl", Type: 3, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0xa407, enum: []int64{0, 1, 2, 3, 4}, enumString: []string{"None", "Low gain up", "High gain up", "Low gain down", "High gain down"}},
-	0xa408: {Name: "Contrast", Type: 3, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0xa408, enum: []int64{0, 1, 2}, enumString: []string{"Normal", "Low", "High"}},
-	0xa409: {Name: "Saturation", Type: 3, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0xa409, enum: []int64{0, 1, 2}, enumString: []string{"Normal", "Low", "High"}},
-	0xa40a: {Name: "Sharpness", Type: 3, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0xa40a, enum: []int64{0, 1, 2}, enumString: []string{"Normal", "Soft", "Hard"}},
-	0xa40b: {Name: "DeviceSettingDescription", Type: 0, flags: 0, arrayLen: [2]int{-1, -1}, ID: 0xa40b},
-	0xa40c: {Name: "SubjectDistanceRange", Type: 3, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0xa40c, enum: []int64{0, 1, 2, 3}, enumString: []string{"Unknown", "Macro", "Close", "Distant"}},
-	0xa420: {Name: "ImageUniqueID", Type: 2, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0xa420},
-	0xa430: {Name: "OwnerName", Type: 2, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0xa430},
-	0xa431: {Name: "SerialNumber", Type: 2, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0xa431},
-	0xa432: {Name: "LensInfo", Type: 5, flags: 0, arrayLen: [2]int{4, 1}, ID: 0xa432},
-	0xa433: {Name: "LensMake", Type: 2, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0xa433},
-	0xa434: {Name: "LensModel", Type: 2, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0xa434},
-	0xa435: {Name: "LensSerialNumber", Type: 2, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0xa435},
-	0xa460: {Name: "CompositeImage", Type: 3, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0xa460, enum: []int64{0, 1, 2, 3}, enumString: []string{"Unknown", "Not a Composite Image", "General Composite Image", "Composite Image Captured While Shooting"}},
-	0xa461: {Name: "CompositeImageCount", Type: 3, flags: 0, arrayLen: [2]int{2, 1}, ID: 0xa461},
-	0xa462: {Name: "CompositeImageExposureTimes", Type: 7, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0xa462},
-	0xa480: {Name: "GDALMetadata", Type: 2, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0xa480},
-	0xa481: {Name: "GDALNoData", Type: 2, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0xa481},
-	0xa500: {Name: "Gamma", Type: 5, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0xa500},
-	0xafc0: {Name: "ExpandSoftware", Type: 0, flags: 0, arrayLen: [2]int{-1, -1}, ID: 0xafc0},
-	0xafc1: {Name: "ExpandLens", Type: 0, flags: 0, arrayLen: [2]int{-1, -1}, ID: 0xafc1},
-	0xafc2: {Name: "ExpandFilm", Type: 0, flags: 0, arrayLen: [2]int{-1, -1}, ID: 0xafc2},
-	0xafc3: {Name: "ExpandFilterLens", Type: 0, flags: 0, arrayLen: [2]int{-1, -1}, ID: 0xafc3},
-	0xafc4: {Name: "ExpandScanner", Type: 0, flags: 0, arrayLen: [2]int{-1, -1}, ID: 0xafc4},
-	0xafc5: {Name: "ExpandFlashLamp", Type: 0, flags: 0, arrayLen: [2]int{-1, -1}, ID: 0xafc5},
-	0xb4c3: {Name: "HasselbladRawImage", Type: 0, flags: 0, arrayLen: [2]int{-1, -1}, ID: 0xb4c3},
-	0xbc01: {Name: "PixelFormat", Type: 0, flags: 0, arrayLen: [2]int{-1, -1}, ID: 0xbc01, enum: []int64{5, 8, 9, 10, 11, 12, 13, 14, 15, 16, 17, 18, 19, 21, 22, 23, 24, 25, 26, 27, 28, 29, 30, 31, 32, 33, 34, 35, 36, 37, 38, 39, 40, 41, 42, 43, 44, 45, 46, 47, 48, 49, 50, 51, 52, 53, 54, 55, 56, 57, 58, 59, 61, 62, 63}, enumString: []string{"Black & White", "8-bit Gray", "16-bit BGR555", "16-bit BGR565", "16-bit Gray", "24-bit BGR", "24-bit RGB", "32-bit BGR", "32-bit BGRA", "32-bit PBGRA", "32-bit Gray Float", "48-bit RGB Fixed Point", "32-bit BGR101010", "48-bit RGB", "64-bit RGBA", "64-bit PRGBA", "96-bit RGB Fixed Point", "128-bit RGBA Float", "128-bit PRGBA Float", "128-bit RGB Float", "32-bit CMYK", "64-bit RGBA Fixed Point", "128-bit RGBA Fixed Point", "64-bit CMYK", "24-bit 3 Channels", "32-bit 4 Channels", "40-bit 5 Channels", "48-bit 6 Channels", "56-bit 7 Channels", "64-bit 8 Channels", "48-bit 3 Channels", "64-bit 4 Channels", "80-bit 5 Channels", "96-bit 6 Channels", "112-bit 7 Channels", "128-bit 8 Channels", "40-bit CMYK Alpha", "80-bit CMYK Alpha", "32-bit 3 Channels Alpha", "40-bit 4 Channels Alpha", "48-bit 5 Channels Alpha", "56-bit 6 Channels Alpha", "64-bit 7 Channels Alpha", "72-bit 8 Channels Alpha", "64-bit 3 Channels Alpha", "80-bit 4 Channels Alpha", "96-bit 5 Channels Alpha", "112-bit 6 Channels Alpha", "128-bit 7 Channels Alpha", "144-bit 8 Channels Alpha", "64-bit RGBA Half", "48-bit RGB Half", "32-bit RGBE", "16-bit Gray Half", "32-bit Gray Fixed Point"}},
-	0xbc02: {Name: "Transformation", Type: 0, flags: 0, arrayLen: [2]int{-1, -1}, ID: 0xbc02, enum: []int64{0, 1, 2, 3, 4, 5, 6, 7}, enumString: []string{"Horizontal (normal)", "Mirror vertical", "Mirror horizontal", "Rotate 180", "Rotate 90 CW", "Mirror horizontal and rotate 90 CW", "Mirror horizontal and rotate 270 CW", "Rotate 270 CW"}},
-	0xbc03: {Name: "Uncompressed", Type: 0, flags: 0, arrayLen: [2]int{-1, -1}, ID: 0xbc03, enum: []int64{0, 1}, enumString: []string{"No", "Yes"}},
-	0xbc04: {Name: "ImageType", Type: 0, flags: 0, arrayLen: [2]int{-1, -1}, ID: 0xbc04},
-	0xbc80: {Name: "ImageWidth", Type: 0, flags: 0, arrayLen: [2]int{-1, -1}, ID: 0xbc80},
-	0xbc81: {Name: "ImageHeight", Type: 0, flags: 0, arrayLen: [2]int{-1, -1}, ID: 0xbc81},
-	0xbc82: {Name: "WidthResolution", Type: 0, flags: 0, arrayLen: [2]int{-1, -1}, ID: 0xbc82},
-	0xbc83: {Name: "HeightResolution", Type: 0, flags: 0, arrayLen: [2]int{-1, -1}, ID: 0xbc83},
-	0xbcc0: {Name: "ImageOffset", Type: 0, flags: 0, arrayLen: [2]int{-1, -1}, ID: 0xbcc0},
-	0xbcc1: {Name: "ImageByteCount", Type: 0, flags: 0, arrayLen: [2]int{-1, -1}, ID: 0xbcc1},
-	0xbcc2: {Name: "AlphaOffset", Type: 0, flags: 0, arrayLen: [2]int{-1, -1}, ID: 0xbcc2},
-	0xbcc3: {Name: "AlphaByteCount", Type: 0, flags: 0, arrayLen: [2]int{-1, -1}, ID: 0xbcc3},
-	0xbcc4: {Name: "ImageDataDiscard", Type: 0, flags: 0, arrayLen: [2]int{-1, -1}, ID: 0xbcc4, enum: []int64{0, 1, 2, 3}, enumString: []string{"Full Resolution", "Flexbits Discarded", "HighPass Frequency Data Discarded", "Highpass and LowPass Frequency Data Discarded"}},
-	0xbcc5: {Name: "AlphaDataDiscard", Type: 0, flags: 0, arrayLen: [2]int{-1, -1}, ID: 0xbcc5, enum: []int64{0, 1, 2, 3}, enumString: []string{"Full Resolution", "Flexbits Discarded", "HighPass Frequency Data Discarded", "Highpass and LowPass Frequency Data Discarded"}},
-	0xc427: {Name: "OceScanjobDesc", Type: 0, flags: 0, arrayLen: [2]int{-1, -1}, ID: 0xc427},
-	0xc428: {Name: "OceApplicationSelector", Type: 0, flags: 0, arrayLen: [2]int{-1, -1}, ID: 0xc428},
-	0xc429: {Name: "OceIDNumber", Type: 0, flags: 0, arrayLen: [2]int{-1, -1}, ID: 0xc429},
-	0xc42a: {Name: "OceImageLogic", Type: 0, flags: 0, arrayLen: [2]int{-1, -1}, ID: 0xc42a},
-	0xc44f: {Name: "Annotations", Type: 0, flags: 0, arrayLen: [2]int{-1, -1}, ID: 0xc44f},
-	0xc4a5: {Name: "PrintIM", Type: 7, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0xc4a5},
-	0xc51b: {Name: "HasselbladExif", Type: 0, flags: 0, arrayLen: [2]int{-1, -1}, ID: 0xc51b},
-	0xc573: {Name: "OriginalFileName", Type: 0, flags: 0, arrayLen: [2]int{-1, -1}, ID: 0xc573},
-	0xc580: {Name: "USPTOOriginalContentType", Type: 0, flags: 0, arrayLen: [2]int{-1, -1}, ID: 0xc580, enum: []int64{0, 1, 2}, enumString: []string{"Text or Drawing", "Grayscale", "Color"}},
-	0xc5e0: {Name: "CR2CFAPattern", Type: 0, flags: 0, arrayLen: [2]int{-1, -1}, ID: 0xc5e0, enum: []int64{1, 4, 3, 2}, enumString: []string{"> '0 1 1 2' = [Red,Green][Green,Blue]", "> '1 0 2 1' = [Green,Red][Blue,Green]", "> '1 2 0 1' = [Green,Blue][Red,Green]", "> '2 1 1 0' = [Blue,Green][Green,Red]"}},
-	0xc612: {Name: "DNGVersion", Type: 1, flags: 2, arrayLen: [2]int{4, 1}, ID: 0xc612},
-	0xc613: {Name: "DNGBackwardVersion", Type: 1, flags: 2, arrayLen: [2]int{4, 1}, ID: 0xc613},
-	0xc614: {Name: "UniqueCameraModel", Type: 2, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0xc614},
-	0xc615: {Name: "LocalizedCameraModel", Type: 2, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0xc615},
-	0xc616: {Name: "CFAPlaneColor", Type: 0, flags: 0, arrayLen: [2]int{-1, -1}, ID: 0xc616},
-	0xc617: {Name: "CFALayout", Type: 0, flags: 0, arrayLen: [2]int{-1, -1}, ID: 0xc617, enum: []int64{1, 2, 3, 4, 5, 6, 7, 8, 9}, enumString: []string{"Rectangular", "Even columns offset down 1/2 row", "Even columns offset up 1/2 row", "Even rows offset right 1/2 column", "Even rows offset left 1/2 column", "Even rows offset up by 1/2 row, even columns offset left by 1/2 column", "Even rows offset up by 1/2 row, even columns offset right by 1/2 column", "Even rows offset down by 1/2 row, even columns offset left by 1/2 column", "Even rows offset down by 1/2 row, even columns offset right by 1/2 column"}},
-	0xc618: {Name: "LinearizationTable", Type: 3, flags: 2, arrayLen: [2]int{-1, 0}, ID: 0xc618},
-	0xc619: {Name: "BlackLevelRepeatDim", Type: 3, flags: 2, arrayLen: [2]int{2, 1}, ID: 0xc619},
-	0xc61a: {Name: "BlackLevel", Type: 5, flags: 2, arrayLen: [2]int{-1, 0}, ID: 0xc61a},
-	0xc61b: {Name: "BlackLevelDeltaH", Type: 10, flags: 2, arrayLen: [2]int{-1, 0}, ID: 0xc61b},
-	0xc61c: {Name: "BlackLevelDeltaV", Type: 10, flags: 2, arrayLen: [2]int{-1, 0}, ID: 0xc61c},
-	0xc61d: {Name: "WhiteLevel", Type: 4, flags: 2, arrayLen: [2]int{-1, 0}, ID: 0xc61d},
-	0xc61e: {Name: "DefaultScale", Type: 5, flags: 2, arrayLen: [2]int{2, 1}, ID: 0xc61e},
-	0xc61f: {Name: "DefaultCropOrigin", Type: 4, flags: 2, arrayLen: [2]int{2, 1}, ID: 0xc61f},
-	0xc620: {Name: "DefaultCropSize", Type: 4, flags: 2, arrayLen: [2]int{2, 1}, ID: 0xc620},
-	0xc621: {Name: "ColorMatrix1", Type: 10, flags: 2, arrayLen: [2]int{-1, 0}, ID: 0xc621},
-	0xc622: {Name: "ColorMatrix2", Type: 10, flags: 2, arrayLen: [2]int{-1, 0}, ID: 0xc622},
-	0xc623: {Name: "CameraCalibration1", Type: 10, flags: 2, arrayLen: [2]int{-1, 0}, ID: 0xc623},
-	0xc624: {Name: "CameraCalibration2", Type: 10, flags: 2, arrayLen: [2]int{-1, 0}, ID: 0xc624},
-	0xc625: {Name: "ReductionMatrix1", Type: 10, flags: 2, arrayLen: [2]int{-1, 0}, ID: 0xc625},
-	0xc626: {Name: "ReductionMatrix2", Type: 10, flags: 2, arrayLen: [2]int{-1, 0}, ID: 0xc626},
-	0xc627: {Name: "AnalogBalance", Type: 5, flags: 2, arrayLen: [2]int{-1, 0}, ID: 0xc627},
-	0xc628: {Name: "AsShotNeutral", Type: 5, flags: 2, arrayLen: [2]int{-1, 0}, ID: 0xc628},
-	0xc629: {Name: "AsShotWhiteXY", Type: 5, flags: 2, arrayLen: [2]int{2, 1}, ID: 0xc629},
-	0xc62a: {Name: "BaselineExposure", Type: 10, flags: 2, arrayLen: [2]int{-1, 1}, ID: 0xc62a},
-	0xc62b: {Name: "BaselineNoise", Type: 5, flags: 2, arrayLen: [2]int{-1, 1}, ID: 0xc62b},
-	0xc62c: {Name: "BaselineSharpness", Type: 5, flags: 2, arrayLen: [2]int{-1, 1}, ID: 0xc62c},
-	0xc62d: {Name: "BayerGreenSplit", Type: 4, flags: 2, arrayLen: [2]int{-1, 1}, ID: 0xc62d},
-	0xc62e: {Name: "LinearResponseLimit", Type: 5, flags: 2, arrayLen: [2]int{-1, 1}, ID: 0xc62e},
-	0xc62f: {Name: "CameraSerialNumber", Type: 2, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0xc62f},
-	0xc630: {Name: "DNGLensInfo", Type: 5, flags: 0, arrayLen: [2]int{4, 1}, ID: 0xc630},
-	0xc631: {Name: "ChromaBlurRadius", Type: 5, flags: 2, arrayLen: [2]int{-1, 1}, ID: 0xc631},
-	0xc632: {Name: "AntiAliasStrength", Type: 5, flags: 2, arrayLen: [2]int{-1, 1}, ID: 0xc632},
-	0xc633: {Name: "ShadowScale", Type: 5, flags: 2, arrayLen: [2]int{-1, 1}, ID: 0xc633},
-	0xc634: {Name: "SR2Private", Type: 0, flags: 0, arrayLen: [2]int{-1, -1}, ID: 0xc634},
-	0xc635: {Name: "MakerNoteSafety", Type: 3, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0xc635, enum: []int64{0, 1}, enumString: []string{"Unsafe", "Safe"}},
-	0xc640: {Name: "RawImageSegmentation", Type: 0, flags: 0, arrayLen: [2]int{-1, -1}, ID: 0xc640},
-	0xc65a: {Name: "CalibrationIlluminant1", Type: 3, flags: 2, arrayLen: [2]int{-1, 1}, ID: 0xc65a},
-	0xc65b: {Name: "CalibrationIlluminant2", Type: 3, flags: 2, arrayLen: [2]int{-1, 1}, ID: 0xc65b},
-	0xc65c: {Name: "BestQualityScale", Type: 5, flags: 2, arrayLen: [2]int{-1, 1}, ID: 0xc65c},
-	0xc65d: {Name: "RawDataUniqueID", Type: 1, flags: 2, arrayLen: [2]int{16, 1}, ID: 0xc65d},
-	0xc660: {Name: "AliasLayerMetadata", Type: 0, flags: 0, arrayLen: [2]int{-1, -1}, ID: 0xc660},
-	0xc68b: {Name: "OriginalRawFileName", Type: 2, flags: 2, arrayLen: [2]int{-1, 1}, ID: 0xc68b},
-	0xc68c: {Name: "OriginalRawFileData", Type: 7, flags: 2, arrayLen: [2]int{-1, 1}, ID: 0xc68c},
-	0xc68d: {Name: "ActiveArea", Type: 4, flags: 2, arrayLen: [2]int{4, 1}, ID: 0xc68d},
-	0xc68e: {Name: "MaskedAreas", Type: 4, flags: 2, arrayLen: [2]int{-1, 0}, ID: 0xc68e},
-	0xc68f: {Name: "AsShotICCProfile", Type: 7, flags: 2, arrayLen: [2]int{-1, 1}, ID: 0xc68f},
-	0xc690: {Name: "AsShotPreProfileMatrix", Type: 10, flags: 2, arrayLen: [2]int{-1, 0}, ID: 0xc690},
-	0xc691: {Name: "CurrentICCProfile", Type: 7, flags: 2, arrayLen: [2]int{-1, 1}, ID: 0xc691},
-	0xc692: {Name: "CurrentPreProfileMatrix", Type: 10, flags: 2, arrayLen: [2]int{-1, 0}, ID: 0xc692},
-	0xc6bf: {Name: "ColorimetricReference", Type: 3, flags: 2, arrayLen: [2]int{-1, 1}, ID: 0xc6bf},
-	0xc6c5: {Name: "SRawType", Type: 0, flags: 0, arrayLen: [2]int{-1, -1}, ID: 0xc6c5},
-	0xc6d2: {Name: "PanasonicTitle", Type: 7, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0xc6d2},
-	0xc6d3: {Name: "PanasonicTitle2", Type: 7, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0xc6d3},
-	0xc6f3: {Name: "CameraCalibrationSig", Type: 2, flags: 2, arrayLen: [2]int{-1, 1}, ID: 0xc6f3},
-	0xc6f4: {Name: "ProfileCalibrationSig", Type: 2, flags: 2, arrayLen: [2]int{-1, 1}, ID: 0xc6f4},
-	0xc6f5: {Name: "ProfileIFD", Type: 0, flags: 0, arrayLen: [2]int{-1, -1}, ID: 0xc6f5},
-	0xc6f6: {Name: "AsShotProfileName", Type: 2, flags: 2, arrayLen: [2]int{-1, 1}, ID: 0xc6f6},
-	0xc6f7: {Name: "NoiseReductionApplied", Type: 5, flags: 2, arrayLen: [2]int{-1, 1}, ID: 0xc6f7},
-	0xc6f8: {Name: "ProfileName", Type: 2, flags: 2, arrayLen: [2]int{-1, 1}, ID: 0xc6f8},
-	0xc6f9: {Name: "ProfileHueSatMapDims", Type: 4, flags: 2, arrayLen: [2]int{3, 1}, ID: 0xc6f9},
-	0xc6fa: {Name: "ProfileHueSatMapData1", Type: 11, flags: 2, arrayLen: [2]int{-1, 0}, ID: 0xc6fa},
-	0xc6fb: {Name: "ProfileHueSatMapData2", Type: 11, flags: 2, arrayLen: [2]int{-1, 0}, ID: 0xc6fb},
-	0xc6fc: {Name: "ProfileToneCurve", Type: 11, flags: 2, arrayLen: [2]int{-1, 0}, ID: 0xc6fc},
-	0xc6fd: {Name: "ProfileEmbedPolicy", Type: 4, flags: 2, arrayLen: [2]int{-1, 1}, ID: 0xc6fd, enum: []int64{0, 1, 2, 3}, enumString: []string{"Allow Copying", "Embed if Used", "Never Embed", "No Restrictions"}},
-	0xc6fe: {Name: "ProfileCopyright", Type: 2, flags: 2, arrayLen: [2]int{-1, 1}, ID: 0xc6fe},
-	0xc714: {Name: "ForwardMatrix1", Type: 10, flags: 2, arrayLen: [2]int{-1, 0}, ID: 0xc714},
-	0xc715: {Name: "ForwardMatrix2", Type: 10, flags: 2, arrayLen: [2]int{-1, 0}, ID: 0xc715},
-	0xc716: {Name: "PreviewApplicationName", Type: 2, flags: 2, arrayLen: [2]int{-1, 1}, ID: 0xc716},
-	0xc717: {Name: "PreviewApplicationVersion", Type: 2, flags: 2, arrayLen: [2]int{-1, 1}, ID: 0xc717},
-	0xc718: {Name: "PreviewSettingsName", Type: 2, flags: 2, arrayLen: [2]int{-1, 1}, ID: 0xc718},
-	0xc719: {Name: "PreviewSettingsDigest", Type: 1, flags: 2, arrayLen: [2]int{-1, 1}, ID: 0xc719},
-	0xc71a: {Name: "PreviewColorSpace", Type: 4, flags: 2, arrayLen: [2]int{-1, 1}, ID: 0xc71a, enum: []int64{0, 1, 2, 3, 4}, enumString: []string{"Unknown", "Gray Gamma 2.2", "sRGB", "Adobe RGB", "ProPhoto RGB"}},
-	0xc71b: {Name: "PreviewDateTime", Type: 2, flags: 2, arrayLen: [2]int{-1, 1}, ID: 0xc71b},
-	0xc71c: {Name: "RawImageDigest", Type: 1, flags: 2, arrayLen: [2]int{16, 1}, ID: 0xc71c},
-	0xc71d: {Name: "OriginalRawFileDigest", Type: 1, flags: 2, arrayLen: [2]int{16, 1}, ID: 0xc71d},
-	0xc71e: {Name: "SubTileBlockSize", Type: 0, flags: 0, arrayLen: [2]int{-1, -1}, ID: 0xc71e},
-	0xc71f: {Name: "RowInterleaveFactor", Type: 0, flags: 0, arrayLen: [2]int{-1, -1}, ID: 0xc71f},
-	0xc725: {Name: "ProfileLookTableDims", Type: 4, flags: 2, arrayLen: [2]int{3, 1}, ID: 0xc725},
-	0xc726: {Name: "ProfileLookTableData", Type: 11, flags: 2, arrayLen: [2]int{-1, 0}, ID: 0xc726},
-	0xc740: {Name: "OpcodeList1", Type: 7, flags: 12, arrayLen: [2]int{-1, 1}, ID: 0xc740, enum: []int64{1, 2, 3, 4, 5, 6, 7}, enumString: []string{"WarpRectilinear", "WarpFisheye", "FixVignetteRadial", "FixBadPixelsConstant", "FixBadPixelsList", "TrimBounds", "MapTable"}},
-	0xc741: {Name: "OpcodeList2", Type: 7, flags: 12, arrayLen: [2]int{-1, 1}, ID: 0xc741, enum: []int64{1, 2, 3, 4, 5, 6, 7}, enumString: []string{"WarpRectilinear", "WarpFisheye", "FixVignetteRadial", "FixBadPixelsConstant", "FixBadPixelsList", "TrimBounds", "MapTable"}},
-	0xc74e: {Name: "OpcodeList3", Type: 7, flags: 12, arrayLen: [2]int{-1, 1}, ID: 0xc74e, enum: []int64{1, 2, 3, 4, 5, 6, 7}, enumString: []string{"WarpRectilinear", "WarpFisheye", "FixVignetteRadial", "FixBadPixelsConstant", "FixBadPixelsList", "TrimBounds", "MapTable"}},
-	0xc761: {Name: "NoiseProfile", Type: 12, flags: 2, arrayLen: [2]int{-1, 0}, ID: 0xc761},
-	0xc763: {Name: "TimeCodes", Type: 1, flags: 0, arrayLen: [2]int{-1, 0}, ID: 0xc763},
-	0xc764: {Name: "FrameRate", Type: 10, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0xc764},
-	0xc772: {Name: "TStop", Type: 5, flags: 0, arrayLen: [2]int{-1, 0}, ID: 0xc772},
-	0xc789: {Name: "ReelName", Type: 2, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0xc789},
-	0xc791: {Name: "OriginalDefaultFinalSize", Type: 4, flags: 2, arrayLen: [2]int{2, 1}, ID: 0xc791},
-	0xc792: {Name: "OriginalBestQualitySize", Type: 4, flags: 2, arrayLen: [2]int{2, 1}, ID: 0xc792},
-	0xc793: {Name: "OriginalDefaultCropSize", Type: 5, flags: 2, arrayLen: [2]int{2, 1}, ID: 0xc793},
-	0xc7a1: {Name: "CameraLabel", Type: 2, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0xc7a1},
-	0xc7a3: {Name: "ProfileHueSatMapEncoding", Type: 4, flags: 2, arrayLen: [2]int{-1, 1}, ID: 0xc7a3, enum: []int64{0, 1}, enumString: []string{"Linear", "sRGB"}},
-	0xc7a4: {Name: "ProfileLookTableEncoding", Type: 4, flags: 2, arrayLen: [2]int{-1, 1}, ID: 0xc7a4, enum: []int64{0, 1}, enumString: []string{"Linear", "sRGB"}},
-	0xc7a5: {Name: "BaselineExposureOffset", Type: 10, flags: 2, arrayLen: [2]int{-1, 1}, ID: 0xc7a5},
-	0xc7a6: {Name: "DefaultBlackRender", Type: 4, flags: 2, arrayLen: [2]int{-1, 1}, ID: 0xc7a6, enum: []int64{0, 1}, enumString: []string{"Auto", "None"}},
-	0xc7a7: {Name: "NewRawImageDigest", Type: 1, flags: 2, arrayLen: [2]int{16, 1}, ID: 0xc7a7},
-	0xc7a8: {Name: "RawToPreviewGain", Type: 12, flags: 2, arrayLen: [2]int{-1, 1}, ID: 0xc7a8},
-	0xc7aa: {Name: "CacheVersion", Type: 4, flags: 2, arrayLen: [2]int{-1, 1}, ID: 0xc7aa},
-	0xc7b5: {Name: "DefaultUserCrop", Type: 5, flags: 2, arrayLen: [2]int{4, 1}, ID: 0xc7b5},
-	0xc7d5: {Name: "NikonNEFInfo", Type: 0, flags: 0, arrayLen: [2]int{-1, -1}, ID: 0xc7d5},
-	0xc7e9: {Name: "DepthFormat", Type: 3, flags: 2, arrayLen: [2]int{-1, 1}, ID: 0xc7e9, enum: []int64{0, 1, 2}, enumString: []string{"Unknown", "Linear", "Inverse"}},
-	0xc7ea: {Name: "DepthNear", Type: 5, flags: 2, arrayLen: [2]int{-1, 1}, ID: 0xc7ea},
-	0xc7eb: {Name: "DepthFar", Type: 5, flags: 2, arrayLen: [2]int{-1, 1}, ID: 0xc7eb},
-	0xc7ec: {Name: "DepthUnits", Type: 3, flags: 2, arrayLen: [2]int{-1, 1}, ID: 0xc7ec, enum: []int64{0, 1}, enumString: []string{"Unknown", "Meters"}},
-	0xc7ed: {Name: "DepthMeasureType", Type: 3, flags: 2, arrayLen: [2]int{-1, 1}, ID: 0xc7ed, enum: []int64{0, 1, 2}, enumString: []string{"Unknown", "Optical Axis", "Optical Ray"}},
-	0xc7ee: {Name: "EnhanceParams", Type: 2, flags: 2, arrayLen: [2]int{-1, 1}, ID: 0xc7ee},
-	0xcd2d: {Name: "ProfileGainTableMap", Type: 7, flags: 2, arrayLen: [2]int{-1, 1}, ID: 0xcd2d},
-	0xcd2e: {Name: "SemanticName", Type: 0, flags: 0, arrayLen: [2]int{-1, -1}, ID: 0xcd2e},
-	0xcd30: {Name: "SemanticInstanceID", Type: 0, flags: 0, arrayLen: [2]int{-1, -1}, ID: 0xcd30},
-	0xcd31: {Name: "CalibrationIlluminant3", Type: 3, flags: 2, arrayLen: [2]int{-1, 1}, ID: 0xcd31},
-	0xcd32: {Name: "CameraCalibration3", Type: 10, flags: 2, arrayLen: [2]int{-1, 0}, ID: 0xcd32},
-	0xcd33: {Name: "ColorMatrix3", Type: 10, flags: 2, arrayLen: [2]int{-1, 0}, ID: 0xcd33},
-	0xcd34: {Name: "ForwardMatrix3", Type: 10, flags: 2, arrayLen: [2]int{-1, 0}, ID: 0xcd34},
-	0xcd35: {Name: "IlluminantData1", Type: 7, flags: 2, arrayLen: [2]int{-1, 1}, ID: 0xcd35},
-	0xcd36: {Name: "IlluminantData2", Type: 7, flags: 2, arrayLen: [2]int{-1, 1}, ID: 0xcd36},
-	0xcd37: {Name: "IlluminantData3", Type: 7, flags: 2, arrayLen: [2]int{-1, 1}, ID: 0xcd37},
-	0xcd38: {Name: "MaskSubArea", Type: 0, flags: 0, arrayLen: [2]int{-1, -1}, ID: 0xcd38},
-	0xcd39: {Name: "ProfileHueSatMapData3", Type: 11, flags: 2, arrayLen: [2]int{-1, 0}, ID: 0xcd39},
-	0xcd3a: {Name: "ReductionMatrix3", Type: 10, flags: 2, arrayLen: [2]int{-1, 0}, ID: 0xcd3a},
-	0xcd3b: {Name: "RGBTables", Type: 7, flags: 2, arrayLen: [2]int{-1, 1}, ID: 0xcd3b},
-	0xea1c: {Name: "Padding", Type: 7, flags: 2, arrayLen: [2]int{-1, 1}, ID: 0xea1c},
-	0xea1d: {Name: "OffsetSchema", Type: 9, flags: 2, arrayLen: [2]int{-1, 1}, ID: 0xea1d},
-	0xfde8: {Name: "OwnerName", Type: 2, flags: 8, arrayLen: [2]int{-1, 1}, ID: 0xfde8},
-	0xfde9: {Name: "SerialNumber", Type: 2, flags: 8, arrayLen: [2]int{-1, 1}, ID: 0xfde9},
-	0xfdea: {Name: "Lens", Type: 2, flags: 8, arrayLen: [2]int{-1, 1}, ID: 0xfdea},
-	0xfe00: {Name: "KDC_IFD", Type: 0, flags: 0, arrayLen: [2]int{-1, -1}, ID: 0xfe00},
-	0xfe4c: {Name: "RawFile", Type: 2, flags: 8, arrayLen: [2]int{-1, 1}, ID: 0xfe4c},
-	0xfe4d: {Name: "Converter", Type: 2, flags: 8, arrayLen: [2]int{-1, 1}, ID: 0xfe4d},
-	0xfe4e: {Name: "WhiteBalance", Type: 2, flags: 8, arrayLen: [2]int{-1, 1}, ID: 0xfe4e},
-	0xfe51: {Name: "Exposure", Type: 2, flags: 8, arrayLen: [2]int{-1, 1}, ID: 0xfe51},
-	0xfe52: {Name: "Shadows", Type: 2, flags: 8, arrayLen: [2]int{-1, 1}, ID: 0xfe52},
-	0xfe53: {Name: "Brightness", Type: 2, flags: 8, arrayLen: [2]int{-1, 1}, ID: 0xfe53},
-	0xfe54: {Name: "Contrast", Type: 2, flags: 8, arrayLen: [2]int{-1, 1}, ID: 0xfe54},
-	0xfe55: {Name: "Saturation", Type: 2, flags: 8, arrayLen: [2]int{-1, 1}, ID: 0xfe55},
-	0xfe56: {Name: "Sharpness", Type: 2, flags: 8, arrayLen: [2]int{-1, 1}, ID: 0xfe56},
-	0xfe57: {Name: "Smoothness", Type: 2, flags: 8, arrayLen: [2]int{-1, 1}, ID: 0xfe57},
+var tags = map[tagkey]tagdef{
+	{GroupNone, 0x0001}: {Name: "InteropIndex", Type: 2, flags: 2, arrayLen: [2]int{-1, 1}, ID: 0x0001},
+	{GroupNone, 0x0002}: {Name: "InteropVersion", Type: 7, flags: 3, arrayLen: [2]int{-1, 1}, ID: 0x0002},
+	{GroupNone, 0x000b}: {Name: "ProcessingSoftware", Type: 2, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x000b},
+	{GroupNone, 0x00fe}: {Name: "SubfileType", Type: 4, flags: 2, arrayLen: [2]int{-1, 1}, ID: 0x00fe, enum: []int64{0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 16, 65537, 65540, 4294967295}, enumString: []string{"Full-resolution image", "Reduced-resolution image", "Single page of multi-page image", "Single page of multi-page reduced-resolution image", "Transparency mask", "Transparency mask of reduced-resolution image", "Transparency mask of multi-page image", "Transparency mask of reduced-resolution multi-page image", "Depth map", "Depth map of reduced-resolution image", "Enhanced image data", "Alternate reduced-resolution image", "Semantic Mask", "invalid"}},
+	{GroupNone, 0x00ff}: {Name: "OldSubfileType", Type: 3, flags: 2, arrayLen: [2]int{-1, 1}, ID: 0x00ff, enum: []int64{1, 2, 3}, enumString: []string{"Full-resolution image", "Reduced-resolution image", "Single page of multi-page image"}},
+	{GroupNone, 0x0100}: {Name: "ImageWidth", Type: 4, flags: 2, arrayLen: [2]int{-1, 1}, ID: 0x0100},
+	{GroupNone, 0x0101}: {Name: "ImageHeight", Type: 4, flags: 2, arrayLen: [2]int{-1, 1}, ID: 0x0101},
+	{GroupNone, 0x0102}: {Name: "BitsPerSample", Type: 3, flags: 2, arrayLen: [2]int{-1, 0}, ID: 0x0102},
+	{GroupNone, 0x0103}: {Name: "Compression", Type: 3, flags: 3, arrayLen: [2]int{-1, 1}, ID: 0x0103},
+	{GroupNone, 0x0106}: {Name: "PhotometricInterpretation", Type: 3, flags: 2, arrayLen: [2]int{-1, 1}, ID: 0x0106, enum: []int64{0, 1, 2, 3, 4, 5, 6, 8, 9, 10, 32803, 32844, 32845, 32892, 34892, 51177, 52527}, enumString: []string{"WhiteIsZero", "BlackIsZero", "RGB", "RGB Palette", "Transparency Mask", "CMYK", "YCbCr", "CIELab", "ICCLab", "ITULab", "Color Filter Array", "Pixar LogL", "Pixar LogLuv", "Sequential Color Filter", "Linear Raw", "Depth Map", "Semantic Mask"}},
+	{GroupNone, 0x0107}: {Name: "Thresholding", Type: 3, flags: 2, arrayLen: [2]int{-1, 1}, ID: 0x0107, enum: []int64{1, 2, 3}, enumString: []string{"No dithering or halftoning", "Ordered dither or halftone", "Randomized dither"}},
+	{GroupNone, 0x0108}: {Name: "CellWidth", Type: 3, flags: 2, arrayLen: [2]int{-1, 1}, ID: 0x0108},
+	{GroupNone, 0x0109}: {Name: "CellLength", Type: 3, flags: 2, arrayLen: [2]int{-1, 1}, ID: 0x0109},
+	{GroupNone, 0x010a}: {Name: "FillOrder", Type: 3, flags: 2, arrayLen: [2]int{-1, 1}, ID: 0x010a, enum: []int64{1, 2}, enumString: []string{"Normal", "Reversed"}},
+	{GroupNone, 0x010d}: {Name: "DocumentName", Type: 2, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x010d},
+	{GroupNone, 0x010e}: {Name: "ImageDescription", Type: 2, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x010e},
+	{GroupNone, 0x010f}: {Name: "Make", Type: 2, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x010f},
+	{GroupNone, 0x0110}: {Name: "Model", Type: 2, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x0110},
+	{GroupNone, 0x0111}: {Name: "StripOffsets", Type: 0, flags: 0, arrayLen: [2]int{-1, -1}, ID: 0x0111},
+	{GroupNone, 0x0112}: {Name: "Orientation", Type: 3, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x0112, enum: []int64{1, 2, 3, 4, 5, 6, 7, 8}, enumString: []string{"Horizontal (normal)", "Mirror horizontal", "Rotate 180", "Mirror vertical", "Mirror horizontal and rotate 270 CW", "Rotate 90 CW", "Mirror horizontal and rotate 90 CW", "Rotate 270 CW"}},
+	{GroupNone, 0x0115}: {Name: "SamplesPerPixel", Type: 3, flags: 2, arrayLen: [2]int{-1, 1}, ID: 0x0115},
+	{GroupNone, 0x0116}: {Name: "RowsPerStrip", Type: 4, flags: 2, arrayLen: [2]int{-1, 1}, ID: 0x0116},
+	{GroupNone, 0x0117}: {Name: "StripByteCounts", Type: 0, flags: 0, arrayLen: [2]int{-1, -1}, ID: 0x0117},
+	{GroupNone, 0x0118}: {Name: "MinSampleValue", Type: 3, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x0118},
+	{GroupNone, 0x0119}: {Name: "MaxSampleValue", Type: 3, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x0119},
+	{GroupNone, 0x011a}: {Name: "XResolution", Type: 5, flags: 1, arrayLen: [2]int{-1, 1}, ID: 0x011a},
+	{GroupNone, 0x011b}: {Name: "YResolution", Type: 5, flags: 1, arrayLen: [2]int{-1, 1}, ID: 0x011b},
+	{GroupNone, 0x011c}: {Name: "PlanarConfiguration", Type: 3, flags: 2, arrayLen: [2]int{-1, 1}, ID: 0x011c, enum: []int64{1, 2}, enumString: []string{"Chunky", "Planar"}},
+	{GroupNone, 0x011d}: {Name: "PageName", Type: 2, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x011d},
+	{GroupNone, 0x011e}: {Name: "XPosition", Type: 5, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x011e},
+	{GroupNone, 0x011f}: {Name: "YPosition", Type: 5, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x011f},
+	{GroupNone, 0x0120}: {Name: "FreeOffsets", Type: 0, flags: 0, arrayLen: [2]int{-1, -1}, ID: 0x0120},
+	{GroupNone, 0x0121}: {Name: "FreeByteCounts", Type: 0, flags: 0, arrayLen: [2]int{-1, -1}, ID: 0x0121},
+	{GroupNone, 0x0122}: {Name: "GrayResponseUnit", Type: 3, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x0122, enum: []int64{1, 2, 3, 4, 5}, enumString: []string{"0.1", "0.001", "0.0001", "1e-05", "1e-06"}},
+	{GroupNone, 0x0123}: {Name: "GrayResponseCurve", Type: 0, flags: 0, arrayLen: [2]int{-1, -1}, ID: 0x0123},
+	{GroupNone, 0x0124}: {Name: "T4Options", Type: 0, flags: 0, arrayLen: [2]int{-1, -1}, ID: 0x0124},
+	{GroupNone, 0x0125}: {Name: "T6Options", Type: 0, flags: 0, arrayLen: [2]int{-1, -1}, ID: 0x0125},
+	{GroupNone, 0x0128}: {Name: "ResolutionUnit", Type: 3, flags: 1, arrayLen: [2]int{-1, 1}, ID: 0x0128, enum: []int64{1, 2, 3}, enumString: []string{"None", "inches", "cm"}},
+	{GroupNone, 0x0129}: {Name: "PageNumber", Type: 3, flags: 0, arrayLen: [2]int{2, 1}, ID: 0x0129},
+	{GroupNone, 0x012c}: {Name: "ColorResponseUnit", Type: 0, flags: 0, arrayLen: [2]int{-1, -1}, ID: 0x012c},
+	{GroupNone, 0x012d}: {Name: "TransferFunction", Type: 3, flags: 2, arrayLen: [2]int{768, 1}, ID: 0x012d},
+	{GroupNone, 0x0131}: {Name: "Software", Type: 2, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x0131},
+	{GroupNone, 0x0132}: {Name: "ModifyDate", Type: 2, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x0132},
+	{GroupNone, 0x013b}: {Name: "Artist", Type: 2, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x013b},
+	{GroupNone, 0x013c}: {Name: "HostComputer", Type: 2, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x013c},
+	{GroupNone, 0x013d}: {Name: "Predictor", Type: 3, flags: 2, arrayLen: [2]int{-1, 1}, ID: 0x013d, enum: []int64{1, 2, 3, 34892, 34893, 34894, 34895}, enumString: []string{"None", "Horizontal differencing", "Floating point", "Horizontal difference X2", "Horizontal difference X4", "Floating point X2", "Floating point X4"}},
+	{GroupNone, 0x013e}: {Name: "WhitePoint", Type: 5, flags: 0, arrayLen: [2]int{2, 1}, ID: 0x013e},
+	{GroupNone, 0x013f}: {Name: "PrimaryChromaticities", Type: 5, flags: 0, arrayLen: [2]int{6, 1}, ID: 0x013f},
+	{GroupNone, 0x0140}: {Name: "ColorMap", Type: 0, flags: 0, arrayLen: [2]int{-1, -1}, ID: 0x0140},
+	{GroupNone, 0x0141}: {Name: "HalftoneHints", Type: 3, flags: 0, arrayLen: [2]int{2, 1}, ID: 0x0141},
+	{GroupNone, 0x0142}: {Name: "TileWidth", Type: 4, flags: 2, arrayLen: [2]int{-1, 1}, ID: 0x0142},
+	{GroupNone, 0x0143}: {Name: "TileLength", Type: 4, flags: 2, arrayLen: [2]int{-1, 1}, ID: 0x0143},
+	{GroupNone, 0x0144}: {Name: "TileOffsets", Type: 0, flags: 0, arrayLen: [2]int{-1, -1}, ID: 0x0144},
+	{GroupNone, 0x0145}: {Name: "TileByteCounts", Type: 0, flags: 0, arrayLen: [2]int{-1, -1}, ID: 0x0145},
+	{GroupNone, 0x0146}: {Name: "BadFaxLines", Type: 0, flags: 0, arrayLen: [2]int{-1, -1}, ID: 0x0146},
+	{GroupNone, 0x0147}: {Name: "CleanFaxData", Type: 0, flags: 0, arrayLen: [2]int{-1, -1}, ID: 0x0147, enum: []int64{0, 1, 2}, enumString: []string{"Clean", "Regenerated", "Unclean"}},
+	{GroupNone, 0x0148}: {Name: "ConsecutiveBadFaxLines", Type: 0, flags: 0, arrayLen: [2]int{-1, -1}, ID: 0x0148},
+	{GroupNone, 0x014a}: {Name: "SubIFD", Type: 0, flags: 0, arrayLen: [2]int{-1, -1}, ID: 0x014a},
+	{GroupNone, 0x014c}: {Name: "InkSet", Type: 3, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x014c, enum: []int64{1, 2}, enumString: []string{"CMYK", "Not CMYK"}},
+	{GroupNone, 0x014d}: {Name: "InkNames", Type: 0, flags: 0, arrayLen: [2]int{-1, -1}, ID: 0x014d},
+	{GroupNone, 0x014e}: {Name: "NumberofInks", Type: 0, flags: 0, arrayLen: [2]int{-1, -1}, ID: 0x014e},
+	{GroupNone, 0x0150}: {Name: "DotRange", Type: 0, flags: 0, arrayLen: [2]int{-1, -1}, ID: 0x0150},
+	{GroupNone, 0x0151}: {Name: "TargetPrinter", Type: 2, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x0151},
+	{GroupNone, 0x0152}: {Name: "ExtraSamples", Type: 0, flags: 0, arrayLen: [2]int{-1, -1}, ID: 0x0152, enum: []int64{0, 1, 2}, enumString: []string{"Unspecified", "Associated Alpha", "Unassociated Alpha"}},
+	{GroupNone, 0x0153}: {Name: "SampleFormat", Type: 0, flags: 0, arrayLen: [2]int{-1, -1}, ID: 0x0153, enum: []int64{1, 2, 3}, enumString: []string{"Unsigned", "Signed", "Float"}},
+	{GroupNone, 0x0154}: {Name: "SMinSampleValue", Type: 0, flags: 0, arrayLen: [2]int{-1, -1}, ID: 0x0154},
+	{GroupNone, 0x0155}: {Name: "SMaxSampleValue", Type: 0, flags: 0, arrayLen: [2]int{-1, -1}, ID: 0x0155},
+	{GroupNone, 0x0156}: {Name: "TransferRange", Type: 0, flags: 0, arrayLen: [2]int{-1, -1}, ID: 0x0156},
+	{GroupNone, 0x0157}: {Name: "ClipPath", Type: 0, flags: 0, arrayLen: [2]int{-1, -1}, ID: 0x0157},
+	{GroupNone, 0x0158}: {Name: "XClipPathUnits", Type: 0, flags: 0, arrayLen: [2]int{-1, -1}, ID: 0x0158},
+	{GroupNone, 0x0159}: {Name: "YClipPathUnits", Type: 0, flags: 0, arrayLen: [2]int{-1, -1}, ID: 0x0159},
+	{GroupNone, 0x015a}: {Name: "Indexed", Type: 0, flags: 0, arrayLen: [2]int{-1, -1}, ID: 0x015a, enum: []int64{0, 1}, enumString: []string{"Not indexed", "Indexed"}},
+	{GroupNone, 0x015b}: {Name: "JPEGTables", Type: 0, flags: 0, arrayLen: [2]int{-1, -1}, ID: 0x015b},
+	{GroupNone, 0x015f}: {Name: "OPIProxy", Type: 0, flags: 0, arrayLen: [2]int{-1, -1}, ID: 0x015f, enum: []int64{0, 1}, enumString: []string{"Higher resolution image does not exist", "Higher resolution image exists"}},
+	{GroupNone, 0x0190}: {Name: "GlobalParametersIFD", Type: 0, flags: 0, arrayLen: [2]int{-1, -1}, ID: 0x0190},
+	{GroupNone, 0x0191}: {Name: "ProfileType", Type: 0, flags: 0, arrayLen: [2]int{-1, -1}, ID: 0x0191, enum: []int64{0, 1}, enumString: []string{"Unspecified", "Group 3 FAX"}},
+	{GroupNone, 0x0192}: {Name: "FaxProfile", Type: 0, flags: 0, arrayLen: [2]int{-1, -1}, ID: 0x0192, enum: []int64{0, 1, 2, 3, 4, 5, 6, 7, 255}, enumString: []string{"Unknown", "Minimal B&W lossless, S", "Extended B&W lossless, F", "Lossless JBIG B&W, J", "Lossy color and grayscale, C", "Lossless color and grayscale, L", "Mixed raster content, M", "Profile T", "Multi Profiles"}},
+	{GroupNone, 0x0193}: {Name: "CodingMethods", Type: 0, flags: 0, arrayLen: [2]int{-1, -1}, ID: 0x0193},
+	{GroupNone, 0x0194}: {Name: "VersionYear", Type: 0, flags: 0, arrayLen: [2]int{-1, -1}, ID: 0x0194},
+	{GroupNone, 0x0195}: {Name: "ModeNumber", Type: 0, flags: 0, arrayLen: [2]int{-1, -1}, ID: 0x0195},
+	{GroupNone, 0x01b1}: {Name: "Decode", Type: 0, flags: 0, arrayLen: [2]int{-1, -1}, ID: 0x01b1},
+	{GroupNone, 0x01b2}: {Name: "DefaultImageColor", Type: 0, flags: 0, arrayLen: [2]int{-1, -1}, ID: 0x01b2},
+	{GroupNone, 0x01b3}: {Name: "T82Options", Type: 0, flags: 0, arrayLen: [2]int{-1, -1}, ID: 0x01b3},
+	{GroupNone, 0x01b5}: {Name: "JPEGTables", Type: 0, flags: 0, arrayLen: [2]int{-1, -1}, ID: 0x01b5},
+	{GroupNone, 0x0200}: {Name: "JPEGProc", Type: 0, flags: 0, arrayLen: [2]int{-1, -1}, ID: 0x0200, enum: []int64{1, 14}, enumString: []string{"Baseline", "Lossless"}},
+	{GroupNone, 0x0201}: {Name: "ThumbnailOffset", Type: 4, flags: 4, arrayLen: [2]int{-1, 1}, ID: 0x0201},
+	{GroupNone, 0x0202}: {Name: "ThumbnailLength", Type: 4, flags: 4, arrayLen: [2]int{-1, 1}, ID: 0x0202},
+	{GroupNone, 0x0203}: {Name: "JPEGRestartInterval", Type: 0, flags: 0, arrayLen: [2]int{-1, -1}, ID: 0x0203},
+	{GroupNone, 0x0205}: {Name: "JPEGLosslessPredictors", Type: 0, flags: 0, arrayLen: [2]int{-1, -1}, ID: 0x0205},
+	{GroupNone, 0x0206}: {Name: "JPEGPointTransforms", Type: 0, flags: 0, arrayLen: [2]int{-1, -1}, ID: 0x0206},
+	{GroupNone, 0x0207}: {Name: "JPEGQTables", Type: 0, flags: 0, arrayLen: [2]int{-1, -1}, ID: 0x0207},
+	{GroupNone, 0x0208}: {Name: "JPEGDCTables", Type: 0, flags: 0, arrayLen: [2]int{-1, -1}, ID: 0x0208},
+	{GroupNone, 0x0209}: {Name: "JPEGACTables", Type: 0, flags: 0, arrayLen: [2]int{-1, -1}, ID: 0x0209},
+	{GroupNone, 0x0211}: {Name: "YCbCrCoefficients", Type: 5, flags: 2, arrayLen: [2]int{3, 1}, ID: 0x0211},
+	{GroupNone, 0x0212}: {Name: "YCbCrSubSampling", Type: 3, flags: 2, arrayLen: [2]int{2, 1}, ID: 0x0212},
+	{GroupNone, 0x0213}: {Name: "YCbCrPositioning", Type: 3, flags: 3, arrayLen: [2]int{-1, 1}, ID: 0x0213, enum: []int64{1, 2}, enumString: []string{"Centered", "Co-sited"}},
+	{GroupNone, 0x0214}: {Name: "ReferenceBlackWhite", Type: 5, flags: 0, arrayLen: [2]int{6, 1}, ID: 0x0214},
+	{GroupNone, 0x022f}: {Name: "StripRowCounts", Type: 0, flags: 0, arrayLen: [2]int{-1, -1}, ID: 0x022f},
+	{GroupNone, 0x02bc}: {Name: "ApplicationNotes", Type: 1, flags: 2, arrayLen: [2]int{-1, 1}, ID: 0x02bc},
+	{GroupNone, 0x03e7}: {Name: "USPTOMiscellaneous", Type: 0, flags: 0, arrayLen: [2]int{-1, -1}, ID: 0x03e7},
+	{GroupNone, 0x1000}: {Name: "RelatedImageFileFormat", Type: 2, flags: 2, arrayLen: [2]int{-1, 1}, ID: 0x1000},
+	{GroupNone, 0x1001}: {Name: "RelatedImageWidth", Type: 3, flags: 2, arrayLen: [2]int{-1, 1}, ID: 0x1001},
+	{GroupNone, 0x1002}: {Name: "RelatedImageHeight", Type: 3, flags: 2, arrayLen: [2]int{-1, 1}, ID: 0x1002},
+	{GroupNone, 0x4746}: {Name: "Rating", Type: 3, flags: 8, arrayLen: [2]int{-1, 1}, ID: 0x4746},
+	{GroupNone, 0x4747}: {Name: "XP_DIP_XML", Type: 0, flags: 0, arrayLen: [2]int{-1, -1}, ID: 0x4747},
+	{GroupNone, 0x4748}: {Name: "StitchInfo", Type: 0, flags: 0, arrayLen: [2]int{-1, -1}, ID: 0x4748},
+	{GroupNone, 0x4749}: {Name: "RatingPercent", Type: 3, flags: 8, arrayLen: [2]int{-1, 1}, ID: 0x4749},
+	{GroupNone, 0x7000}: {Name: "SonyRawFileType", Type: 0, flags: 0, arrayLen: [2]int{-1, -1}, ID: 0x7000, enum: []int64{0, 1, 2, 3, 4}, enumString: []string{"Sony Uncompressed 14-bit RAW", "Sony Uncompressed 12-bit RAW", "Sony Compressed RAW", "Sony Lossless Compressed RAW", "Sony Lossless Compressed RAW 2"}},
+	{GroupNone, 0x7010}: {Name: "SonyToneCurve", Type: 0, flags: 0, arrayLen: [2]int{-1, -1}, ID: 0x7010},
+	{GroupNone, 0x7031}: {Name: "VignettingCorrection", Type: 8, flags: 2, arrayLen: [2]int{-1, 1}, ID: 0x7031, enum: []int64{256, 257, 272, 511}, enumString: []string{"Off", "Auto", "Auto (ILCE-1)", "No correction params available"}},
+	{GroupNone, 0x7032}: {Name: "VignettingCorrParams", Type: 8, flags: 2, arrayLen: [2]int{17, 1}, ID: 0x7032},
+	{GroupNone, 0x7034}: {Name: "ChromaticAberrationCorrection", Type: 8, flags: 2, arrayLen: [2]int{-1, 1}, ID: 0x7034, enum: []int64{0, 1, 255}, enumString: []string{"Off", "Auto", "No correction params available"}},
+	{GroupNone, 0x7035}: {Name: "ChromaticAberrationCorrParams", Type: 8, flags: 2, arrayLen: [2]int{33, 1}, ID: 0x7035},
+	{GroupNone, 0x7036}: {Name: "DistortionCorrection", Type: 8, flags: 2, arrayLen: [2]int{-1, 1}, ID: 0x7036, enum: []int64{0, 1, 17, 255}, enumString: []string{"Off", "Auto", "Auto fixed by lens", "No correction params available"}},
+	{GroupNone, 0x7037}: {Name: "DistortionCorrParams", Type: 8, flags: 2, arrayLen: [2]int{17, 1}, ID: 0x7037},
+	{GroupNone, 0x74c7}: {Name: "SonyCropTopLeft", Type: 4, flags: 2, arrayLen: [2]int{2, 1}, ID: 0x74c7},
+	{GroupNone, 0x74c8}: {Name: "SonyCropSize", Type: 4, flags: 2, arrayLen: [2]int{2, 1}, ID: 0x74c8},
+	{GroupNone, 0x800d}: {Name: "ImageID", Type: 0, flags: 0, arrayLen: [2]int{-1, -1}, ID: 0x800d},
+	{GroupNone, 0x80a3}: {Name: "WangTag1", Type: 0, flags: 0, arrayLen: [2]int{-1, -1}, ID: 0x80a3},
+	{GroupNone, 0x80a4}: {Name: "WangAnnotation", Type: 0, flags: 0, arrayLen: [2]int{-1, -1}, ID: 0x80a4},
+	{GroupNone, 0x80a5}: {Name: "WangTag3", Type: 0, flags: 0, arrayLen: [2]int{-1, -1}, ID: 0x80a5},
+	{GroupNone, 0x80a6}: {Name: "WangTag4", Type: 0, flags: 0, arrayLen: [2]int{-1, -1}, ID: 0x80a6},
+	{GroupNone, 0x80b9}: {Name: "ImageReferencePoints", Type: 0, flags: 0, arrayLen: [2]int{-1, -1}, ID: 0x80b9},
+	{GroupNone, 0x80ba}: {Name: "RegionXformTackPoint", Type: 0, flags: 0, arrayLen: [2]int{-1, -1}, ID: 0x80ba},
+	{GroupNone, 0x80bb}: {Name: "WarpQuadrilateral", Type: 0, flags: 0, arrayLen: [2]int{-1, -1}, ID: 0x80bb},
+	{GroupNone, 0x80bc}: {Name: "AffineTransformMat", Type: 0, flags: 0, arrayLen: [2]int{-1, -1}, ID: 0x80bc},
+	{GroupNone, 0x80e3}: {Name: "Matteing", Type: 0, flags: 0, arrayLen: [2]int{-1, -1}, ID: 0x80e3},
+	{GroupNone, 0x80e4}: {Name: "DataType", Type: 0, flags: 0, arrayLen: [2]int{-1, -1}, ID: 0x80e4},
+	{GroupNone, 0x80e5}: {Name: "ImageDepth", Type: 0, flags: 0, arrayLen: [2]int{-1, -1}, ID: 0x80e5},
+	{GroupNone, 0x80e6}: {Name: "TileDepth", Type: 0, flags: 0, arrayLen: [2]int{-1, -1}, ID: 0x80e6},
+	{GroupNone, 0x8214}: {Name: "ImageFullWidth", Type: 0, flags: 0, arrayLen: [2]int{-1, -1}, ID: 0x8214},
+	{GroupNone, 0x8215}: {Name: "ImageFullHeight", Type: 0, flags: 0, arrayLen: [2]int{-1, -1}, ID: 0x8215},
+	{GroupNone, 0x8216}: {Name: "TextureFormat", Type: 0, flags: 0, arrayLen: [2]int{-1, -1}, ID: 0x8216},
+	{GroupNone, 0x8217}: {Name: "WrapModes", Type: 0, flags: 0, arrayLen: [2]int{-1, -1}, ID: 0x8217},
+	{GroupNone, 0x8218}: {Name: "FovCot", Type: 0, flags: 0, arrayLen: [2]int{-1, -1}, ID: 0x8218},
+	{GroupNone, 0x8219}: {Name: "MatrixWorldToScreen", Type: 0, flags: 0, arrayLen: [2]int{-1, -1}, ID: 0x8219},
+	{GroupNone, 0x821a}: {Name: "MatrixWorldToCamera", Type: 0, flags: 0, arrayLen: [2]int{-1, -1}, ID: 0x821a},
+	{GroupNone, 0x827d}: {Name: "Model2", Type: 0, flags: 0, arrayLen: [2]int{-1, -1}, ID: 0x827d},
+	{GroupNone, 0x828d}: {Name: "CFARepeatPatternDim", Type: 3, flags: 2, arrayLen: [2]int{2, 1}, ID: 0x828d},
+	{GroupNone, 0x828e}: {Name: "CFAPattern2", Type: 1, flags: 2, arrayLen: [2]int{-1, 0}, ID: 0x828e},
+	{GroupNone, 0x828f}: {Name: "BatteryLevel", Type: 0, flags: 0, arrayLen: [2]int{-1, -1}, ID: 0x828f},
+	{GroupNone, 0x8290}: {Name: "KodakIFD", Type: 0, flags: 0, arrayLen: [2]int{-1, -1}, ID: 0x8290},
+	{GroupNone, 0x8298}: {Name: "Copyright", Type: 2, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x8298},
+	{GroupNone, 0x829a}: {Name: "ExposureTime", Type: 5, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x829a},
+	{GroupNone, 0x829d}: {Name: "FNumber", Type: 5, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x829d},
+	{GroupNone, 0x82a5}: {Name: "MDFileTag", Type: 0, flags: 0, arrayLen: [2]int{-1, -1}, ID: 0x82a5},
+	{GroupNone, 0x82a6}: {Name: "MDScalePixel", Type: 0, flags: 0, arrayLen: [2]int{-1, -1}, ID: 0x82a6},
+	{GroupNone, 0x82a7}: {Name: "MDColorTable", Type: 0, flags: 0, arrayLen: [2]int{-1, -1}, ID: 0x82a7},
+	{GroupNone, 0x82a8}: {Name: "MDLabName", Type: 0, flags: 0, arrayLen: [2]int{-1, -1}, ID: 0x82a8},
+	{GroupNone, 0x82a9}: {Name: "MDSampleInfo", Type: 0, flags: 0, arrayLen: [2]int{-1, -1}, ID: 0x82a9},
+	{GroupNone, 0x82aa}: {Name: "MDPrepDate", Type: 0, flags: 0, arrayLen: [2]int{-1, -1}, ID: 0x82aa},
+	{GroupNone, 0x82ab}: {Name: "MDPrepTime", Type: 0, flags: 0, arrayLen: [2]int{-1, -1}, ID: 0x82ab},
+	{GroupNone, 0x82ac}: {Name: "MDFileUnits", Type: 0, flags: 0, arrayLen: [2]int{-1, -1}, ID: 0x82ac},
+	{GroupNone, 0x830e}: {Name: "PixelScale", Type: 12, flags: 0, arrayLen: [2]int{3, 1}, ID: 0x830e},
+	{GroupNone, 0x8335}: {Name: "AdventScale", Type: 0, flags: 0, arrayLen: [2]int{-1, -1}, ID: 0x8335},
+	{GroupNone, 0x8336}: {Name: "AdventRevision", Type: 0, flags: 0, arrayLen: [2]int{-1, -1}, ID: 0x8336},
+	{GroupNone, 0x835c}: {Name: "UIC1Tag", Type: 0, flags: 0, arrayLen: [2]int{-1, -1}, ID: 0x835c},
+	{GroupNone, 0x835d}: {Name: "UIC2Tag", Type: 0, flags: 0, arrayLen: [2]int{-1, -1}, ID: 0x835d},
+	{GroupNone, 0x835e}: {Name: "UIC3Tag", Type: 0, flags: 0, arrayLen: [2]int{-1, -1}, ID: 0x835e},
+	{GroupNone, 0x835f}: {Name: "UIC4Tag", Type: 0, flags: 0, arrayLen: [2]int{-1, -1}, ID: 0x835f},
+	{GroupNone, 0x83bb}: {Name: "IPTC-NAA", Type: 4, flags: 2, arrayLen: [2]int{-1, 1}, ID: 0x83bb},
+	{GroupNone, 0x847e}: {Name: "IntergraphPacketData", Type: 0, flags: 0, arrayLen: [2]int{-1, -1}, ID: 0x847e},
+	{GroupNone, 0x847f}: {Name: "IntergraphFlagRegisters", Type: 0, flags: 0, arrayLen: [2]int{-1, -1}, ID: 0x847f},
+	{GroupNone, 0x8480}: {Name: "IntergraphMatrix", Type: 12, flags: 0, arrayLen: [2]int{-1, 0}, ID: 0x8480},
+	{GroupNone, 0x8481}: {Name: "INGRReserved", Type: 0, flags: 0, arrayLen: [2]int{-1, -1}, ID: 0x8481},
+	{GroupNone, 0x8482}: {Name: "ModelTiePoint", Type: 12, flags: 0, arrayLen: [2]int{-1, 0}, ID: 0x8482},
+	{GroupNone, 0x84e0}: {Name: "Site", Type: 0, flags: 0, arrayLen: [2]int{-1, -1}, ID: 0x84e0},
+	{GroupNone, 0x84e1}: {Name: "ColorSequence", Type: 0, flags: 0, arrayLen: [2]int{-1, -1}, ID: 0x84e1},
+	{GroupNone, 0x84e2}: {Name: "IT8Header", Type: 0, flags: 0, arrayLen: [2]int{-1, -1}, ID: 0x84e2},
+	{GroupNone, 0x84e3}: {Name: "RasterPadding", Type: 0, flags: 0, arrayLen: [2]int{-1, -1}, ID: 0x84e3, enum: []int64{0, 1, 2, 9, 10}, enumString: []string{"Byte", "Word", "Long Word", "Sector", "Long Sector"}},
+	{GroupNone, 0x84e4}: {Name: "BitsPerRunLength", Type: 0, flags: 0, arrayLen: [2]int{-1, -1}, ID: 0x84e4},
+	{GroupNone, 0x84e5}: {Name: "BitsPerExtendedRunLength", Type: 0, flags: 0, arrayLen: [2]int{-1, -1}, ID: 0x84e5},
+	{GroupNone, 0x84e6}: {Name: "ColorTable", Type: 0, flags: 0, arrayLen: [2]int{-1, -1}, ID: 0x84e6},
+	{GroupNone, 0x84e7}: {Name: "ImageColorIndicator", Type: 0, flags: 0, arrayLen: [2]int{-1, -1}, ID: 0x84e7, enum: []int64{0, 1}, enumString: []string{"Unspecified Image Color", "Specified Image Color"}},
+	{GroupNone, 0x84e8}: {Name: "BackgroundColorIndicator", Type: 0, flags: 0, arrayLen: [2]int{-1, -1}, ID: 0x84e8, enum: []int64{0, 1}, enumString: []string{"Unspecified Background Color", "Specified Background Color"}},
+	{GroupNone, 0x84e9}: {Name: "ImageColorValue", Type: 0, flags: 0, arrayLen: [2]int{-1, -1}, ID: 0x84e9},
+	{GroupNone, 0x84ea}: {Name: "BackgroundColorValue", Type: 0, flags: 0, arrayLen: [2]int{-1, -1}, ID: 0x84ea},
+	{GroupNone, 0x84eb}: {Name: "PixelIntensityRange", Type: 0, flags: 0, arrayLen: [2]int{-1, -1}, ID: 0x84eb},
+	{GroupNone, 0x84ec}: {Name: "TransparencyIndicator", Type: 0, flags: 0, arrayLen: [2]int{-1, -1}, ID: 0x84ec},
+	{GroupNone, 0x84ed}: {Name: "ColorCharacterization", Type: 0, flags: 0, arrayLen: [2]int{-1, -1}, ID: 0x84ed},
+	{GroupNone, 0x84ee}: {Name: "HCUsage", Type: 0, flags: 0, arrayLen: [2]int{-1, -1}, ID: 0x84ee, enum: []int64{0, 1, 2}, enumString: []string{"CT", "Line Art", "Trap"}},
+	{GroupNone, 0x84ef}: {Name: "TrapIndicator", Type: 0, flags: 0, arrayLen: [2]int{-1, -1}, ID: 0x84ef},
+	{GroupNone, 0x84f0}: {Name: "CMYKEquivalent", Type: 0, flags: 0, arrayLen: [2]int{-1, -1}, ID: 0x84f0},
+	{GroupNone, 0x8546}: {Name: "SEMInfo", Type: 2, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x8546},
+	{GroupNone, 0x8568}: {Name: "AFCP_IPTC", Type: 0, flags: 0, arrayLen: [2]int{-1, -1}, ID: 0x8568},
+	{GroupNone, 0x85b8}: {Name: "PixelMagicJBIGOptions", Type: 0, flags: 0, arrayLen: [2]int{-1, -1}, ID: 0x85b8},
+	{GroupNone, 0x85d7}: {Name: "JPLCartoIFD", Type: 0, flags: 0, arrayLen: [2]int{-1, -1}, ID: 0x85d7},
+	{GroupNone, 0x85d8}: {Name: "ModelTransform", Type: 12, flags: 0, arrayLen: [2]int{16, 1}, ID: 0x85d8},
+	{GroupNone, 0x8602}: {Name: "WB_GRGBLevels", Type: 0, flags: 0, arrayLen: [2]int{-1, -1}, ID: 0x8602},
+	{GroupNone, 0x8606}: {Name: "LeafData", Type: 0, flags: 0, arrayLen: [2]int{-1, -1}, ID: 0x8606},
+	{GroupNone, 0x8649}: {Name: "PhotoshopSettings", Type: 0, flags: 0, arrayLen: [2]int{-1, -1}, ID: 0x8649},
+	{GroupNone, 0x8769}: {Name: "ExifOffset", Type: 0, flags: 0, arrayLen: [2]int{-1, -1}, ID: 0x8769},
+	{GroupNone, 0x8773}: {Name: "ICC_Profile", Type: 0, flags: 0, arrayLen: [2]int{-1, -1}, ID: 0x8773},
+	{GroupNone, 0x877f}: {Name: "TIFF_FXExtensions", Type: 0, flags: 0, arrayLen: [2]int{-1, -1}, ID: 0x877f},
+	{GroupNone, 0x8780}: {Name: "MultiProfiles", Type: 0, flags: 0, arrayLen: [2]int{-1, -1}, ID: 0x8780},
+	{GroupNone, 0x8781}: {Name: "SharedData", Type: 0, flags: 0, arrayLen: [2]int{-1, -1}, ID: 0x8781},
+	{GroupNone, 0x8782}: {Name: "T88Options", Type: 0, flags: 0, arrayLen: [2]int{-1, -1}, ID: 0x8782},
+	{GroupNone, 0x87ac}: {Name: "ImageLayer", Type: 0, flags: 0, arrayLen: [2]int{-1, -1}, ID: 0x87ac},
+	{GroupNone, 0x87af}: {Name: "GeoTiffDirectory", Type: 3, flags: 0, arrayLen: [2]int{5, 1}, ID: 0x87af},
+	{GroupNone, 0x87b0}: {Name: "GeoTiffDoubleParams", Type: 12, flags: 0, arrayLen: [2]int{125, 1}, ID: 0x87b0},
+	{GroupNone, 0x87b1}: {Name: "GeoTiffAsciiParams", Type: 2, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x87b1},
+	{GroupNone, 0x87be}: {Name: "JBIGOptions", Type: 0, flags: 0, arrayLen: [2]int{-1, -1}, ID: 0x87be},
+	{GroupNone, 0x8822}: {Name: "ExposureProgram", Type: 3, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x8822, enum: []int64{0, 1, 2, 3, 4, 5, 6, 7, 8, 9}, enumString: []string{"Not Defined", "Manual", "Program AE", "Aperture-priority AE", "Shutter speed priority AE", "Creative (Slow speed)", "Action (High speed)", "Portrait", "Landscape", "Bulb"}},
+	{GroupNone, 0x8824}: {Name: "SpectralSensitivity", Type: 2, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x8824},
+	{GroupNone, 0x8825}: {Name: "GPSInfo", Type: 0, flags: 0, arrayLen: [2]int{-1, -1}, ID: 0x8825},
+	{GroupNone, 0x8827}: {Name: "ISO", Type: 3, flags: 0, arrayLen: [2]int{-1, 0}, ID: 0x8827},
+	{GroupNone, 0x8828}: {Name: "Opto-ElectricConvFactor", Type: 0, flags: 0, arrayLen: [2]int{-1, -1}, ID: 0x8828},
+	{GroupNone, 0x8829}: {Name: "Interlace", Type: 0, flags: 0, arrayLen: [2]int{-1, -1}, ID: 0x8829},
+	{GroupNone, 0x882a}: {Name: "TimeZoneOffset", Type: 8, flags: 0, arrayLen: [2]int{-1, 0}, ID: 0x882a},
+	{GroupNone, 0x882b}: {Name: "SelfTimerMode", Type: 3, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x882b},
+	{GroupNone, 0x8830}: {Name: "SensitivityType", Type: 3, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x8830, enum: []int64{0, 1, 2, 3, 4, 5, 6, 7}, enumString: []string{"Unknown", "Standard Output Sensitivity", "Recommended Exposure Index", "ISO Speed", "Standard Output Sensitivity and Recommended Exposure Index", "Standard Output Sensitivity and ISO Speed", "Recommended Exposure Index and ISO Speed", "Standard Output Sensitivity, Recommended Exposure Index and ISO Speed"}},
+	{GroupNone, 0x8831}: {Name: "StandardOutputSensitivity", Type: 4, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x8831},
+	{GroupNone, 0x8832}: {Name: "RecommendedExposureIndex", Type: 4, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x8832},
+	{GroupNone, 0x8833}: {Name: "ISOSpeed", Type: 4, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x8833},
+	{GroupNone, 0x8834}: {Name: "ISOSpeedLatitudeyyy", Type: 4, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x8834},
+	{GroupNone, 0x8835}: {Name: "ISOSpeedLatitudezzz", Type: 4, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x8835},
+	{GroupNone, 0x885c}: {Name: "FaxRecvParams", Type: 0, flags: 0, arrayLen: [2]int{-1, -1}, ID: 0x885c},
+	{GroupNone, 0x885d}: {Name: "FaxSubAddress", Type: 0, flags: 0, arrayLen: [2]int{-1, -1}, ID: 0x885d},
+	{GroupNone, 0x885e}: {Name: "FaxRecvTime", Type: 0, flags: 0, arrayLen: [2]int{-1, -1}, ID: 0x885e},
+	{GroupNone, 0x8871}: {Name: "FedexEDR", Type: 0, flags: 0, arrayLen: [2]int{-1, -1}, ID: 0x8871},
+	{GroupNone, 0x888a}: {Name: "LeafSubIFD", Type: 0, flags: 0, arrayLen: [2]int{-1, -1}, ID: 0x888a},
+	{GroupNone, 0x9000}: {Name: "ExifVersion", Type: 7, flags: 1, arrayLen: [2]int{-1, 1}, ID: 0x9000},
+	{GroupNone, 0x9003}: {Name: "DateTimeOriginal", Type: 2, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x9003},
+	{GroupNone, 0x9004}: {Name: "CreateDate", Type: 2, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x9004},
+	{GroupNone, 0x9009}: {Name: "GooglePlusUploadCode", Type: 7, flags: 0, arrayLen: [2]int{-1, 0}, ID: 0x9009},
+	{GroupNone, 0x9010}: {Name: "OffsetTime", Type: 2, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x9010},
+	{GroupNone, 0x9011}: {Name: "OffsetTimeOriginal", Type: 2, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x9011},
+	{GroupNone, 0x9012}: {Name: "OffsetTimeDigitized", Type: 2, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x9012},
+	{GroupNone, 0x9101}: {Name: "ComponentsConfiguration", Type: 7, flags: 3, arrayLen: [2]int{4, 1}, ID: 0x9101, enum: []int64{0, 1, 2, 3}, enumString: []string{"-", "Y", "Cb", "Cr"}},
+	{GroupNone, 0x9102}: {Name: "CompressedBitsPerPixel", Type: 5, flags: 2, arrayLen: [2]int{-1, 1}, ID: 0x9102},
+	{GroupNone, 0x9201}: {Name: "ShutterSpeedValue", Type: 10, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x9201},
+	{GroupNone, 0x9202}: {Name: "ApertureValue", Type: 5, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x9202},
+	{GroupNone, 0x9203}: {Name: "BrightnessValue", Type: 10, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x9203},
+	{GroupNone, 0x9204}: {Name: "ExposureCompensation", Type: 10, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x9204},
+	{GroupNone, 0x9205}: {Name: "MaxApertureValue", Type: 5, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x9205},
+	{GroupNone, 0x9206}: {Name: "SubjectDistance", Type: 5, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x9206},
+	{GroupNone, 0x9207}: {Name: "MeteringMode", Type: 3, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x9207, enum: []int64{0, 1, 2, 3, 4, 5, 6, 255}, enumString: []string{"Unknown", "Average", "Center-weighted average", "Spot", "Multi-spot", "Multi-segment", "Partial", "Other"}},
+	{GroupNone, 0x9208}: {Name: "LightSource", Type: 3, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x9208},
+	{GroupNone, 0x9209}: {Name: "Flash", Type: 3, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x9209},
+	{GroupNone, 0x920a}: {Name: "FocalLength", Type: 5, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x920a},
+	{GroupNone, 0x920b}: {Name: "FlashEnergy", Type: 0, flags: 0, arrayLen: [2]int{-1, -1}, ID: 0x920b},
+	{GroupNone, 0x920c}: {Name: "SpatialFrequencyResponse", Type: 0, flags: 0, arrayLen: [2]int{-1, -1}, ID: 0x920c},
+	{GroupNone, 0x920d}: {Name: "Noise", Type: 0, flags: 0, arrayLen: [2]int{-1, -1}, ID: 0x920d},
+	{GroupNone, 0x920e}: {Name: "FocalPlaneXResolution", Type: 0, flags: 0, arrayLen: [2]int{-1, -1}, ID: 0x920e},
+	{GroupNone, 0x920f}: {Name: "FocalPlaneYResolution", Type: 0, flags: 0, arrayLen: [2]int{-1, -1}, ID: 0x920f},
+	{GroupNone, 0x9210}: {Name: "FocalPlaneResolutionUnit", Type: 0, flags: 0, arrayLen: [2]int{-1, -1}, ID: 0x9210, enum: []int64{1, 2, 3, 4, 5}, enumString: []string{"None", "inches", "cm", "mm", "um"}},
+	{GroupNone, 0x9211}: {Name: "ImageNumber", Type: 4, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x9211},
+	{GroupNone, 0x9212}: {Name: "SecurityClassification", Type: 2, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x9212},
+	{GroupNone, 0x9213}: {Name: "ImageHistory", Type: 2, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x9213},
+	{GroupNone, 0x9214}: {Name: "SubjectArea", Type: 3, flags: 0, arrayLen: [2]int{-1, 0}, ID: 0x9214},
+	{GroupNone, 0x9215}: {Name: "ExposureIndex", Type: 0, flags: 0, arrayLen: [2]int{-1, -1}, ID: 0x9215},
+	{GroupNone, 0x9216}: {Name: "TIFF-EPStandardID", Type: 0, flags: 0, arrayLen: [2]int{-1, -1}, ID: 0x9216},
+	{GroupNone, 0x9217}: {Name: "SensingMethod", Type: 0, flags: 0, arrayLen: [2]int{-1, -1}, ID: 0x9217, enum: []int64{1, 2, 3, 4, 5, 6, 7, 8}, enumString: []string{"Monochrome area", "One-chip color area", "Two-chip color area", "Three-chip color area", "Color sequential area", "Monochrome linear", "Trilinear", "Color sequential linear"}},
+	{GroupNone, 0x923a}: {Name: "CIP3DataFile", Type: 0, flags: 0, arrayLen: [2]int{-1, -1}, ID: 0x923a},
+	{GroupNone, 0x923b}: {Name: "CIP3Sheet", Type: 0, flags: 0, arrayLen: [2]int{-1, -1}, ID: 0x923b},
+	{GroupNone, 0x923c}: {Name: "CIP3Side", Type: 0, flags: 0, arrayLen: [2]int{-1, -1}, ID: 0x923c},
+	{GroupNone, 0x923f}: {Name: "StoNits", Type: 0, flags: 0, arrayLen: [2]int{-1, -1}, ID: 0x923f},
+	{GroupNone, 0x927c}: {Name: "MakerNoteApple", Type: 7, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x927c},
+	{GroupNone, 0x9286}: {Name: "UserComment", Type: 7, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x9286},
+	{GroupNone, 0x9290}: {Name: "SubSecTime", Type: 2, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x9290},
+	{GroupNone, 0x9291}: {Name: "SubSecTimeOriginal", Type: 2, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x9291},
+	{GroupNone, 0x9292}: {Name: "SubSecTimeDigitized", Type: 2, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x9292},
+	{GroupNone, 0x932f}: {Name: "MSDocumentText", Type: 0, flags: 0, arrayLen: [2]int{-1, -1}, ID: 0x932f},
+	{GroupNone, 0x9330}: {Name: "MSPropertySetStorage", Type: 0, flags: 0, arrayLen: [2]int{-1, -1}, ID: 0x9330},
+	{GroupNone, 0x9331}: {Name: "MSDocumentTextPosition", Type: 0, flags: 0, arrayLen: [2]int{-1, -1}, ID: 0x9331},
+	{GroupNone, 0x935c}: {Name: "ImageSourceData", Type: 7, flags: 2, arrayLen: [2]int{-1, 1}, ID: 0x935c},
+	{GroupNone, 0x9400}: {Name: "AmbientTemperature", Type: 10, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x9400},
+	{GroupNone, 0x9401}: {Name: "Humidity", Type: 5, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x9401},
+	{GroupNone, 0x9402}: {Name: "Pressure", Type: 5, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x9402},
+	{GroupNone, 0x9403}: {Name: "WaterDepth", Type: 10, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x9403},
+	{GroupNone, 0x9404}: {Name: "Acceleration", Type: 5, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x9404},
+	{GroupNone, 0x9405}: {Name: "CameraElevationAngle", Type: 10, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x9405},
+	{GroupNone, 0x9c9b}: {Name: "XPTitle", Type: 1, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x9c9b},
+	{GroupNone, 0x9c9c}: {Name: "XPComment", Type: 1, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x9c9c},
+	{GroupNone, 0x9c9d}: {Name: "XPAuthor", Type: 1, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x9c9d},
+	{GroupNone, 0x9c9e}: {Name: "XPKeywords", Type: 1, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x9c9e},
+	{GroupNone, 0x9c9f}: {Name: "XPSubject", Type: 1, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x9c9f},
+	{GroupNone, 0xa000}: {Name: "FlashpixVersion", Type: 7, flags: 1, arrayLen: [2]int{-1, 1}, ID: 0xa000},
+	{GroupNone, 0xa001}: {Name: "ColorSpace", Type: 3, flags: 1, arrayLen: [2]int{-1, 1}, ID: 0xa001, enum: []int64{1, 2, 65533, 65534, 65535}, enumString: []string{"sRGB", "Adobe RGB", "Wide Gamut RGB", "ICC Profile", "Uncalibrated"}},
+	{GroupNone, 0xa002}: {Name: "ExifImageWidth", Type: 3, flags: 1, arrayLen: [2]int{-1, 1}, ID: 0xa002},
+	{GroupNone, 0xa003}: {Name: "ExifImageHeight", Type: 3, flags: 1, arrayLen: [2]int{-1, 1}, ID: 0xa003},
+	{GroupNone, 0xa004}: {Name: "RelatedSoundFile", Type: 2, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0xa004},
+	{GroupNone, 0xa005}: {Name: "InteropOffset", Type: 0, flags: 0, arrayLen: [2]int{-1, -1}, ID: 0xa005},
+	{GroupNone, 0xa010}: {Name: "SamsungRawPointersOffset", Type: 0, flags: 0, arrayLen: [2]int{-1, -1}, ID: 0xa010},
+	{GroupNone, 0xa011}: {Name: "SamsungRawPointersLength", Type: 0, flags: 0, arrayLen: [2]int{-1, -1}, ID: 0xa011},
+	{GroupNone, 0xa101}: {Name: "SamsungRawByteOrder", Type: 0, flags: 0, arrayLen: [2]int{-1, -1}, ID: 0xa101},
+	{GroupNone, 0xa102}: {Name: "SamsungRawUnknown?", Type: 0, flags: 0, arrayLen: [2]int{-1, -1}, ID: 0xa102},
+	{GroupNone, 0xa20b}: {Name: "FlashEnergy", Type: 5, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0xa20b},
+	{GroupNone, 0xa20c}: {Name: "SpatialFrequencyResponse", Type: 0, flags: 0, arrayLen: [2]int{-1, -1}, ID: 0xa20c},
+	{GroupNone, 0xa20d}: {Name: "Noise", Type: 0, flags: 0, arrayLen: [2]int{-1, -1}, ID: 0xa20d},
+	{GroupNone, 0xa20e}: {Name: "FocalPlaneXResolution", Type: 5, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0xa20e},
+	{GroupNone, 0xa20f}: {Name: "FocalPlaneYResolution", Type: 5, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0xa20f},
+	{GroupNone, 0xa210}: {Name: "FocalPlaneResolutionUnit", Type: 3, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0xa210, enum: []int64{1, 2, 3, 4, 5}, enumString: []string{"None", "inches", "cm", "mm", "um"}},
+	{GroupNone, 0xa211}: {Name: "ImageNumber", Type: 0, flags: 0, arrayLen: [2]int{-1, -1}, ID: 0xa211},
+	{GroupNone, 0xa212}: {Name: "SecurityClassification", Type: 0, flags: 0, arrayLen: [2]int{-1, -1}, ID: 0xa212},
+	{GroupNone, 0xa213}: {Name: "ImageHistory", Type: 0, flags: 0, arrayLen: [2]int{-1, -1}, ID: 0xa213},
+	{GroupNone, 0xa214}: {Name: "SubjectLocation", Type: 3, flags: 0, arrayLen: [2]int{2, 1}, ID: 0xa214},
+	{GroupNone, 0xa215}: {Name: "ExposureIndex", Type: 5, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0xa215},
+	{GroupNone, 0xa216}: {Name: "TIFF-EPStandardID", Type: 0, flags: 0, arrayLen: [2]int{-1, -1}, ID: 0xa216},
+	{GroupNone, 0xa217}: {Name: "SensingMethod", Type: 3, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0xa217, enum: []int64{1, 2, 3, 4, 5, 7, 8}, enumString: []string{"Not defined", "One-chip color area", "Two-chip color area", "Three-chip color area", "Color sequential area", "Trilinear", "Color sequential linear"}},
+	{GroupNone, 0xa300}: {Name: "FileSource", Type: 7, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0xa300, enum: []int64{1, 2, 3}, enumString: []string{"Film Scanner", "Reflection Print Scanner", "Digital Camera"}},
+	{GroupNone, 0xa301}: {Name: "SceneType", Type: 7, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0xa301, enum: []int64{1}, enumString: []string{"Directly photographed"}},
+	{GroupNone, 0xa302}: {Name: "CFAPattern", Type: 7, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0xa302},
+	{GroupNone, 0xa401}: {Name: "CustomRendered", Type: 3, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0xa401, enum: []int64{0, 1, 2, 3, 4, 6, 7, 8}, enumString: []string{"Normal", "Custom", "HDR (no original saved)", "HDR (original saved)", "Original (for HDR)", "Panorama", "Portrait HDR", "Portrait"}},
+	{GroupNone, 0xa402}: {Name: "ExposureMode", Type: 3, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0xa402, enum: []int64{0, 1, 2}, enumString: []string{"Auto", "Manual", "Auto bracket"}},
+	{GroupNone, 0xa403}: {Name: "WhiteBalance", Type: 3, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0xa403, enum: []int64{0, 1}, enumString: []string{"Auto", "Manual"}},
+	{GroupNone, 0xa404}: {Name: "DigitalZoomRatio", Type: 5, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0xa404},
+	{GroupNone, 0xa405}: {Name: "FocalLengthIn35mmFormat", Type: 3, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0xa405},
+	{GroupNone, 0xa406}: {Name: "SceneCaptureType", Type: 3, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0xa406, enum: []int64{0, 1, 2, 3, 4}, enumString: []string{"Standard", "Landscape", "Portrait", "Night", "Other"}},
+	{GroupNone, 0xa407}: {Name: "GainControl", Type: 3, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0xa407, enum: []int64{0, 1, 2, 3, 4}, enumString: []string{"None", "Low gain up", "High gain up", "Low gain down", "High gain down"}},
+	{GroupNone, 0xa408}: {Name: "Contrast", Type: 3, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0xa408, enum: []int64{0, 1, 2}, enumString: []string{"Normal", "Low", "High"}},
+	{GroupNone, 0xa409}: {Name: "Saturation", Type: 3, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0xa409, enum: []int64{0, 1, 2}, enumString: []string{"Normal", "Low", "High"}},
+	{GroupNone, 0xa40a}: {Name: "Sharpness", Type: 3, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0xa40a, enum: []int64{0, 1, 2}, enumString: []string{"Normal", "Soft", "Hard"}},
+	{GroupNone, 0xa40b}: {Name: "DeviceSettingDescription", Type: 0, flags: 0, arrayLen: [2]int{-1, -1}, ID: 0xa40b},
+	{GroupNone, 0xa40c}: {Name: "SubjectDistanceRange", Type: 3, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0xa40c, enum: []int64{0, 1, 2, 3}, enumString: []string{"Unknown", "Macro", "Close", "Distant"}},
+	{GroupNone, 0xa420}: {Name: "ImageUniqueID", Type: 2, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0xa420},
+	{GroupNone, 0xa430}: {Name: "OwnerName", Type: 2, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0xa430},
+	{GroupNone, 0xa431}: {Name: "SerialNumber", Type: 2, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0xa431},
+	{GroupNone, 0xa432}: {Name: "LensInfo", Type: 5, flags: 0, arrayLen: [2]int{4, 1}, ID: 0xa432},
+	{GroupNone, 0xa433}: {Name: "LensMake", Type: 2, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0xa433},
+	{GroupNone, 0xa434}: {Name: "LensModel", Type: 2, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0xa434},
+	{GroupNone, 0xa435}: {Name: "LensSerialNumber", Type: 2, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0xa435},
+	{GroupNone, 0xa460}: {Name: "CompositeImage", Type: 3, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0xa460, enum: []int64{0, 1, 2, 3}, enumString: []string{"Unknown", "Not a Composite Image", "General Composite Image", "Composite Image Captured While Shooting"}},
+	{GroupNone, 0xa461}: {Name: "CompositeImageCount", Type: 3, flags: 0, arrayLen: [2]int{2, 1}, ID: 0xa461},
+	{GroupNone, 0xa462}: {Name: "CompositeImageExposureTimes", Type: 7, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0xa462},
+	{GroupNone, 0xa480}: {Name: "GDALMetadata", Type: 2, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0xa480},
+	{GroupNone, 0xa481}: {Name: "GDALNoData", Type: 2, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0xa481},
+	{GroupNone, 0xa500}: {Name: "Gamma", Type: 5, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0xa500},
+	{GroupNone, 0xafc0}: {Name: "ExpandSoftware", Type: 0, flags: 0, arrayLen: [2]int{-1, -1}, ID: 0xafc0},
+	{GroupNone, 0xafc1}: {Name: "ExpandLens", Type: 0, flags: 0, arrayLen: [2]int{-1, -1}, ID: 0xafc1},
+	{GroupNone, 0xafc2}: {Name: "ExpandFilm", Type: 0, flags: 0, arrayLen: [2]int{-1, -1}, ID: 0xafc2},
+	{GroupNone, 0xafc3}: {Name: "ExpandFilterLens", Type: 0, flags: 0, arrayLen: [2]int{-1, -1}, ID: 0xafc3},
+	{GroupNone, 0xafc4}: {Name: "ExpandScanner", Type: 0, flags: 0, arrayLen: [2]int{-1, -1}, ID: 0xafc4},
+	{GroupNone, 0xafc5}: {Name: "ExpandFlashLamp", Type: 0, flags: 0, arrayLen: [2]int{-1, -1}, ID: 0xafc5},
+	{GroupNone, 0xb4c3}: {Name: "HasselbladRawImage", Type: 0, flags: 0, arrayLen: [2]int{-1, -1}, ID: 0xb4c3},
+	{GroupNone, 0xbc01}: {Name: "PixelFormat", Type: 0, flags: 0, arrayLen: [2]int{-1, -1}, ID: 0xbc01, enum: []int64{5, 8, 9, 10, 11, 12, 13, 14, 15, 16, 17, 18, 19, 21, 22, 23, 24, 25, 26, 27, 28, 29, 30, 31, 32, 33, 34, 35, 36, 37, 38, 39, 40, 41, 42, 43, 44, 45, 46, 47, 48, 49, 50, 51, 52, 53, 54, 55, 56, 57, 58, 59, 61, 62, 63}, enumString: []string{"Black & White", "8-bit Gray", "16-bit BGR555", "16-bit BGR565", "16-bit Gray", "24-bit BGR", "24-bit RGB", "32-bit BGR", "32-bit BGRA", "32-bit PBGRA", "32-bit Gray Float", "48-bit RGB Fixed Point", "32-bit BGR101010", "48-bit RGB", "64-bit RGBA", "64-bit PRGBA", "96-bit RGB Fixed Point", "128-bit RGBA Float", "128-bit PRGBA Float", "128-bit RGB Float", "32-bit CMYK", "64-bit RGBA Fixed Point", "128-bit RGBA Fixed Point", "64-bit CMYK", "24-bit 3 Channels", "32-bit 4 Channels", "40-bit 5 Channels", "48-bit 6 Channels", "56-bit 7 Channels", "64-bit 8 Channels", "48-bit 3 Channels", "64-bit 4 Channels", "80-bit 5 Channels", "96-bit 6 Channels", "112-bit 7 Channels", "128-bit 8 Channels", "40-bit CMYK Alpha", "80-bit CMYK Alpha", "32-bit 3 Channels Alpha", "40-bit 4 Channels Alpha", "48-bit 5 Channels Alpha", "56-bit 6 Channels Alpha", "64-bit 7 Channels Alpha", "72-bit 8 Channels Alpha", "64-bit 3 Channels Alpha", "80-bit 4 Channels Alpha", "96-bit 5 Channels Alpha", "112-bit 6 Channels Alpha", "128-bit 7 Channels Alpha", "144-bit 8 Channels Alpha", "64-bit RGBA Half", "48-bit RGB Half", "32-bit RGBE", "16-bit Gray Half", "32-bit Gray Fixed Point"}},
+	{GroupNone, 0xbc02}: {Name: "Transformation", Type: 0, flags: 0, arrayLen: [2]int{-1, -1}, ID: 0xbc02, enum: []int64{0, 1, 2, 3, 4, 5, 6, 7}, enumString: []string{"Horizontal (normal)", "Mirror vertical", "Mirror horizontal", "Rotate 180", "Rotate 90 CW", "Mirror horizontal and rotate 90 CW", "Mirror horizontal and rotate 270 CW", "Rotate 270 CW"}},
+	{GroupNone, 0xbc03}: {Name: "Uncompressed", Type: 0, flags: 0, arrayLen: [2]int{-1, -1}, ID: 0xbc03, enum: []int64{0, 1}, enumString: []string{"No", "Yes"}},
+	{GroupNone, 0xbc04}: {Name: "ImageType", Type: 0, flags: 0, arrayLen: [2]int{-1, -1}, ID: 0xbc04},
+	{GroupNone, 0xbc80}: {Name: "ImageWidth", Type: 0, flags: 0, arrayLen: [2]int{-1, -1}, ID: 0xbc80},
+	{GroupNone, 0xbc81}: {Name: "ImageHeight", Type: 0, flags: 0, arrayLen: [2]int{-1, -1}, ID: 0xbc81},
+	{GroupNone, 0xbc82}: {Name: "WidthResolution", Type: 0, flags: 0, arrayLen: [2]int{-1, -1}, ID: 0xbc82},
+	{GroupNone, 0xbc83}: {Name: "HeightResolution", Type: 0, flags: 0, arrayLen: [2]int{-1, -1}, ID: 0xbc83},
+	{GroupNone, 0xbcc0}: {Name: "ImageOffset", Type: 0, flags: 0, arrayLen: [2]int{-1, -1}, ID: 0xbcc0},
+	{GroupNone, 0xbcc1}: {Name: "ImageByteCount", Type: 0, flags: 0, arrayLen: [2]int{-1, -1}, ID: 0xbcc1},
+	{GroupNone, 0xbcc2}: {Name: "AlphaOffset", Type: 0, flags: 0, arrayLen: [2]int{-1, -1}, ID: 0xbcc2},
+	{GroupNone, 0xbcc3}: {Name: "AlphaByteCount", Type: 0, flags: 0, arrayLen: [2]int{-1, -1}, ID: 0xbcc3},
+	{GroupNone, 0xbcc4}: {Name: "ImageDataDiscard", Type: 0, flags: 0, arrayLen: [2]int{-1, -1}, ID: 0xbcc4, enum: []int64{0, 1, 2, 3}, enumString: []string{"Full Resolution", "Flexbits Discarded", "HighPass Frequency Data Discarded", "Highpass and LowPass Frequency Data Discarded"}},
+	{GroupNone, 0xbcc5}: {Name: "AlphaDataDiscard", Type: 0, flags: 0, arrayLen: [2]int{-1, -1}, ID: 0xbcc5, enum: []int64{0, 1, 2, 3}, enumString: []string{"Full Resolution", "Flexbits Discarded", "HighPass Frequency Data Discarded", "Highpass and LowPass Frequency Data Discarded"}},
+	{GroupNone, 0xc427}: {Name: "OceScanjobDesc", Type: 0, flags: 0, arrayLen: [2]int{-1, -1}, ID: 0xc427},
+	{GroupNone, 0xc428}: {Name: "OceApplicationSelector", Type: 0, flags: 0, arrayLen: [2]int{-1, -1}, ID: 0xc428},
+	{GroupNone, 0xc429}: {Name: "OceIDNumber", Type: 0, flags: 0, arrayLen: [2]int{-1, -1}, ID: 0xc429},
+	{GroupNone, 0xc42a}: {Name: "OceImageLogic", Type: 0, flags: 0, arrayLen: [2]int{-1, -1}, ID: 0xc42a},
+	{GroupNone, 0xc44f}: {Name: "Annotations", Type: 0, flags: 0, arrayLen: [2]int{-1, -1}, ID: 0xc44f},
+	{GroupNone, 0xc4a5}: {Name: "PrintIM", Type: 7, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0xc4a5},
+	{GroupNone, 0xc51b}: {Name: "HasselbladExif", Type: 0, flags: 0, arrayLen: [2]int{-1, -1}, ID: 0xc51b},
+	{GroupNone, 0xc573}: {Name: "OriginalFileName", Type: 0, flags: 0, arrayLen: [2]int{-1, -1}, ID: 0xc573},
+	{GroupNone, 0xc580}: {Name: "USPTOOriginalContentType", Type: 0, flags: 0, arrayLen: [2]int{-1, -1}, ID: 0xc580, enum: []int64{0, 1, 2}, enumString: []string{"Text or Drawing", "Grayscale", "Color"}},
+	{GroupNone, 0xc5e0}: {Name: "CR2CFAPattern", Type: 0, flags: 0, arrayLen: [2]int{-1, -1}, ID: 0xc5e0, enum: []int64{1, 4, 3, 2}, enumString: []string{"> '0 1 1 2' = [Red,Green][Green,Blue]", "> '1 0 2 1' = [Green,Red][Blue,Green]", "> '1 2 0 1' = [Green,Blue][Red,Green]", "> '2 1 1 0' = [Blue,Green][Green,Red]"}},
+	{GroupNone, 0xc612}: {Name: "DNGVersion", Type: 1, flags: 2, arrayLen: [2]int{4, 1}, ID: 0xc612},
+	{GroupNone, 0xc613}: {Name: "DNGBackwardVersion", Type: 1, flags: 2, arrayLen: [2]int{4, 1}, ID: 0xc613},
+	{GroupNone, 0xc614}: {Name: "UniqueCameraModel", Type: 2, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0xc614},
+	{GroupNone, 0xc615}: {Name: "LocalizedCameraModel", Type: 2, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0xc615},
+	{GroupNone, 0xc616}: {Name: "CFAPlaneColor", Type: 0, flags: 0, arrayLen: [2]int{-1, -1}, ID: 0xc616},
+	{GroupNone, 0xc617}: {Name: "CFALayout", Type: 0, flags: 0, arrayLen: [2]int{-1, -1}, ID: 0xc617, enum: []int64{1, 2, 3, 4, 5, 6, 7, 8, 9}, enumString: []string{"Rectangular", "Even columns offset down 1/2 row", "Even columns offset up 1/2 row", "Even rows offset right 1/2 column", "Even rows offset left 1/2 column", "Even rows offset up by 1/2 row, even columns offset left by 1/2 column", "Even rows offset up by 1/2 row, even columns offset right by 1/2 column", "Even rows offset down by 1/2 row, even columns offset left by 1/2 column", "Even rows offset down by 1/2 row, even columns offset right by 1/2 column"}},
+	{GroupNone, 0xc618}: {Name: "LinearizationTable", Type: 3, flags: 2, arrayLen: [2]int{-1, 0}, ID: 0xc618},
+	{GroupNone, 0xc619}: {Name: "BlackLevelRepeatDim", Type: 3, flags: 2, arrayLen: [2]int{2, 1}, ID: 0xc619},
+	{GroupNone, 0xc61a}: {Name: "BlackLevel", Type: 5, flags: 2, arrayLen: [2]int{-1, 0}, ID: 0xc61a},
+	{GroupNone, 0xc61b}: {Name: "BlackLevelDeltaH", Type: 10, flags: 2, arrayLen: [2]int{-1, 0}, ID: 0xc61b},
+	{GroupNone, 0xc61c}: {Name: "BlackLevelDeltaV", Type: 10, flags: 2, arrayLen: [2]int{-1, 0}, ID: 0xc61c},
+	{GroupNone, 0xc61d}: {Name: "WhiteLevel", Type: 4, flags: 2, arrayLen: [2]int{-1, 0}, ID: 0xc61d},
+	{GroupNone, 0xc61e}: {Name: "DefaultScale", Type: 5, flags: 2, arrayLen: [2]int{2, 1}, ID: 0xc61e},
+	{GroupNone, 0xc61f}: {Name: "DefaultCropOrigin", Type: 4, flags: 2, arrayLen: [2]int{2, 1}, ID: 0xc61f},
+	{GroupNone, 0xc620}: {Name: "DefaultCropSize", Type: 4, flags: 2, arrayLen: [2]int{2, 1}, ID: 0xc620},
+	{GroupNone, 0xc621}: {Name: "ColorMatrix1", Type: 10, flags: 2, arrayLen: [2]int{-1, 0}, ID: 0xc621},
+	{GroupNone, 0xc622}: {Name: "ColorMatrix2", Type: 10, flags: 2, arrayLen: [2]int{-1, 0}, ID: 0xc622},
+	{GroupNone, 0xc623}: {Name: "CameraCalibration1", Type: 10, flags: 2, arrayLen: [2]int{-1, 0}, ID: 0xc623},
+	{GroupNone, 0xc624}: {Name: "CameraCalibration2", Type: 10, flags: 2, arrayLen: [2]int{-1, 0}, ID: 0xc624},
+	{GroupNone, 0xc625}: {Name: "ReductionMatrix1", Type: 10, flags: 2, arrayLen: [2]int{-1, 0}, ID: 0xc625},
+	{GroupNone, 0xc626}: {Name: "ReductionMatrix2", Type: 10, flags: 2, arrayLen: [2]int{-1, 0}, ID: 0xc626},
+	{GroupNone, 0xc627}: {Name: "AnalogBalance", Type: 5, flags: 2, arrayLen: [2]int{-1, 0}, ID: 0xc627},
+	{GroupNone, 0xc628}: {Name: "AsShotNeutral", Type: 5, flags: 2, arrayLen: [2]int{-1, 0}, ID: 0xc628},
+	{GroupNone, 0xc629}: {Name: "AsShotWhiteXY", Type: 5, flags: 2, arrayLen: [2]int{2, 1}, ID: 0xc629},
+	{GroupNone, 0xc62a}: {Name: "BaselineExposure", Type: 10, flags: 2, arrayLen: [2]int{-1, 1}, ID: 0xc62a},
+	{GroupNone, 0xc62b}: {Name: "BaselineNoise", Type: 5, flags: 2, arrayLen: [2]int{-1, 1}, ID: 0xc62b},
+	{GroupNone, 0xc62c}: {Name: "BaselineSharpness", Type: 5, flags: 2, arrayLen: [2]int{-1, 1}, ID: 0xc62c},
+	{GroupNone, 0xc62d}: {Name: "BayerGreenSplit", Type: 4, flags: 2, arrayLen: [2]int{-1, 1}, ID: 0xc62d},
+	{GroupNone, 0xc62e}: {Name: "LinearResponseLimit", Type: 5, flags: 2, arrayLen: [2]int{-1, 1}, ID: 0xc62e},
+	{GroupNone, 0xc62f}: {Name: "CameraSerialNumber", Type: 2, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0xc62f},
+	{GroupNone, 0xc630}: {Name: "DNGLensInfo", Type: 5, flags: 0, arrayLen: [2]int{4, 1}, ID: 0xc630},
+	{GroupNone, 0xc631}: {Name: "ChromaBlurRadius", Type: 5, flags: 2, arrayLen: [2]int{-1, 1}, ID: 0xc631},
+	{GroupNone, 0xc632}: {Name: "AntiAliasStrength", Type: 5, flags: 2, arrayLen: [2]int{-1, 1}, ID: 0xc632},
+	{GroupNone, 0xc633}: {Name: "ShadowScale", Type: 5, flags: 2, arrayLen: [2]int{-1, 1}, ID: 0xc633},
+	{GroupNone, 0xc634}: {Name: "SR2Private", Type: 0, flags: 0, arrayLen: [2]int{-1, -1}, ID: 0xc634},
+	{GroupNone, 0xc635}: {Name: "MakerNoteSafety", Type: 3, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0xc635, enum: []int64{0, 1}, enumString: []string{"Unsafe", "Safe"}},
+	{GroupNone, 0xc640}: {Name: "RawImageSegmentation", Type: 0, flags: 0, arrayLen: [2]int{-1, -1}, ID: 0xc640},
+	{GroupNone, 0xc65a}: {Name: "CalibrationIlluminant1", Type: 3, flags: 2, arrayLen: [2]int{-1, 1}, ID: 0xc65a},
+	{GroupNone, 0xc65b}: {Name: "CalibrationIlluminant2", Type: 3, flags: 2, arrayLen: [2]int{-1, 1}, ID: 0xc65b},
+	{GroupNone, 0xc65c}: {Name: "BestQualityScale", Type: 5, flags: 2, arrayLen: [2]int{-1, 1}, ID: 0xc65c},
+	{GroupNone, 0xc65d}: {Name: "RawDataUniqueID", Type: 1, flags: 2, arrayLen: [2]int{16, 1}, ID: 0xc65d},
+	{GroupNone, 0xc660}: {Name: "AliasLayerMetadata", Type: 0, flags: 0, arrayLen: [2]int{-1, -1}, ID: 0xc660},
+	{GroupNone, 0xc68b}: {Name: "OriginalRawFileName", Type: 2, flags: 2, arrayLen: [2]int{-1, 1}, ID: 0xc68b},
+	{GroupNone, 0xc68c}: {Name: "OriginalRawFileData", Type: 7, flags: 2, arrayLen: [2]int{-1, 1}, ID: 0xc68c},
+	{GroupNone, 0xc68d}: {Name: "ActiveArea", Type: 4, flags: 2, arrayLen: [2]int{4, 1}, ID: 0xc68d},
+	{GroupNone, 0xc68e}: {Name: "MaskedAreas", Type: 4, flags: 2, arrayLen: [2]int{-1, 0}, ID: 0xc68e},
+	{GroupNone, 0xc68f}: {Name: "AsShotICCProfile", Type: 7, flags: 2, arrayLen: [2]int{-1, 1}, ID: 0xc68f},
+	{GroupNone, 0xc690}: {Name: "AsShotPreProfileMatrix", Type: 10, flags: 2, arrayLen: [2]int{-1, 0}, ID: 0xc690},
+	{GroupNone, 0xc691}: {Name: "CurrentICCProfile", Type: 7, flags: 2, arrayLen: [2]int{-1, 1}, ID: 0xc691},
+	{GroupNone, 0xc692}: {Name: "CurrentPreProfileMatrix", Type: 10, flags: 2, arrayLen: [2]int{-1, 0}, ID: 0xc692},
+	{GroupNone, 0xc6bf}: {Name: "ColorimetricReference", Type: 3, flags: 2, arrayLen: [2]int{-1, 1}, ID: 0xc6bf},
+	{GroupNone, 0xc6c5}: {Name: "SRawType", Type: 0, flags: 0, arrayLen: [2]int{-1, -1}, ID: 0xc6c5},
+	{GroupNone, 0xc6d2}: {Name: "PanasonicTitle", Type: 7, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0xc6d2},
+	{GroupNone, 0xc6d3}: {Name: "PanasonicTitle2", Type: 7, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0xc6d3},
+	{GroupNone, 0xc6f3}: {Name: "CameraCalibrationSig", Type: 2, flags: 2, arrayLen: [2]int{-1, 1}, ID: 0xc6f3},
+	{GroupNone, 0xc6f4}: {Name: "ProfileCalibrationSig", Type: 2, flags: 2, arrayLen: [2]int{-1, 1}, ID: 0xc6f4},
+	{GroupNone, 0xc6f5}: {Name: "ProfileIFD", Type: 0, flags: 0, arrayLen: [2]int{-1, -1}, ID: 0xc6f5},
+	{GroupNone, 0xc6f6}: {Name: "AsShotProfileName", Type: 2, flags: 2, arrayLen: [2]int{-1, 1}, ID: 0xc6f6},
+	{GroupNone, 0xc6f7}: {Name: "NoiseReductionApplied", Type: 5, flags: 2, arrayLen: [2]int{-1, 1}, ID: 0xc6f7},
+	{GroupNone, 0xc6f8}: {Name: "ProfileName", Type: 2, flags: 2, arrayLen: [2]int{-1, 1}, ID: 0xc6f8},
+	{GroupNone, 0xc6f9}: {Name: "ProfileHueSatMapDims", Type: 4, flags: 2, arrayLen: [2]int{3, 1}, ID: 0xc6f9},
+	{GroupNone, 0xc6fa}: {Name: "ProfileHueSatMapData1", Type: 11, flags: 2, arrayLen: [2]int{-1, 0}, ID: 0xc6fa},
+	{GroupNone, 0xc6fb}: {Name: "ProfileHueSatMapData2", Type: 11, flags: 2, arrayLen: [2]int{-1, 0}, ID: 0xc6fb},
+	{GroupNone, 0xc6fc}: {Name: "ProfileToneCurve", Type: 11, flags: 2, arrayLen: [2]int{-1, 0}, ID: 0xc6fc},
+	{GroupNone, 0xc6fd}: {Name: "ProfileEmbedPolicy", Type: 4, flags: 2, arrayLen: [2]int{-1, 1}, ID: 0xc6fd, enum: []int64{0, 1, 2, 3}, enumString: []string{"Allow Copying", "Embed if Used", "Never Embed", "No Restrictions"}},
+	{GroupNone, 0xc6fe}: {Name: "ProfileCopyright", Type: 2, flags: 2, arrayLen: [2]int{-1, 1}, ID: 0xc6fe},
+	{GroupNone, 0xc714}: {Name: "ForwardMatrix1", Type: 10, flags: 2, arrayLen: [2]int{-1, 0}, ID: 0xc714},
+	{GroupNone, 0xc715}: {Name: "ForwardMatrix2", Type: 10, flags: 2, arrayLen: [2]int{-1, 0}, ID: 0xc715},
+	{GroupNone, 0xc716}: {Name: "PreviewApplicationName", Type: 2, flags: 2, arrayLen: [2]int{-1, 1}, ID: 0xc716},
+	{GroupNone, 0xc717}: {Name: "PreviewApplicationVersion", Type: 2, flags: 2, arrayLen: [2]int{-1, 1}, ID: 0xc717},
+	{GroupNone, 0xc718}: {Name: "PreviewSettingsName", Type: 2, flags: 2, arrayLen: [2]int{-1, 1}, ID: 0xc718},
+	{GroupNone, 0xc719}: {Name: "PreviewSettingsDigest", Type: 1, flags: 2, arrayLen: [2]int{-1, 1}, ID: 0xc719},
+	{GroupNone, 0xc71a}: {Name: "PreviewColorSpace", Type: 4, flags: 2, arrayLen: [2]int{-1, 1}, ID: 0xc71a, enum: []int64{0, 1, 2, 3, 4}, enumString: []string{"Unknown", "Gray Gamma 2.2", "sRGB", "Adobe RGB", "ProPhoto RGB"}},
+	{GroupNone, 0xc71b}: {Name: "PreviewDateTime", Type: 2, flags: 2, arrayLen: [2]int{-1, 1}, ID: 0xc71b},
+	{GroupNone, 0xc71c}: {Name: "RawImageDigest", Type: 1, flags: 2, arrayLen: [2]int{16, 1}, ID: 0xc71c},
+	{GroupNone, 0xc71d}: {Name: "OriginalRawFileDigest", Type: 1, flags: 2, arrayLen: [2]int{16, 1}, ID: 0xc71d},
+	{GroupNone, 0xc71e}: {Name: "SubTileBlockSize", Type: 0, flags: 0, arrayLen: [2]int{-1, -1}, ID: 0xc71e},
+	{GroupNone, 0xc71f}: {Name: "RowInterleaveFactor", Type: 0, flags: 0, arrayLen: [2]int{-1, -1}, ID: 0xc71f},
+	{GroupNone, 0xc725}: {Name: "ProfileLookTableDims", Type: 4, flags: 2, arrayLen: [2]int{3, 1}, ID: 0xc725},
+	{GroupNone, 0xc726}: {Name: "ProfileLookTableData", Type: 11, flags: 2, arrayLen: [2]int{-1, 0}, ID: 0xc726},
+	{GroupNone, 0xc740}: {Name: "OpcodeList1", Type: 7, flags: 12, arrayLen: [2]int{-1, 1}, ID: 0xc740, enum: []int64{1, 2, 3, 4, 5, 6, 7}, enumString: []string{"WarpRectilinear", "WarpFisheye", "FixVignetteRadial", "FixBadPixelsConstant", "FixBadPixelsList", "TrimBounds", "MapTable"}},
+	{GroupNone, 0xc741}: {Name: "OpcodeList2", Type: 7, flags: 12, arrayLen: [2]int{-1, 1}, ID: 0xc741, enum: []int64{1, 2, 3, 4, 5, 6, 7}, enumString: []string{"WarpRectilinear", "WarpFisheye", "FixVignetteRadial", "FixBadPixelsConstant", "FixBadPixelsList", "TrimBounds", "MapTable"}},
+	{GroupNone, 0xc74e}: {Name: "OpcodeList3", Type: 7, flags: 12, arrayLen: [2]int{-1, 1}, ID: 0xc74e, enum: []int64{1, 2, 3, 4, 5, 6, 7}, enumString: []string{"WarpRectilinear", "WarpFisheye", "FixVignetteRadial", "FixBadPixelsConstant", "FixBadPixelsList", "TrimBounds", "MapTable"}},
+	{GroupNone, 0xc761}: {Name: "NoiseProfile", Type: 12, flags: 2, arrayLen: [2]int{-1, 0}, ID: 0xc761},
+	{GroupNone, 0xc763}: {Name: "TimeCodes", Type: 1, flags: 0, arrayLen: [2]int{-1, 0}, ID: 0xc763},
+	{GroupNone, 0xc764}: {Name: "FrameRate", Type: 10, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0xc764},
+	{GroupNone, 0xc772}: {Name: "TStop", Type: 5, flags: 0, arrayLen: [2]int{-1, 0}, ID: 0xc772},
+	{GroupNone, 0xc789}: {Name: "ReelName", Type: 2, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0xc789},
+	{GroupNone, 0xc791}: {Name: "OriginalDefaultFinalSize", Type: 4, flags: 2, arrayLen: [2]int{2, 1}, ID: 0xc791},
+	{GroupNone, 0xc792}: {Name: "OriginalBestQualitySize", Type: 4, flags: 2, arrayLen: [2]int{2, 1}, ID: 0xc792},
+	{GroupNone, 0xc793}: {Name: "OriginalDefaultCropSize", Type: 5, flags: 2, arrayLen: [2]int{2, 1}, ID: 0xc793},
+	{GroupNone, 0xc7a1}: {Name: "CameraLabel", Type: 2, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0xc7a1},
+	{GroupNone, 0xc7a3}: {Name: "ProfileHueSatMapEncoding", Type: 4, flags: 2, arrayLen: [2]int{-1, 1}, ID: 0xc7a3, enum: []int64{0, 1}, enumString: []string{"Linear", "sRGB"}},
+	{GroupNone, 0xc7a4}: {Name: "ProfileLookTableEncoding", Type: 4, flags: 2, arrayLen: [2]int{-1, 1}, ID: 0xc7a4, enum: []int64{0, 1}, enumString: []string{"Linear", "sRGB"}},
+	{GroupNone, 0xc7a5}: {Name: "BaselineExposureOffset", Type: 10, flags: 2, arrayLen: [2]int{-1, 1}, ID: 0xc7a5},
+	{GroupNone, 0xc7a6}: {Name: "DefaultBlackRender", Type: 4, flags: 2, arrayLen: [2]int{-1, 1}, ID: 0xc7a6, enum: []int64{0, 1}, enumString: []string{"Auto", "None"}},
+	{GroupNone, 0xc7a7}: {Name: "NewRawImageDigest", Type: 1, flags: 2, arrayLen: [2]int{16, 1}, ID: 0xc7a7},
+	{GroupNone, 0xc7a8}: {Name: "RawToPreviewGain", Type: 12, flags: 2, arrayLen: [2]int{-1, 1}, ID: 0xc7a8},
+	{GroupNone, 0xc7aa}: {Name: "CacheVersion", Type: 4, flags: 2, arrayLen: [2]int{-1, 1}, ID: 0xc7aa},
+	{GroupNone, 0xc7b5}: {Name: "DefaultUserCrop", Type: 5, flags: 2, arrayLen: [2]int{4, 1}, ID: 0xc7b5},
+	{GroupNone, 0xc7d5}: {Name: "NikonNEFInfo", Type: 0, flags: 0, arrayLen: [2]int{-1, -1}, ID: 0xc7d5},
+	{GroupNone, 0xc7e9}: {Name: "DepthFormat", Type: 3, flags: 2, arrayLen: [2]int{-1, 1}, ID: 0xc7e9, enum: []int64{0, 1, 2}, enumString: []string{"Unknown", "Linear", "Inverse"}},
+	{GroupNone, 0xc7ea}: {Name: "DepthNear", Type: 5, flags: 2, arrayLen: [2]int{-1, 1}, ID: 0xc7ea},
+	{GroupNone, 0xc7eb}: {Name: "DepthFar", Type: 5, flags: 2, arrayLen: [2]int{-1, 1}, ID: 0xc7eb},
+	{GroupNone, 0xc7ec}: {Name: "DepthUnits", Type: 3, flags: 2, arrayLen: [2]int{-1, 1}, ID: 0xc7ec, enum: []int64{0, 1}, enumString: []string{"Unknown", "Meters"}},
+	{GroupNone, 0xc7ed}: {Name: "DepthMeasureType", Type: 3, flags: 2, arrayLen: [2]int{-1, 1}, ID: 0xc7ed, enum: []int64{0, 1, 2}, enumString: []string{"Unknown", "Optical Axis", "Optical Ray"}},
+	{GroupNone, 0xc7ee}: {Name: "EnhanceParams", Type: 2, flags: 2, arrayLen: [2]int{-1, 1}, ID: 0xc7ee},
+	{GroupNone, 0xcd2d}: {Name: "ProfileGainTableMap", Type: 7, flags: 2, arrayLen: [2]int{-1, 1}, ID: 0xcd2d},
+	{GroupNone, 0xcd2e}: {Name: "SemanticName", Type: 0, flags: 0, arrayLen: [2]int{-1, -1}, ID: 0xcd2e},
+	{GroupNone, 0xcd30}: {Name: "SemanticInstanceID", Type: 0, flags: 0, arrayLen: [2]int{-1, -1}, ID: 0xcd30},
+	{GroupNone, 0xcd31}: {Name: "CalibrationIlluminant3", Type: 3, flags: 2, arrayLen: [2]int{-1, 1}, ID: 0xcd31},
+	{GroupNone, 0xcd32}: {Name: "CameraCalibration3", Type: 10, flags: 2, arrayLen: [2]int{-1, 0}, ID: 0xcd32},
+	{GroupNone, 0xcd33}: {Name: "ColorMatrix3", Type: 10, flags: 2, arrayLen: [2]int{-1, 0}, ID: 0xcd33},
+	{GroupNone, 0xcd34}: {Name: "ForwardMatrix3", Type: 10, flags: 2, arrayLen: [2]int{-1, 0}, ID: 0xcd34},
+	{GroupNone, 0xcd35}: {Name: "IlluminantData1", Type: 7, flags: 2, arrayLen: [2]int{-1, 1}, ID: 0xcd35},
+	{GroupNone, 0xcd36}: {Name: "IlluminantData2", Type: 7, flags: 2, arrayLen: [2]int{-1, 1}, ID: 0xcd36},
+	{GroupNone, 0xcd37}: {Name: "IlluminantData3", Type: 7, flags: 2, arrayLen: [2]int{-1, 1}, ID: 0xcd37},
+	{GroupNone, 0xcd38}: {Name: "MaskSubArea", Type: 0, flags: 0, arrayLen: [2]int{-1, -1}, ID: 0xcd38},
+	{GroupNone, 0xcd39}: {Name: "ProfileHueSatMapData3", Type: 11, flags: 2, arrayLen: [2]int{-1, 0}, ID: 0xcd39},
+	{GroupNone, 0xcd3a}: {Name: "ReductionMatrix3", Type: 10, flags: 2, arrayLen: [2]int{-1, 0}, ID: 0xcd3a},
+	{GroupNone, 0xcd3b}: {Name: "RGBTables", Type: 7, flags: 2, arrayLen: [2]int{-1, 1}, ID: 0xcd3b},
+	{GroupNone, 0xea1c}: {Name: "Padding", Type: 7, flags: 2, arrayLen: [2]int{-1, 1}, ID: 0xea1c},
+	{GroupNone, 0xea1d}: {Name: "OffsetSchema", Type: 9, flags: 2, arrayLen: [2]int{-1, 1}, ID: 0xea1d},
+	{GroupNone, 0xfde8}: {Name: "OwnerName", Type: 2, flags: 8, arrayLen: [2]int{-1, 1}, ID: 0xfde8},
+	{GroupNone, 0xfde9}: {Name: "SerialNumber", Type: 2, flags: 8, arrayLen: [2]int{-1, 1}, ID: 0xfde9},
+	{GroupNone, 0xfdea}: {Name: "Lens", Type: 2, flags: 8, arrayLen: [2]int{-1, 1}, ID: 0xfdea},
+	{GroupNone, 0xfe00}: {Name: "KDC_IFD", Type: 0, flags: 0, arrayLen: [2]int{-1, -1}, ID: 0xfe00},
+	{GroupNone, 0xfe4c}: {Name: "RawFile", Type: 2, flags: 8, arrayLen: [2]int{-1, 1}, ID: 0xfe4c},
+	{GroupNone, 0xfe4d}: {Name: "Converter", Type: 2, flags: 8, arrayLen: [2]int{-1, 1}, ID: 0xfe4d},
+	{GroupNone, 0xfe4e}: {Name: "WhiteBalance", Type: 2, flags: 8, arrayLen: [2]int{-1, 1}, ID: 0xfe4e},
+	{GroupNone, 0xfe51}: {Name: "Exposure", Type: 2, flags: 8, arrayLen: [2]int{-1, 1}, ID: 0xfe51},
+	{GroupNone, 0xfe52}: {Name: "Shadows", Type: 2, flags: 8, arrayLen: [2]int{-1, 1}, ID: 0xfe52},
+	{GroupNone, 0xfe53}: {Name: "Brightness", Type: 2, flags: 8, arrayLen: [2]int{-1, 1}, ID: 0xfe53},
+	{GroupNone, 0xfe54}: {Name: "Contrast", Type: 2, flags: 8, arrayLen: [2]int{-1, 1}, ID: 0xfe54},
+	{GroupNone, 0xfe55}: {Name: "Saturation", Type: 2, flags: 8, arrayLen: [2]int{-1, 1}, ID: 0xfe55},
+	{GroupNone, 0xfe56}: {Name: "Sharpness", Type: 2, flags: 8, arrayLen: [2]int{-1, 1}, ID: 0xfe56},
+	{GroupNone, 0xfe57}: {Name: "Smoothness", Type: 2, flags: 8, arrayLen: [2]int{-1, 1}, ID: 0xfe57},
+	{GroupNone, 0xfe58}: {Name: "MoireFilter", Type: 2, flags: 8, arrayLen: [2]int{-1, 1}, ID: 0xfe58},
+	{GroupGPS, 0x0000}:  {Name: "GPSVersionID", Type: 1, flags: 1, arrayLen: [2]int{4, 1}, ID: 0x0000},
+	{GroupGPS, 0x0001}:  {Name: "GPSLatitudeRef", Type: 2, flags: 0, arrayLen: [2]int{2, 1}, ID: 0x0001},
+	{GroupGPS, 0x0002}:  {Name: "GPSLatitude", Type: 5, flags: 0, arrayLen: [2]int{3, 1}, ID: 0x0002},
+	{GroupGPS, 0x0003}:  {Name: "GPSLongitudeRef", Type: 2, flags: 0, arrayLen: [2]int{2, 1}, ID: 0x0003},
+	{GroupGPS, 0x0004}:  {Name: "GPSLongitude", Type: 5, flags: 0, arrayLen: [2]int{3, 1}, ID: 0x0004},
+	{GroupGPS, 0x0005}:  {Name: "GPSAltitudeRef", Type: 1, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x0005, enum: []int64{0, 1}, enumString: []string{"Above Sea Level", "Below Sea Level"}},
+	{GroupGPS, 0x0006}:  {Name: "GPSAltitude", Type: 5, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x0006},
+	{GroupGPS, 0x0007}:  {Name: "GPSTimeStamp", Type: 5, flags: 0, arrayLen: [2]int{3, 1}, ID: 0x0007},
+	{GroupGPS, 0x0008}:  {Name: "GPSSatellites", Type: 2, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x0008},
+	{GroupGPS, 0x0009}:  {Name: "GPSStatus", Type: 2, flags: 0, arrayLen: [2]int{2, 1}, ID: 0x0009},
+	{GroupGPS, 0x000a}:  {Name: "GPSMeasureMode", Type: 2, flags: 0, arrayLen: [2]int{2, 1}, ID: 0x000a, enum: []int64{2, 3}, enumString: []string{"2-Dimensional Measurement", "3-Dimensional Measurement"}},
+	{GroupGPS, 0x000b}:  {Name: "GPSDOP", Type: 5, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x000b},
+	{GroupGPS, 0x000c}:  {Name: "GPSSpeedRef", Type: 2, flags: 0, arrayLen: [2]int{2, 1}, ID: 0x000c},
+	{GroupGPS, 0x000d}:  {Name: "GPSSpeed", Type: 5, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x000d},
+	{GroupGPS, 0x000e}:  {Name: "GPSTrackRef", Type: 2, flags: 0, arrayLen: [2]int{2, 1}, ID: 0x000e},
+	{GroupGPS, 0x000f}:  {Name: "GPSTrack", Type: 5, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x000f},
+	{GroupGPS, 0x0010}:  {Name: "GPSImgDirectionRef", Type: 2, flags: 0, arrayLen: [2]int{2, 1}, ID: 0x0010},
+	{GroupGPS, 0x0011}:  {Name: "GPSImgDirection", Type: 5, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x0011},
+	{GroupGPS, 0x0012}:  {Name: "GPSMapDatum", Type: 2, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x0012},
+	{GroupGPS, 0x0013}:  {Name: "GPSDestLatitudeRef", Type: 2, flags: 0, arrayLen: [2]int{2, 1}, ID: 0x0013},
+	{GroupGPS, 0x0014}:  {Name: "GPSDestLatitude", Type: 5, flags: 0, arrayLen: [2]int{3, 1}, ID: 0x0014},
+	{GroupGPS, 0x0015}:  {Name: "GPSDestLongitudeRef", Type: 2, flags: 0, arrayLen: [2]int{2, 1}, ID: 0x0015},
+	{GroupGPS, 0x0016}:  {Name: "GPSDestLongitude", Type: 5, flags: 0, arrayLen: [2]int{3, 1}, ID: 0x0016},
+	{GroupGPS, 0x0017}:  {Name: "GPSDestBearingRef", Type: 2, flags: 0, arrayLen: [2]int{2, 1}, ID: 0x0017},
+	{GroupGPS, 0x0018}:  {Name: "GPSDestBearing", Type: 5, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x0018},
+	{GroupGPS, 0x0019}:  {Name: "GPSDestDistanceRef", Type: 2, flags: 0, arrayLen: [2]int{2, 1}, ID: 0x0019},
+	{GroupGPS, 0x001a}:  {Name: "GPSDestDistance", Type: 5, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x001a},
+	{GroupGPS, 0x001b}:  {Name: "GPSProcessingMethod", Type: 7, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x001b},
+	{GroupGPS, 0x001c}:  {Name: "GPSAreaInformation", Type: 7, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x001c},
+	{GroupGPS, 0x001d}:  {Name: "GPSDateStamp", Type: 2, flags: 0, arrayLen: [2]int{11, 1}, ID: 0x001d},
+	{GroupGPS, 0x001e}:  {Name: "GPSDifferential", Type: 3, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x001e, enum: []int64{0, 1}, enumString: []string{"No Correction", "Differential Corrected"}},
+	{GroupGPS, 0x001f}:  {Name: "GPSHPositioningError", Type: 5, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x001f},
+
+	// Canon maker note tags.
+	{GroupCanon, 0x0001}: {Name: "CanonCameraSettings", Type: 8, flags: 0, arrayLen: [2]int{-1, 0}, ID: 0x0001},
+	{GroupCanon, 0x0002}: {Name: "CanonFocalLength", Type: 3, flags: 0, arrayLen: [2]int{4, 1}, ID: 0x0002},
+	{GroupCanon, 0x0003}: {Name: "CanonFlashInfo", Type: 3, flags: 0, arrayLen: [2]int{4, 1}, ID: 0x0003},
+	{GroupCanon, 0x0004}: {Name: "CanonShotInfo", Type: 8, flags: 0, arrayLen: [2]int{-1, 0}, ID: 0x0004},
+	{GroupCanon, 0x0005}: {Name: "CanonPanorama", Type: 8, flags: 0, arrayLen: [2]int{-1, 0}, ID: 0x0005},
+	{GroupCanon, 0x0006}: {Name: "CanonImageType", Type: 2, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x0006},
+	{GroupCanon, 0x0007}: {Name: "CanonFirmwareVersion", Type: 2, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x0007},
+	{GroupCanon, 0x0008}: {Name: "FileNumber", Type: 4, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x0008},
+	{GroupCanon, 0x0009}: {Name: "OwnerName", Type: 2, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x0009},
+	{GroupCanon, 0x000c}: {Name: "SerialNumber", Type: 4, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x000c},
+	{GroupCanon, 0x000d}: {Name: "CanonCameraInfo", Type: 4, flags: 0, arrayLen: [2]int{-1, 0}, ID: 0x000d},
+	{GroupCanon, 0x000e}: {Name: "CanonFileLength", Type: 4, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x000e},
+	{GroupCanon, 0x000f}: {Name: "CustomFunctions", Type: 3, flags: 0, arrayLen: [2]int{-1, 0}, ID: 0x000f},
+	{GroupCanon, 0x0010}: {Name: "CanonModelID", Type: 4, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x0010},
+	{GroupCanon, 0x0011}: {Name: "MovieInfo", Type: 8, flags: 0, arrayLen: [2]int{-1, 0}, ID: 0x0011},
+	{GroupCanon, 0x0012}: {Name: "CanonAFInfo", Type: 3, flags: 0, arrayLen: [2]int{-1, 0}, ID: 0x0012},
+	{GroupCanon, 0x0013}: {Name: "ThumbnailImageValidArea", Type: 3, flags: 0, arrayLen: [2]int{4, 1}, ID: 0x0013},
+	{GroupCanon, 0x0015}: {Name: "SerialNumberFormat", Type: 4, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x0015, enum: []int64{2415919104, 2684354560}, enumString: []string{"Format 1", "Format 2"}},
+	{GroupCanon, 0x001a}: {Name: "SuperMacro", Type: 3, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x001a, enum: []int64{0, 1, 2}, enumString: []string{"Off", "On (1)", "On (2)"}},
+	{GroupCanon, 0x001c}: {Name: "DateStampMode", Type: 3, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x001c, enum: []int64{0, 1, 2}, enumString: []string{"Off", "Date", "Date & Time"}},
+	{GroupCanon, 0x001d}: {Name: "MyColors", Type: 3, flags: 0, arrayLen: [2]int{-1, 0}, ID: 0x001d},
+	{GroupCanon, 0x001e}: {Name: "FirmwareRevision", Type: 4, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x001e},
+	{GroupCanon, 0x0023}: {Name: "Categories", Type: 4, flags: 0, arrayLen: [2]int{2, 1}, ID: 0x0023},
+	{GroupCanon, 0x0024}: {Name: "FaceDetect1", Type: 3, flags: 0, arrayLen: [2]int{-1, 0}, ID: 0x0024},
+	{GroupCanon, 0x0025}: {Name: "FaceDetect2", Type: 1, flags: 0, arrayLen: [2]int{-1, 0}, ID: 0x0025},
+	{GroupCanon, 0x0026}: {Name: "CanonAFInfo2", Type: 3, flags: 0, arrayLen: [2]int{-1, 0}, ID: 0x0026},
+	{GroupCanon, 0x0028}: {Name: "ImageUniqueID", Type: 1, flags: 0, arrayLen: [2]int{16, 1}, ID: 0x0028},
+	{GroupCanon, 0x002f}: {Name: "FaceDetect3", Type: 3, flags: 0, arrayLen: [2]int{-1, 0}, ID: 0x002f},
+	{GroupCanon, 0x0035}: {Name: "TimeInfo", Type: 9, flags: 0, arrayLen: [2]int{-1, 0}, ID: 0x0035},
+	{GroupCanon, 0x0038}: {Name: "BatteryType", Type: 7, flags: 0, arrayLen: [2]int{-1, 0}, ID: 0x0038},
+	{GroupCanon, 0x003c}: {Name: "AFInfo3", Type: 3, flags: 0, arrayLen: [2]int{-1, 0}, ID: 0x003c},
+	{GroupCanon, 0x0081}: {Name: "RawDataOffset", Type: 4, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x0081},
+	{GroupCanon, 0x0083}: {Name: "OriginalDecisionDataOffset", Type: 4, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x0083},
+	{GroupCanon, 0x0090}: {Name: "CustomFunctions1D", Type: 3, flags: 0, arrayLen: [2]int{-1, 0}, ID: 0x0090},
+	{GroupCanon, 0x0091}: {Name: "PersonalFunctions", Type: 3, flags: 0, arrayLen: [2]int{-1, 0}, ID: 0x0091},
+	{GroupCanon, 0x0092}: {Name: "PersonalFunctionValues", Type: 3, flags: 0, arrayLen: [2]int{-1, 0}, ID: 0x0092},
+	{GroupCanon, 0x0093}: {Name: "CanonFileInfo", Type: 8, flags: 0, arrayLen: [2]int{-1, 0}, ID: 0x0093},
+	{GroupCanon, 0x0094}: {Name: "AFPointsInFocus1D", Type: 3, flags: 0, arrayLen: [2]int{-1, 0}, ID: 0x0094},
+	{GroupCanon, 0x0095}: {Name: "LensModel", Type: 2, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x0095},
+	{GroupCanon, 0x0096}: {Name: "InternalSerialNumber", Type: 2, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x0096},
+	{GroupCanon, 0x0097}: {Name: "DustRemovalData", Type: 7, flags: 0, arrayLen: [2]int{-1, 0}, ID: 0x0097},
+	{GroupCanon, 0x0098}: {Name: "CropInfo", Type: 3, flags: 0, arrayLen: [2]int{4, 1}, ID: 0x0098},
+	{GroupCanon, 0x0099}: {Name: "CustomFunctions2", Type: 4, flags: 0, arrayLen: [2]int{-1, 0}, ID: 0x0099},
+	{GroupCanon, 0x009a}: {Name: "AspectInfo", Type: 4, flags: 0, arrayLen: [2]int{5, 1}, ID: 0x009a},
+	{GroupCanon, 0x00a0}: {Name: "ProcessingInfo", Type: 8, flags: 0, arrayLen: [2]int{-1, 0}, ID: 0x00a0},
+	{GroupCanon, 0x00aa}: {Name: "MeasuredColor", Type: 3, flags: 0, arrayLen: [2]int{-1, 0}, ID: 0x00aa},
+	{GroupCanon, 0x00b4}: {Name: "ColorSpace", Type: 3, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x00b4, enum: []int64{1, 2}, enumString: []string{"sRGB", "Adobe RGB"}},
+	{GroupCanon, 0x00b6}: {Name: "PreviewImageInfo", Type: 4, flags: 0, arrayLen: [2]int{-1, 0}, ID: 0x00b6},
+	{GroupCanon, 0x00d0}: {Name: "VRDOffset", Type: 4, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x00d0},
+	{GroupCanon, 0x00e0}: {Name: "SensorInfo", Type: 8, flags: 0, arrayLen: [2]int{17, 1}, ID: 0x00e0},
+	{GroupCanon, 0x4001}: {Name: "ColorData", Type: 3, flags: 0, arrayLen: [2]int{-1, 0}, ID: 0x4001},
+	{GroupCanon, 0x4002}: {Name: "CRWParam", Type: 7, flags: 0, arrayLen: [2]int{-1, 0}, ID: 0x4002},
+	{GroupCanon, 0x4005}: {Name: "Flavor", Type: 7, flags: 0, arrayLen: [2]int{-1, 0}, ID: 0x4005},
+	{GroupCanon, 0x4008}: {Name: "PictureStyleUserDef", Type: 3, flags: 0, arrayLen: [2]int{3, 1}, ID: 0x4008},
+	{GroupCanon, 0x4009}: {Name: "PictureStylePC", Type: 3, flags: 0, arrayLen: [2]int{3, 1}, ID: 0x4009},
+	{GroupCanon, 0x4010}: {Name: "CustomPictureStyleFileName", Type: 2, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x4010},
+	{GroupCanon, 0x4013}: {Name: "AFMicroAdj", Type: 9, flags: 0, arrayLen: [2]int{-1, 0}, ID: 0x4013},
+	{GroupCanon, 0x4015}: {Name: "VignettingCorr", Type: 7, flags: 0, arrayLen: [2]int{-1, 0}, ID: 0x4015},
+	{GroupCanon, 0x4016}: {Name: "VignettingCorr2", Type: 9, flags: 0, arrayLen: [2]int{-1, 0}, ID: 0x4016},
+	{GroupCanon, 0x4018}: {Name: "LightingOpt", Type: 9, flags: 0, arrayLen: [2]int{-1, 0}, ID: 0x4018},
+	{GroupCanon, 0x4019}: {Name: "LensInfo", Type: 7, flags: 0, arrayLen: [2]int{-1, 0}, ID: 0x4019},
+	{GroupCanon, 0x4020}: {Name: "AmbienceInfo", Type: 9, flags: 0, arrayLen: [2]int{-1, 0}, ID: 0x4020},
+	{GroupCanon, 0x4021}: {Name: "MultiExp", Type: 9, flags: 0, arrayLen: [2]int{-1, 0}, ID: 0x4021},
+	{GroupCanon, 0x4024}: {Name: "FilterInfo", Type: 7, flags: 0, arrayLen: [2]int{-1, 0}, ID: 0x4024},
+	{GroupCanon, 0x4025}: {Name: "HDRInfo", Type: 9, flags: 0, arrayLen: [2]int{-1, 0}, ID: 0x4025},
+	{GroupCanon, 0x4028}: {Name: "AFConfig", Type: 9, flags: 0, arrayLen: [2]int{-1, 0}, ID: 0x4028},
+
+	// CanonCameraSettings maker note tags.
+	{GroupCanonCameraSettings, 0x0001}: {Name: "MacroMode", Type: 8, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x0001, enum: []int64{1, 2}, enumString: []string{"Macro", "Normal"}},
+	{GroupCanonCameraSettings, 0x0002}: {Name: "SelfTimer", Type: 8, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x0002},
+	{GroupCanonCameraSettings, 0x0003}: {Name: "Quality", Type: 8, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x0003, enum: []int64{-1, 1, 2, 3, 4, 5, 7, 130, 131}, enumString: []string{"n/a", "Economy", "Normal", "Fine", "RAW", "Superfine", "CRAW", "Light (RAW)", "Standard (RAW)"}},
+	{GroupCanonCameraSettings, 0x0004}: {Name: "CanonFlashMode", Type: 8, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x0004, enum: []int64{-1, 0, 1, 2, 3, 4, 5, 6, 16}, enumString: []string{"n/a", "Off", "Auto", "On", "Red-eye reduction", "Slow-sync", "Red-eye reduction (Auto)", "Red-eye reduction (On)", "External flash"}},
+	{GroupCanonCameraSettings, 0x0005}: {Name: "ContinuousDrive", Type: 8, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x0005, enum: []int64{0, 1, 2, 3, 4, 5, 6, 9, 10}, enumString: []string{"Single", "Continuous", "Movie", "Continuous, Speed Priority", "Continuous, Low", "Continuous, High", "Silent Single", "Single, Silent", "Continuous, Silent"}},
+	{GroupCanonCameraSettings, 0x0007}: {Name: "FocusMode", Type: 8, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x0007, enum: []int64{0, 1, 2, 3, 4, 5, 6, 16, 256, 257, 258, 512, 519}, enumString: []string{"One-shot AF", "AI Servo AF", "AI Focus AF", "Manual Focus (3)", "Single", "Continuous", "Manual Focus (6)", "Pan Focus", "One-shot AF (Live View)", "AI Servo AF (Live View)", "AI Focus AF (Live View)", "Movie Snap Focus", "Movie Servo AF"}},
+	{GroupCanonCameraSettings, 0x0009}: {Name: "RecordMode", Type: 8, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x0009, enum: []int64{1, 2, 3, 4, 5, 6, 7, 9, 10, 11, 12, 13, 14, 15}, enumString: []string{"JPEG", "CRW+THM", "AVI+THM", "TIF", "TIF+JPEG", "CR2", "CR2+JPEG", "MOV", "MP4", "CRM", "CR3", "CR3+JPEG", "HIF", "CR3+HIF"}},
+	{GroupCanonCameraSettings, 0x000a}: {Name: "CanonImageSize", Type: 8, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x000a, enum: []int64{-1, 0, 1, 2, 5, 6, 7, 8, 9, 10, 14, 15, 16}, enumString: []string{"n/a", "Large", "Medium", "Small", "Medium 1", "Medium 2", "Medium 3", "Postcard", "Widescreen", "Medium Widescreen", "Small 1", "Small 2", "Small 3"}},
+	{GroupCanonCameraSettings, 0x000b}: {Name: "EasyMode", Type: 8, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x000b, enum: []int64{0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16, 17, 18, 19, 20, 21, 22, 23}, enumString: []string{"Full auto", "Manual", "Landscape", "Fast shutter", "Slow shutter", "Night", "Gray Scale", "Sepia", "Portrait", "Sports", "Macro", "Black & White", "Pan focus", "Vivid", "Neutral", "Flash Off", "Long Shutter", "Super Macro", "Foliage", "Indoor", "Fireworks", "Beach", "Underwater", "Snow"}},
+	{GroupCanonCameraSettings, 0x000c}: {Name: "DigitalZoom", Type: 8, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x000c, enum: []int64{0, 1, 2, 3}, enumString: []string{"None", "2x", "4x", "Other"}},
+	{GroupCanonCameraSettings, 0x000d}: {Name: "Contrast", Type: 8, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x000d},
+	{GroupCanonCameraSettings, 0x000e}: {Name: "Saturation", Type: 8, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x000e},
+	{GroupCanonCameraSettings, 0x000f}: {Name: "Sharpness", Type: 8, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x000f},
+	{GroupCanonCameraSettings, 0x0010}: {Name: "CameraISO", Type: 8, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x0010},
+	{GroupCanonCameraSettings, 0x0011}: {Name: "MeteringMode", Type: 8, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x0011, enum: []int64{0, 1, 2, 3, 4, 5}, enumString: []string{"Default", "Spot", "Average", "Evaluative", "Partial", "Center-weighted average"}},
+	{GroupCanonCameraSettings, 0x0012}: {Name: "FocusRange", Type: 8, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x0012, enum: []int64{0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10}, enumString: []string{"Manual", "Auto", "Not Known", "Macro", "Very Close", "Close", "Middle Range", "Far Range", "Pan Focus", "Super Macro", "Infinity"}},
+	{GroupCanonCameraSettings, 0x0013}: {Name: "AFPoint", Type: 8, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x0013},
+	{GroupCanonCameraSettings, 0x0014}: {Name: "CanonExposureMode", Type: 8, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x0014, enum: []int64{0, 1, 2, 3, 4, 5, 6, 7, 8}, enumString: []string{"Easy", "Program AE", "Shutter speed priority AE", "Aperture-priority AE", "Manual", "Depth-of-field AE", "M-Dep", "Bulb", "Flexible-priority AE"}},
+	{GroupCanonCameraSettings, 0x0016}: {Name: "LensType", Type: 3, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x0016},
+	{GroupCanonCameraSettings, 0x0017}: {Name: "MaxFocalLength", Type: 3, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x0017},
+	{GroupCanonCameraSettings, 0x0018}: {Name: "MinFocalLength", Type: 3, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x0018},
+	{GroupCanonCameraSettings, 0x0019}: {Name: "FocalUnits", Type: 8, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x0019},
+	{GroupCanonCameraSettings, 0x001a}: {Name: "MaxAperture", Type: 8, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x001a},
+	{GroupCanonCameraSettings, 0x001b}: {Name: "MinAperture", Type: 8, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x001b},
+	{GroupCanonCameraSettings, 0x001c}: {Name: "FlashActivity", Type: 8, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x001c},
+	{GroupCanonCameraSettings, 0x001d}: {Name: "FlashBits", Type: 8, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x001d},
+	{GroupCanonCameraSettings, 0x0020}: {Name: "FocusContinuous", Type: 8, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x0020, enum: []int64{-1, 0, 1, 8}, enumString: []string{"n/a", "Single", "Continuous", "Manual"}},
+	{GroupCanonCameraSettings, 0x0021}: {Name: "AESetting", Type: 8, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x0021, enum: []int64{-1, 0, 1, 2, 3, 4}, enumString: []string{"n/a", "Normal AE", "Exposure Compensation", "AE Lock", "AE Lock + Exposure Comp.", "No AE"}},
+	{GroupCanonCameraSettings, 0x0022}: {Name: "ImageStabilization", Type: 8, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x0022, enum: []int64{-1, 0, 1, 2, 3, 4}, enumString: []string{"n/a", "Off", "On", "Shoot Only", "Panning", "Dynamic"}},
+	{GroupCanonCameraSettings, 0x0023}: {Name: "DisplayAperture", Type: 8, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x0023},
+	{GroupCanonCameraSettings, 0x0024}: {Name: "ZoomSourceWidth", Type: 8, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x0024},
+	{GroupCanonCameraSettings, 0x0025}: {Name: "ZoomTargetWidth", Type: 8, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x0025},
+	{GroupCanonCameraSettings, 0x0027}: {Name: "SpotMeteringMode", Type: 8, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x0027, enum: []int64{-1, 0, 1}, enumString: []string{"n/a", "Center", "AF Point"}},
+	{GroupCanonCameraSettings, 0x0028}: {Name: "PhotoEffect", Type: 8, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x0028, enum: []int64{-1, 0, 1, 2, 3, 4, 5, 6, 100}, enumString: []string{"n/a", "Off", "Vivid", "Neutral", "Smooth", "Sepia", "B&W", "Custom", "My Color Data"}},
+	{GroupCanonCameraSettings, 0x0029}: {Name: "ManualFlashOutput", Type: 8, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x0029, enum: []int64{0, 1280, 1282, 1284, 32767}, enumString: []string{"n/a", "Full", "Medium", "Low", "n/a"}},
+	{GroupCanonCameraSettings, 0x002a}: {Name: "ColorTone", Type: 8, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x002a},
+	{GroupCanonCameraSettings, 0x002e}: {Name: "SRAWQuality", Type: 8, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x002e, enum: []int64{-1, 0, 1, 2}, enumString: []string{"n/a", "n/a", "sRAW1 (mRAW)", "sRAW2 (sRAW)"}},
+
+	// CanonFileInfo maker note tags.
+	{GroupCanonFileInfo, 0x0001}: {Name: "ShutterCount", Type: 4, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x0001},
+	{GroupCanonFileInfo, 0x0003}: {Name: "BracketMode", Type: 8, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x0003, enum: []int64{0, 1, 2, 3, 4}, enumString: []string{"Off", "AEB", "FEB", "ISO", "WB"}},
+	{GroupCanonFileInfo, 0x0004}: {Name: "BracketValue", Type: 8, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x0004},
+	{GroupCanonFileInfo, 0x0005}: {Name: "BracketShotNumber", Type: 8, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x0005},
+	{GroupCanonFileInfo, 0x0006}: {Name: "RawJpgQuality", Type: 8, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x0006, enum: []int64{-1, 1, 2, 3, 4, 5, 7}, enumString: []string{"n/a", "Economy", "Normal", "Fine", "RAW", "Superfine", "CRAW"}},
+	{GroupCanonFileInfo, 0x0007}: {Name: "RawJpgSize", Type: 8, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x0007, enum: []int64{-1, 0, 1, 2, 5, 6, 7}, enumString: []string{"n/a", "Large", "Medium", "Small", "Medium 1", "Medium 2", "Medium 3"}},
+	{GroupCanonFileInfo, 0x0008}: {Name: "LongExposureNoiseReduction2", Type: 8, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x0008, enum: []int64{0, 1, 3, 4}, enumString: []string{"Off", "On (1D)", "On", "Auto"}},
+	{GroupCanonFileInfo, 0x0009}: {Name: "WBBracketMode", Type: 8, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x0009, enum: []int64{0, 1, 2}, enumString: []string{"Off", "On (shift AB)", "On (shift GM)"}},
+	{GroupCanonFileInfo, 0x000c}: {Name: "WBBracketValueAB", Type: 8, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x000c},
+	{GroupCanonFileInfo, 0x000d}: {Name: "WBBracketValueGM", Type: 8, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x000d},
+	{GroupCanonFileInfo, 0x000e}: {Name: "FilterEffect", Type: 8, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x000e, enum: []int64{-1, 0, 1, 2, 3, 4}, enumString: []string{"n/a", "None", "Yellow", "Orange", "Red", "Green"}},
+	{GroupCanonFileInfo, 0x000f}: {Name: "ToningEffect", Type: 8, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x000f, enum: []int64{-1, 0, 1, 2, 3, 4}, enumString: []string{"n/a", "None", "Sepia", "Blue", "Purple", "Green"}},
+	{GroupCanonFileInfo, 0x0010}: {Name: "MacroMagnification", Type: 8, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x0010},
+	{GroupCanonFileInfo, 0x0013}: {Name: "LiveViewShooting", Type: 8, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x0013, enum: []int64{0, 1}, enumString: []string{"Off", "On"}},
+	{GroupCanonFileInfo, 0x0014}: {Name: "FocusDistanceUpper", Type: 3, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x0014},
+	{GroupCanonFileInfo, 0x0015}: {Name: "FocusDistanceLower", Type: 3, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x0015},
+	{GroupCanonFileInfo, 0x0017}: {Name: "ShutterMode", Type: 8, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x0017, enum: []int64{0, 1, 2}, enumString: []string{"Mechanical", "Electronic First Curtain", "Electronic"}},
+	{GroupCanonFileInfo, 0x0019}: {Name: "FlashExposureLock", Type: 8, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x0019, enum: []int64{0, 1}, enumString: []string{"Off", "On"}},
+
+	// Nikon maker note tags.
+	{GroupNikon, 0x0001}: {Name: "MakerNoteVersion", Type: 7, flags: 0, arrayLen: [2]int{4, 1}, ID: 0x0001},
+	{GroupNikon, 0x0002}: {Name: "ISO", Type: 3, flags: 0, arrayLen: [2]int{2, 1}, ID: 0x0002},
+	{GroupNikon, 0x0003}: {Name: "ColorMode", Type: 2, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x0003},
+	{GroupNikon, 0x0004}: {Name: "Quality", Type: 2, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x0004},
+	{GroupNikon, 0x0005}: {Name: "WhiteBalance", Type: 2, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x0005},
+	{GroupNikon, 0x0006}: {Name: "Sharpness", Type: 2, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x0006},
+	{GroupNikon, 0x0007}: {Name: "FocusMode", Type: 2, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x0007},
+	{GroupNikon, 0x0008}: {Name: "FlashSetting", Type: 2, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x0008},
+	{GroupNikon, 0x0009}: {Name: "FlashType", Type: 2, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x0009},
+	{GroupNikon, 0x000b}: {Name: "WhiteBalanceFineTune", Type: 8, flags: 0, arrayLen: [2]int{-1, 0}, ID: 0x000b},
+	{GroupNikon, 0x000c}: {Name: "WB_RBLevels", Type: 5, flags: 0, arrayLen: [2]int{4, 1}, ID: 0x000c},
+	{GroupNikon, 0x000d}: {Name: "ProgramShift", Type: 7, flags: 0, arrayLen: [2]int{4, 1}, ID: 0x000d},
+	{GroupNikon, 0x000e}: {Name: "ExposureDifference", Type: 7, flags: 0, arrayLen: [2]int{4, 1}, ID: 0x000e},
+	{GroupNikon, 0x0011}: {Name: "PreviewIFD", Type: 4, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x0011},
+	{GroupNikon, 0x0012}: {Name: "FlashExposureComp", Type: 7, flags: 0, arrayLen: [2]int{4, 1}, ID: 0x0012},
+	{GroupNikon, 0x0013}: {Name: "ISOSetting", Type: 3, flags: 0, arrayLen: [2]int{2, 1}, ID: 0x0013},
+	{GroupNikon, 0x0016}: {Name: "ImageBoundary", Type: 3, flags: 0, arrayLen: [2]int{4, 1}, ID: 0x0016},
+	{GroupNikon, 0x0017}: {Name: "ExternalFlashExposureComp", Type: 7, flags: 0, arrayLen: [2]int{4, 1}, ID: 0x0017},
+	{GroupNikon, 0x0018}: {Name: "FlashExposureBracketValue", Type: 7, flags: 0, arrayLen: [2]int{4, 1}, ID: 0x0018},
+	{GroupNikon, 0x0019}: {Name: "ExposureBracketValue", Type: 10, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x0019},
+	{GroupNikon, 0x001a}: {Name: "ImageProcessing", Type: 2, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x001a},
+	{GroupNikon, 0x001b}: {Name: "CropHiSpeed", Type: 3, flags: 0, arrayLen: [2]int{7, 1}, ID: 0x001b},
+	{GroupNikon, 0x001c}: {Name: "ExposureTuning", Type: 7, flags: 0, arrayLen: [2]int{3, 1}, ID: 0x001c},
+	{GroupNikon, 0x001d}: {Name: "SerialNumber", Type: 2, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x001d},
+	{GroupNikon, 0x001e}: {Name: "ColorSpace", Type: 3, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x001e, enum: []int64{1, 2, 4}, enumString: []string{"sRGB", "Adobe RGB", "BT.2100"}},
+	{GroupNikon, 0x001f}: {Name: "VRInfo", Type: 7, flags: 0, arrayLen: [2]int{-1, 0}, ID: 0x001f},
+	{GroupNikon, 0x0020}: {Name: "ImageAuthentication", Type: 1, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x0020, enum: []int64{0, 1}, enumString: []string{"Off", "On"}},
+	{GroupNikon, 0x0022}: {Name: "ActiveDLighting", Type: 3, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x0022, enum: []int64{0, 1, 3, 5, 7, 8, 9, 10, 11, 65535}, enumString: []string{"Off", "Low", "Normal", "High", "Extra High", "Extra High 1", "Extra High 2", "Extra High 3", "Extra High 4", "Auto"}},
+	{GroupNikon, 0x0023}: {Name: "PictureControlData", Type: 7, flags: 0, arrayLen: [2]int{-1, 0}, ID: 0x0023},
+	{GroupNikon, 0x0024}: {Name: "WorldTime", Type: 7, flags: 0, arrayLen: [2]int{-1, 0}, ID: 0x0024},
+	{GroupNikon, 0x0025}: {Name: "ISOInfo", Type: 7, flags: 0, arrayLen: [2]int{-1, 0}, ID: 0x0025},
+	{GroupNikon, 0x002a}: {Name: "VignetteControl", Type: 3, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x002a, enum: []int64{0, 1, 3, 5}, enumString: []string{"Off", "Low", "Normal", "High"}},
+	{GroupNikon, 0x002b}: {Name: "DistortInfo", Type: 7, flags: 0, arrayLen: [2]int{-1, 0}, ID: 0x002b},
+	{GroupNikon, 0x0080}: {Name: "ImageAdjustment", Type: 2, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x0080},
+	{GroupNikon, 0x0081}: {Name: "ToneComp", Type: 2, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x0081},
+	{GroupNikon, 0x0082}: {Name: "AuxiliaryLens", Type: 2, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x0082},
+	{GroupNikon, 0x0083}: {Name: "LensType", Type: 1, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x0083},
+	{GroupNikon, 0x0084}: {Name: "Lens", Type: 5, flags: 0, arrayLen: [2]int{4, 1}, ID: 0x0084},
+	{GroupNikon, 0x0085}: {Name: "ManualFocusDistance", Type: 5, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x0085},
+	{GroupNikon, 0x0086}: {Name: "DigitalZoom", Type: 5, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x0086},
+	{GroupNikon, 0x0087}: {Name: "FlashMode", Type: 1, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x0087, enum: []int64{0, 1, 3, 7, 8, 9, 18}, enumString: []string{"Did Not Fire", "Fired, Manual", "Not Ready", "Fired, External", "Fired, Commander Mode", "Fired, TTL Mode", "LED Light"}},
+	{GroupNikon, 0x0088}: {Name: "AFInfo", Type: 7, flags: 0, arrayLen: [2]int{-1, 0}, ID: 0x0088},
+	{GroupNikon, 0x0089}: {Name: "ShootingMode", Type: 3, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x0089},
+	{GroupNikon, 0x008b}: {Name: "LensFStops", Type: 7, flags: 0, arrayLen: [2]int{4, 1}, ID: 0x008b},
+	{GroupNikon, 0x008c}: {Name: "ContrastCurve", Type: 7, flags: 0, arrayLen: [2]int{-1, 0}, ID: 0x008c},
+	{GroupNikon, 0x008d}: {Name: "ColorHue", Type: 2, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x008d},
+	{GroupNikon, 0x008f}: {Name: "SceneMode", Type: 2, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x008f},
+	{GroupNikon, 0x0090}: {Name: "LightSource", Type: 2, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x0090},
+	{GroupNikon, 0x0091}: {Name: "ShotInfo", Type: 7, flags: 0, arrayLen: [2]int{-1, 0}, ID: 0x0091},
+	{GroupNikon, 0x0092}: {Name: "HueAdjustment", Type: 8, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x0092},
+	{GroupNikon, 0x0093}: {Name: "NEFCompression", Type: 3, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x0093, enum: []int64{1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 13, 14}, enumString: []string{"Lossy (type 1)", "Uncompressed", "Lossless", "Lossy (type 2)", "Striped packed 12 bits", "Uncompressed (reduced to 12 bit)", "Unpacked 12 bits", "Small", "Packed 12 bits", "Packed 14 bits", "High Efficiency", "High Efficiency*"}},
+	{GroupNikon, 0x0094}: {Name: "SaturationAdj", Type: 8, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x0094},
+	{GroupNikon, 0x0095}: {Name: "NoiseReduction", Type: 2, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x0095},
+	{GroupNikon, 0x0096}: {Name: "NEFLinearizationTable", Type: 7, flags: 0, arrayLen: [2]int{-1, 0}, ID: 0x0096},
+	{GroupNikon, 0x0097}: {Name: "ColorBalance", Type: 7, flags: 0, arrayLen: [2]int{-1, 0}, ID: 0x0097},
+	{GroupNikon, 0x0098}: {Name: "LensData", Type: 7, flags: 0, arrayLen: [2]int{-1, 0}, ID: 0x0098},
+	{GroupNikon, 0x0099}: {Name: "RawImageCenter", Type: 3, flags: 0, arrayLen: [2]int{2, 1}, ID: 0x0099},
+	{GroupNikon, 0x009a}: {Name: "SensorPixelSize", Type: 5, flags: 0, arrayLen: [2]int{2, 1}, ID: 0x009a},
+	{GroupNikon, 0x009c}: {Name: "SceneAssist", Type: 2, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x009c},
+	{GroupNikon, 0x009e}: {Name: "RetouchHistory", Type: 3, flags: 0, arrayLen: [2]int{10, 1}, ID: 0x009e},
+	{GroupNikon, 0x00a2}: {Name: "ImageDataSize", Type: 4, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x00a2},
+	{GroupNikon, 0x00a5}: {Name: "ImageCount", Type: 4, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x00a5},
+	{GroupNikon, 0x00a6}: {Name: "DeletedImageCount", Type: 4, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x00a6},
+	{GroupNikon, 0x00a7}: {Name: "ShutterCount", Type: 4, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x00a7},
+	{GroupNikon, 0x00a8}: {Name: "FlashInfo", Type: 7, flags: 0, arrayLen: [2]int{-1, 0}, ID: 0x00a8},
+	{GroupNikon, 0x00a9}: {Name: "ImageOptimization", Type: 2, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x00a9},
+	{GroupNikon, 0x00aa}: {Name: "Saturation", Type: 2, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x00aa},
+	{GroupNikon, 0x00ab}: {Name: "VariProgram", Type: 2, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x00ab},
+	{GroupNikon, 0x00ac}: {Name: "ImageStabilization", Type: 2, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x00ac},
+	{GroupNikon, 0x00ad}: {Name: "AFResponse", Type: 2, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x00ad},
+	{GroupNikon, 0x00b0}: {Name: "MultiExposure", Type: 7, flags: 0, arrayLen: [2]int{-1, 0}, ID: 0x00b0},
+	{GroupNikon, 0x00b1}: {Name: "HighISONoiseReduction", Type: 3, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x00b1, enum: []int64{0, 1, 2, 3, 4, 5, 6}, enumString: []string{"Off", "Minimal", "Low", "Medium Low", "Normal", "Medium High", "High"}},
+	{GroupNikon, 0x00b3}: {Name: "ToningEffect", Type: 2, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x00b3},
+	{GroupNikon, 0x00b6}: {Name: "PowerUpTime", Type: 7, flags: 0, arrayLen: [2]int{-1, 0}, ID: 0x00b6},
+	{GroupNikon, 0x00b7}: {Name: "AFInfo2", Type: 7, flags: 0, arrayLen: [2]int{-1, 0}, ID: 0x00b7},
+	{GroupNikon, 0x00b8}: {Name: "FileInfo", Type: 7, flags: 0, arrayLen: [2]int{-1, 0}, ID: 0x00b8},
+	{GroupNikon, 0x00b9}: {Name: "AFTune", Type: 7, flags: 0, arrayLen: [2]int{-1, 0}, ID: 0x00b9},
+	{GroupNikon, 0x00bb}: {Name: "RetouchInfo", Type: 7, flags: 0, arrayLen: [2]int{-1, 0}, ID: 0x00bb},
+	{GroupNikon, 0x0e00}: {Name: "PrintIM", Type: 7, flags: 0, arrayLen: [2]int{-1, 0}, ID: 0x0e00},
+	{GroupNikon, 0x0e01}: {Name: "NikonCaptureData", Type: 7, flags: 0, arrayLen: [2]int{-1, 0}, ID: 0x0e01},
+	{GroupNikon, 0x0e09}: {Name: "NikonCaptureVersion", Type: 2, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x0e09},
+	{GroupNikon, 0x0e0e}: {Name: "NikonCaptureOffsets", Type: 7, flags: 0, arrayLen: [2]int{-1, 0}, ID: 0x0e0e},
+	{GroupNikon, 0x0e10}: {Name: "NikonScanIFD", Type: 7, flags: 0, arrayLen: [2]int{-1, 0}, ID: 0x0e10},
+	{GroupNikon, 0x0e1d}: {Name: "NikonICCProfile", Type: 7, flags: 0, arrayLen: [2]int{-1, 0}, ID: 0x0e1d},
+	{GroupNikon, 0x0e1e}: {Name: "NikonCaptureOutput", Type: 7, flags: 0, arrayLen: [2]int{-1, 0}, ID: 0x0e1e},
+	{GroupNikon, 0x0e22}: {Name: "NEFBitDepth", Type: 3, flags: 0, arrayLen: [2]int{4, 1}, ID: 0x0e22},
+
+	// NikonLensData maker note tags.
+	{GroupNikonLensData, 0x0000}: {Name: "LensDataVersion", Type: 2, flags: 0, arrayLen: [2]int{4, 1}, ID: 0x0000},
+	{GroupNikonLensData, 0x0004}: {Name: "ExitPupilPosition", Type: 1, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x0004},
+	{GroupNikonLensData, 0x0005}: {Name: "AFAperture", Type: 1, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x0005},
+	{GroupNikonLensData, 0x0008}: {Name: "FocusPosition", Type: 1, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x0008},
+	{GroupNikonLensData, 0x0009}: {Name: "FocusDistance", Type: 1, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x0009},
+	{GroupNikonLensData, 0x000a}: {Name: "FocalLength", Type: 1, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x000a},
+	{GroupNikonLensData, 0x000b}: {Name: "LensIDNumber", Type: 1, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x000b},
+	{GroupNikonLensData, 0x000c}: {Name: "LensFStops", Type: 1, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x000c},
+	{GroupNikonLensData, 0x000d}: {Name: "MinFocalLength", Type: 1, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x000d},
+	{GroupNikonLensData, 0x000e}: {Name: "MaxFocalLength", Type: 1, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x000e},
+	{GroupNikonLensData, 0x000f}: {Name: "MaxApertureAtMinFocal", Type: 1, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x000f},
+	{GroupNikonLensData, 0x0010}: {Name: "MaxApertureAtMaxFocal", Type: 1, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x0010},
+	{GroupNikonLensData, 0x0011}: {Name: "MCUVersion", Type: 1, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x0011},
+	{GroupNikonLensData, 0x0012}: {Name: "EffectiveMaxAperture", Type: 1, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x0012},
+	{GroupNikonLensData, 0x0100}: {Name: "LensID", Type: 2, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x0100},
+
+	// NikonShotInfo maker note tags.
+	{GroupNikonShotInfo, 0x0000}: {Name: "ShotInfoVersion", Type: 2, flags: 0, arrayLen: [2]int{4, 1}, ID: 0x0000},
+	{GroupNikonShotInfo, 0x0004}: {Name: "FirmwareVersion", Type: 2, flags: 0, arrayLen: [2]int{5, 1}, ID: 0x0004},
+
+	// Sony maker note tags.
+	{GroupSony, 0x0102}: {Name: "Quality", Type: 4, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x0102, enum: []int64{0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 4294967295}, enumString: []string{"RAW", "Super Fine", "Fine", "Standard", "Economy", "Extra Fine", "RAW + JPEG/HEIF", "Compressed RAW", "Compressed RAW + JPEG", "Light", "n/a"}},
+	{GroupSony, 0x0104}: {Name: "FlashExposureComp", Type: 10, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x0104},
+	{GroupSony, 0x0105}: {Name: "Teleconverter", Type: 4, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x0105},
+	{GroupSony, 0x0112}: {Name: "WhiteBalanceFineTune", Type: 9, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x0112},
+	{GroupSony, 0x0114}: {Name: "CameraSettings", Type: 7, flags: 0, arrayLen: [2]int{-1, 0}, ID: 0x0114},
+	{GroupSony, 0x0115}: {Name: "WhiteBalance", Type: 4, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x0115},
+	{GroupSony, 0x0116}: {Name: "ExtraInfo", Type: 7, flags: 0, arrayLen: [2]int{-1, 0}, ID: 0x0116},
+	{GroupSony, 0x0e00}: {Name: "PrintIM", Type: 7, flags: 0, arrayLen: [2]int{-1, 0}, ID: 0x0e00},
+	{GroupSony, 0x1000}: {Name: "MultiBurstMode", Type: 7, flags: 0, arrayLen: [2]int{-1, 0}, ID: 0x1000},
+	{GroupSony, 0x1001}: {Name: "MultiBurstImageWidth", Type: 3, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x1001},
+	{GroupSony, 0x1002}: {Name: "MultiBurstImageHeight", Type: 3, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x1002},
+	{GroupSony, 0x1003}: {Name: "Panorama", Type: 7, flags: 0, arrayLen: [2]int{-1, 0}, ID: 0x1003},
+	{GroupSony, 0x2001}: {Name: "PreviewImage", Type: 7, flags: 0, arrayLen: [2]int{-1, 0}, ID: 0x2001},
+	{GroupSony, 0x2002}: {Name: "Rating", Type: 4, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x2002},
+	{GroupSony, 0x2004}: {Name: "Contrast", Type: 9, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x2004},
+	{GroupSony, 0x2005}: {Name: "Saturation", Type: 9, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x2005},
+	{GroupSony, 0x2006}: {Name: "Sharpness", Type: 9, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x2006},
+	{GroupSony, 0x2007}: {Name: "Brightness", Type: 9, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x2007},
+	{GroupSony, 0x2008}: {Name: "LongExposureNoiseReduction", Type: 4, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x2008, enum: []int64{0, 1, 65535, 65536, 65537}, enumString: []string{"Off", "On (unused)", "n/a", "Off (65536)", "On (65537)"}},
+	{GroupSony, 0x2009}: {Name: "HighISONoiseReduction", Type: 3, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x2009, enum: []int64{0, 1, 2, 3, 256, 65535}, enumString: []string{"Off", "Low", "Normal", "High", "Auto", "n/a"}},
+	{GroupSony, 0x200a}: {Name: "HDR", Type: 4, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x200a},
+	{GroupSony, 0x200b}: {Name: "MultiFrameNoiseReduction", Type: 4, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x200b, enum: []int64{0, 1, 255}, enumString: []string{"Off", "On", "n/a"}},
+	{GroupSony, 0x200e}: {Name: "PictureEffect", Type: 3, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x200e},
+	{GroupSony, 0x200f}: {Name: "SoftSkinEffect", Type: 4, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x200f, enum: []int64{0, 1, 2, 3, 4294967295}, enumString: []string{"Off", "Low", "Mid", "High", "n/a"}},
+	{GroupSony, 0x2011}: {Name: "VignettingCorrection", Type: 4, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x2011, enum: []int64{0, 2, 4294967295}, enumString: []string{"Off", "Auto", "n/a"}},
+	{GroupSony, 0x2012}: {Name: "LateralChromaticAberration", Type: 4, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x2012, enum: []int64{0, 2, 4294967295}, enumString: []string{"Off", "Auto", "n/a"}},
+	{GroupSony, 0x2013}: {Name: "DistortionCorrectionSetting", Type: 4, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x2013, enum: []int64{0, 2, 4294967295}, enumString: []string{"Off", "Auto", "n/a"}},
+	{GroupSony, 0x2014}: {Name: "WBShiftAB_GM", Type: 9, flags: 0, arrayLen: [2]int{2, 1}, ID: 0x2014},
+	{GroupSony, 0x2016}: {Name: "AutoPortraitFramed", Type: 3, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x2016, enum: []int64{0, 1}, enumString: []string{"No", "Yes"}},
+	{GroupSony, 0x2017}: {Name: "FlashAction", Type: 4, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x2017, enum: []int64{0, 1, 2, 3}, enumString: []string{"Did not fire", "Flash Fired", "External Flash Fired", "Wireless Controlled Flash Fired"}},
+	{GroupSony, 0x201a}: {Name: "ElectronicFrontCurtainShutter", Type: 4, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x201a, enum: []int64{0, 1}, enumString: []string{"Off", "On"}},
+	{GroupSony, 0x201b}: {Name: "FocusMode", Type: 1, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x201b, enum: []int64{0, 2, 3, 4, 6, 7}, enumString: []string{"Manual", "AF-S", "AF-C", "AF-A", "DMF", "AF-D"}},
+	{GroupSony, 0x201c}: {Name: "AFAreaModeSetting", Type: 1, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x201c},
+	{GroupSony, 0x201d}: {Name: "FlexibleSpotPosition", Type: 3, flags: 0, arrayLen: [2]int{2, 1}, ID: 0x201d},
+	{GroupSony, 0x201e}: {Name: "AFPointSelected", Type: 1, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x201e},
+	{GroupSony, 0x2021}: {Name: "AFTracking", Type: 1, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x2021, enum: []int64{0, 1, 2}, enumString: []string{"Off", "Face tracking", "Lock On AF"}},
+	{GroupSony, 0x2023}: {Name: "MultiFrameNREffect", Type: 4, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x2023, enum: []int64{0, 1}, enumString: []string{"Normal", "High"}},
+	{GroupSony, 0x2026}: {Name: "WBShiftAB_GM_Precise", Type: 9, flags: 0, arrayLen: [2]int{2, 1}, ID: 0x2026},
+	{GroupSony, 0x2027}: {Name: "FocusLocation", Type: 3, flags: 0, arrayLen: [2]int{4, 1}, ID: 0x2027},
+	{GroupSony, 0x2028}: {Name: "VariableLowPassFilter", Type: 3, flags: 0, arrayLen: [2]int{2, 1}, ID: 0x2028},
+	{GroupSony, 0x2029}: {Name: "RAWFileType", Type: 3, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x2029, enum: []int64{0, 1, 2, 3, 65535}, enumString: []string{"Compressed RAW", "Uncompressed RAW", "Lossless Compressed RAW", "Compressed RAW (HQ)", "n/a"}},
+	{GroupSony, 0x202b}: {Name: "PrioritySetInAWB", Type: 1, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x202b, enum: []int64{0, 1, 2}, enumString: []string{"Standard", "Ambience", "White"}},
+	{GroupSony, 0x202c}: {Name: "MeteringMode2", Type: 3, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x202c},
+	{GroupSony, 0x202d}: {Name: "ExposureStandardAdjustment", Type: 10, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x202d},
+	{GroupSony, 0x202e}: {Name: "Quality2", Type: 3, flags: 0, arrayLen: [2]int{2, 1}, ID: 0x202e},
+	{GroupSony, 0x202f}: {Name: "PixelShiftInfo", Type: 7, flags: 0, arrayLen: [2]int{-1, 0}, ID: 0x202f},
+	{GroupSony, 0x2031}: {Name: "SerialNumber", Type: 2, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x2031},
+	{GroupSony, 0x2032}: {Name: "Shadows", Type: 9, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x2032},
+	{GroupSony, 0x2033}: {Name: "Highlights", Type: 9, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x2033},
+	{GroupSony, 0x2034}: {Name: "Fade", Type: 9, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x2034},
+	{GroupSony, 0x2035}: {Name: "SharpnessRange", Type: 9, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x2035},
+	{GroupSony, 0x2036}: {Name: "Clarity", Type: 9, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x2036},
+	{GroupSony, 0x2037}: {Name: "FocusFrameSize", Type: 3, flags: 0, arrayLen: [2]int{3, 1}, ID: 0x2037},
+	{GroupSony, 0x2039}: {Name: "JPEGHEIFSwitch", Type: 3, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x2039, enum: []int64{0, 1, 65535}, enumString: []string{"JPEG", "HEIF", "n/a"}},
+	{GroupSony, 0xb000}: {Name: "FileFormat", Type: 1, flags: 0, arrayLen: [2]int{4, 1}, ID: 0xb000},
+	{GroupSony, 0xb001}: {Name: "SonyModelID", Type: 3, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0xb001},
+	{GroupSony, 0xb020}: {Name: "CreativeStyle", Type: 2, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0xb020},
+	{GroupSony, 0xb021}: {Name: "ColorTemperature", Type: 4, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0xb021},
+	{GroupSony, 0xb022}: {Name: "ColorCompensationFilter", Type: 9, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0xb022},
+	{GroupSony, 0xb023}: {Name: "SceneMode", Type: 4, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0xb023},
+	{GroupSony, 0xb024}: {Name: "ZoneMatching", Type: 4, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0xb024, enum: []int64{0, 1, 2}, enumString: []string{"ISO Setting Used", "High Key", "Low Key"}},
+	{GroupSony, 0xb025}: {Name: "DynamicRangeOptimizer", Type: 4, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0xb025},
+	{GroupSony, 0xb026}: {Name: "ImageStabilization", Type: 4, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0xb026, enum: []int64{0, 1, 4294967295}, enumString: []string{"Off", "On", "n/a"}},
+	{GroupSony, 0xb027}: {Name: "LensType", Type: 4, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0xb027},
+	{GroupSony, 0xb028}: {Name: "MinoltaMakerNote", Type: 4, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0xb028},
+	{GroupSony, 0xb029}: {Name: "ColorMode", Type: 4, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0xb029},
+	{GroupSony, 0xb02a}: {Name: "LensSpec", Type: 1, flags: 0, arrayLen: [2]int{8, 1}, ID: 0xb02a},
+	{GroupSony, 0xb02b}: {Name: "FullImageSize", Type: 4, flags: 0, arrayLen: [2]int{2, 1}, ID: 0xb02b},
+	{GroupSony, 0xb02c}: {Name: "PreviewImageSize", Type: 4, flags: 0, arrayLen: [2]int{2, 1}, ID: 0xb02c},
+	{GroupSony, 0xb040}: {Name: "Macro", Type: 3, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0xb040, enum: []int64{0, 1, 2, 65535}, enumString: []string{"Off", "On", "Close Focus", "n/a"}},
+	{GroupSony, 0xb041}: {Name: "ExposureMode", Type: 3, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0xb041},
+	{GroupSony, 0xb043}: {Name: "AFAreaMode", Type: 3, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0xb043},
+	{GroupSony, 0xb044}: {Name: "AFIlluminator", Type: 3, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0xb044, enum: []int64{0, 1, 65535}, enumString: []string{"Off", "Auto", "n/a"}},
+	{GroupSony, 0xb047}: {Name: "JPEGQuality", Type: 3, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0xb047, enum: []int64{0, 1, 2, 65535}, enumString: []string{"Standard", "Fine", "Extra Fine", "n/a"}},
+	{GroupSony, 0xb048}: {Name: "FlashLevel", Type: 8, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0xb048},
+	{GroupSony, 0xb049}: {Name: "ReleaseMode", Type: 3, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0xb049},
+	{GroupSony, 0xb04a}: {Name: "SequenceNumber", Type: 3, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0xb04a},
+	{GroupSony, 0xb04b}: {Name: "AntiBlur", Type: 3, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0xb04b, enum: []int64{0, 1, 2, 65535}, enumString: []string{"Off", "On (Continuous)", "On (Shooting)", "n/a"}},
+	{GroupSony, 0xb04e}: {Name: "FocusMode2", Type: 3, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0xb04e},
+	{GroupSony, 0xb050}: {Name: "HighISONoiseReduction2", Type: 3, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0xb050},
+	{GroupSony, 0xb052}: {Name: "IntelligentAuto", Type: 3, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0xb052, enum: []int64{0, 1, 2}, enumString: []string{"Off", "On", "Advanced"}},
+	{GroupSony, 0xb054}: {Name: "WhiteBalance2", Type: 3, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0xb054},
+
+	// Fujifilm maker note tags.
+	{GroupFujifilm, 0x0000}: {Name: "Version", Type: 7, flags: 0, arrayLen: [2]int{4, 1}, ID: 0x0000},
+	{GroupFujifilm, 0x0010}: {Name: "InternalSerialNumber", Type: 2, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x0010},
+	{GroupFujifilm, 0x1000}: {Name: "Quality", Type: 2, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x1000},
+	{GroupFujifilm, 0x1001}: {Name: "Sharpness", Type: 3, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x1001, enum: []int64{0, 1, 2, 3, 4, 5, 6, 130, 132, 32768, 65535}, enumString: []string{"-4 (softest)", "-3 (very soft)", "-2 (soft)", "0 (normal)", "+2 (hard)", "+3 (very hard)", "+4 (hardest)", "-1 (medium soft)", "+1 (medium hard)", "Film Simulation", "n/a"}},
+	{GroupFujifilm, 0x1002}: {Name: "WhiteBalance", Type: 3, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x1002, enum: []int64{0, 1, 2, 256, 512, 768, 769, 770, 771, 772, 1024, 1280, 1536, 3840, 3841, 3842, 3843, 3844, 4080}, enumString: []string{"Auto", "Auto (white priority)", "Auto (ambiance priority)", "Daylight", "Cloudy", "Daylight Fluorescent", "Day White Fluorescent", "White Fluorescent", "Warm White Fluorescent", "Living Room Warm White Fluorescent", "Incandescent", "Flash", "Underwater", "Custom", "Custom2", "Custom3", "Custom4", "Custom5", "Kelvin"}},
+	{GroupFujifilm, 0x1003}: {Name: "Saturation", Type: 3, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x1003},
+	{GroupFujifilm, 0x1004}: {Name: "Contrast", Type: 3, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x1004},
+	{GroupFujifilm, 0x1005}: {Name: "ColorTemperature", Type: 3, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x1005},
+	{GroupFujifilm, 0x100a}: {Name: "WhiteBalanceFineTune", Type: 9, flags: 0, arrayLen: [2]int{2, 1}, ID: 0x100a},
+	{GroupFujifilm, 0x100b}: {Name: "NoiseReduction", Type: 3, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x100b, enum: []int64{64, 128, 256}, enumString: []string{"Low", "Normal", "n/a"}},
+	{GroupFujifilm, 0x100e}: {Name: "HighISONoiseReduction", Type: 3, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x100e},
+	{GroupFujifilm, 0x1010}: {Name: "FujiFlashMode", Type: 3, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x1010, enum: []int64{0, 1, 2, 3, 4, 16, 32768, 33056, 33568, 38976, 39008, 39040, 43296, 43552, 43808, 44320, 44576, 44832, 51488, 51744, 52000, 52512, 52768, 53024, 59680}, enumString: []string{"Auto", "On", "Off", "Red-eye reduction", "External", "Commander", "Not Attached", "TTL", "TTL Auto - Did not fire", "Manual", "Flash Commander", "Multi-flash", "1st Curtain (front)", "TTL Slow - 1st Curtain (front)", "TTL Auto - 1st Curtain (front)", "TTL - Red-eye Flash - 1st Curtain (front)", "TTL Slow - Red-eye Flash - 1st Curtain (front)", "TTL Auto - Red-eye Flash - 1st Curtain (front)", "2nd Curtain (rear)", "TTL Slow - 2nd Curtain (rear)", "TTL Auto - 2nd Curtain (rear)", "TTL - Red-eye Flash - 2nd Curtain (rear)", "TTL Slow - Red-eye Flash - 2nd Curtain (rear)", "TTL Auto - Red-eye Flash - 2nd Curtain (rear)", "High Speed Sync (HSS)"}},
+	{GroupFujifilm, 0x1011}: {Name: "FlashExposureComp", Type: 10, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x1011},
+	{GroupFujifilm, 0x1020}: {Name: "Macro", Type: 3, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x1020, enum: []int64{0, 1}, enumString: []string{"Off", "On"}},
+	{GroupFujifilm, 0x1021}: {Name: "FocusMode", Type: 3, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x1021, enum: []int64{0, 1, 65535}, enumString: []string{"Auto", "Manual", "Movie"}},
+	{GroupFujifilm, 0x1022}: {Name: "AFMode", Type: 3, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x1022, enum: []int64{0, 1, 256, 512}, enumString: []string{"No", "Single Point", "Zone", "Wide/Tracking"}},
+	{GroupFujifilm, 0x1023}: {Name: "FocusPixel", Type: 3, flags: 0, arrayLen: [2]int{2, 1}, ID: 0x1023},
+	{GroupFujifilm, 0x1030}: {Name: "SlowSync", Type: 3, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x1030, enum: []int64{0, 1}, enumString: []string{"Off", "On"}},
+	{GroupFujifilm, 0x1031}: {Name: "PictureMode", Type: 3, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x1031},
+	{GroupFujifilm, 0x1032}: {Name: "ExposureCount", Type: 3, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x1032},
+	{GroupFujifilm, 0x1033}: {Name: "EXRAuto", Type: 3, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x1033, enum: []int64{0, 1}, enumString: []string{"Auto", "Manual"}},
+	{GroupFujifilm, 0x1034}: {Name: "EXRMode", Type: 3, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x1034},
+	{GroupFujifilm, 0x1040}: {Name: "ShadowTone", Type: 9, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x1040},
+	{GroupFujifilm, 0x1041}: {Name: "HighlightTone", Type: 9, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x1041},
+	{GroupFujifilm, 0x1044}: {Name: "DigitalZoom", Type: 4, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x1044},
+	{GroupFujifilm, 0x1045}: {Name: "LensModulationOptimizer", Type: 4, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x1045, enum: []int64{0, 1}, enumString: []string{"Off", "On"}},
+	{GroupFujifilm, 0x1047}: {Name: "GrainEffectRoughness", Type: 9, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x1047, enum: []int64{0, 32, 64}, enumString: []string{"Off", "Weak", "Strong"}},
+	{GroupFujifilm, 0x1048}: {Name: "ColorChromeEffect", Type: 9, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x1048, enum: []int64{0, 32, 64}, enumString: []string{"Off", "Weak", "Strong"}},
+	{GroupFujifilm, 0x1049}: {Name: "BWAdjustment", Type: 6, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x1049},
+	{GroupFujifilm, 0x104b}: {Name: "BWMagentaGreen", Type: 6, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x104b},
+	{GroupFujifilm, 0x104c}: {Name: "GrainEffectSize", Type: 3, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x104c, enum: []int64{0, 16, 32}, enumString: []string{"Off", "Small", "Large"}},
+	{GroupFujifilm, 0x104d}: {Name: "CropMode", Type: 4, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x104d, enum: []int64{0, 1, 2, 4, 8}, enumString: []string{"n/a", "Full-frame on GFX", "Sports Finder Mode", "Electronic Shutter 1.25x Crop", "Digital Tele-Conv"}},
+	{GroupFujifilm, 0x104e}: {Name: "ColorChromeFXBlue", Type: 9, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x104e, enum: []int64{0, 32, 64}, enumString: []string{"Off", "Weak", "Strong"}},
+	{GroupFujifilm, 0x1050}: {Name: "ShutterType", Type: 3, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x1050, enum: []int64{0, 1, 2, 3}, enumString: []string{"Mechanical", "Electronic", "Electronic (long shutter speed)", "Electronic Front Curtain"}},
+	{GroupFujifilm, 0x1100}: {Name: "AutoBracketing", Type: 3, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x1100},
+	{GroupFujifilm, 0x1101}: {Name: "SequenceNumber", Type: 3, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x1101},
+	{GroupFujifilm, 0x1103}: {Name: "DriveSettings", Type: 4, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x1103},
+	{GroupFujifilm, 0x1153}: {Name: "PanoramaAngle", Type: 3, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x1153},
+	{GroupFujifilm, 0x1154}: {Name: "PanoramaDirection", Type: 3, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x1154, enum: []int64{1, 2, 3, 4}, enumString: []string{"Right", "Up", "Left", "Down"}},
+	{GroupFujifilm, 0x1201}: {Name: "AdvancedFilter", Type: 4, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x1201},
+	{GroupFujifilm, 0x1210}: {Name: "ColorMode", Type: 3, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x1210, enum: []int64{0, 16, 48}, enumString: []string{"Standard", "Chrome", "B & W"}},
+	{GroupFujifilm, 0x1300}: {Name: "BlurWarning", Type: 3, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x1300, enum: []int64{0, 1}, enumString: []string{"None", "Blur Warning"}},
+	{GroupFujifilm, 0x1301}: {Name: "FocusWarning", Type: 3, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x1301, enum: []int64{0, 1}, enumString: []string{"Good", "Out of focus"}},
+	{GroupFujifilm, 0x1302}: {Name: "ExposureWarning", Type: 3, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x1302, enum: []int64{0, 1}, enumString: []string{"Good", "Bad exposure"}},
+	{GroupFujifilm, 0x1304}: {Name: "GEImageSize", Type: 2, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x1304},
+	{GroupFujifilm, 0x1400}: {Name: "DynamicRange", Type: 3, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x1400, enum: []int64{1, 3}, enumString: []string{"Standard", "Wide"}},
+	{GroupFujifilm, 0x1401}: {Name: "FilmMode", Type: 3, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x1401, enum: []int64{0, 256, 272, 288, 304, 512, 768, 1024, 1280, 1281, 1536, 1792, 2048, 2304, 2560, 2816}, enumString: []string{"F0/Standard (Provia)", "F1/Studio Portrait", "F1a/Studio Portrait Enhanced Saturation", "F1b/Studio Portrait Smooth Skin Tone (Astia)", "F1c/Studio Portrait Increased Sharpness", "F2/Fujichrome (Velvia)", "F3/Studio Portrait Ex", "F4/Velvia", "Pro Neg. Std", "Pro Neg. Hi", "Classic Chrome", "Eterna", "Classic Negative", "Bleach Bypass", "Nostalgic Neg", "Reala ACE"}},
+	{GroupFujifilm, 0x1402}: {Name: "DynamicRangeSetting", Type: 3, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x1402, enum: []int64{0, 1, 256, 512, 513, 32768}, enumString: []string{"Auto", "Manual", "Standard (100%)", "Wide1 (230%)", "Wide2 (400%)", "Film Simulation"}},
+	{GroupFujifilm, 0x1403}: {Name: "DevelopmentDynamicRange", Type: 3, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x1403},
+	{GroupFujifilm, 0x1404}: {Name: "MinFocalLength", Type: 10, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x1404},
+	{GroupFujifilm, 0x1405}: {Name: "MaxFocalLength", Type: 10, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x1405},
+	{GroupFujifilm, 0x1406}: {Name: "MaxApertureAtMinFocal", Type: 10, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x1406},
+	{GroupFujifilm, 0x1407}: {Name: "MaxApertureAtMaxFocal", Type: 10, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x1407},
+	{GroupFujifilm, 0x140b}: {Name: "AutoDynamicRange", Type: 3, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x140b},
+	{GroupFujifilm, 0x1422}: {Name: "ImageStabilization", Type: 3, flags: 0, arrayLen: [2]int{3, 1}, ID: 0x1422},
+	{GroupFujifilm, 0x1425}: {Name: "SceneRecognition", Type: 3, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x1425},
+	{GroupFujifilm, 0x1431}: {Name: "Rating", Type: 4, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x1431},
+	{GroupFujifilm, 0x1436}: {Name: "ImageGeneration", Type: 3, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x1436, enum: []int64{0, 1}, enumString: []string{"Original Image", "Re-developed from RAW"}},
+	{GroupFujifilm, 0x1438}: {Name: "ImageCount", Type: 3, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x1438},
+	{GroupFujifilm, 0x1443}: {Name: "DRangePriority", Type: 3, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x1443, enum: []int64{0, 1}, enumString: []string{"Auto", "Fixed"}},
+	{GroupFujifilm, 0x1446}: {Name: "FlickerReduction", Type: 4, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x1446},
+	{GroupFujifilm, 0x4100}: {Name: "FacesDetected", Type: 3, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x4100},
+	{GroupFujifilm, 0x8000}: {Name: "FileSource", Type: 2, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x8000},
+	{GroupFujifilm, 0x8002}: {Name: "OrderNumber", Type: 4, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x8002},
+	{GroupFujifilm, 0x8003}: {Name: "FrameNumber", Type: 3, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x8003},
+	{GroupFujifilm, 0xb211}: {Name: "Parallax", Type: 10, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0xb211},
+
+	// Olympus maker note tags.
+	{GroupOlympus, 0x0100}: {Name: "ThumbnailImage", Type: 7, flags: 0, arrayLen: [2]int{-1, 0}, ID: 0x0100},
+	{GroupOlympus, 0x0104}: {Name: "BodyFirmwareVersion", Type: 2, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x0104},
+	{GroupOlympus, 0x0200}: {Name: "SpecialMode", Type: 4, flags: 0, arrayLen: [2]int{3, 1}, ID: 0x0200},
+	{GroupOlympus, 0x0201}: {Name: "Quality", Type: 3, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x0201, enum: []int64{1, 2, 3, 4, 5}, enumString: []string{"SQ", "HQ", "SHQ", "RAW", "SQ (5)"}},
+	{GroupOlympus, 0x0202}: {Name: "Macro", Type: 3, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x0202, enum: []int64{0, 1, 2}, enumString: []string{"Off", "On", "Super Macro"}},
+	{GroupOlympus, 0x0203}: {Name: "BWMode", Type: 3, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x0203, enum: []int64{0, 1, 6}, enumString: []string{"No", "Yes", "(none)"}},
+	{GroupOlympus, 0x0204}: {Name: "DigitalZoom", Type: 5, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x0204},
+	{GroupOlympus, 0x0205}: {Name: "FocalPlaneDiagonal", Type: 5, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x0205},
+	{GroupOlympus, 0x0206}: {Name: "LensDistortionParams", Type: 8, flags: 0, arrayLen: [2]int{6, 1}, ID: 0x0206},
+	{GroupOlympus, 0x0207}: {Name: "CameraType", Type: 2, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x0207},
+	{GroupOlympus, 0x0208}: {Name: "TextInfo", Type: 2, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x0208},
+	{GroupOlympus, 0x0209}: {Name: "CameraID", Type: 7, flags: 0, arrayLen: [2]int{-1, 0}, ID: 0x0209},
+	{GroupOlympus, 0x020b}: {Name: "EpsonImageWidth", Type: 4, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x020b},
+	{GroupOlympus, 0x020c}: {Name: "EpsonImageHeight", Type: 4, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x020c},
+	{GroupOlympus, 0x020d}: {Name: "EpsonSoftware", Type: 2, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x020d},
+	{GroupOlympus, 0x0280}: {Name: "PreviewImage", Type: 7, flags: 0, arrayLen: [2]int{-1, 0}, ID: 0x0280},
+	{GroupOlympus, 0x0300}: {Name: "PreCaptureFrames", Type: 3, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x0300},
+	{GroupOlympus, 0x0301}: {Name: "WhiteBoard", Type: 3, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x0301},
+	{GroupOlympus, 0x0302}: {Name: "OneTouchWB", Type: 3, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x0302, enum: []int64{0, 1, 2}, enumString: []string{"Off", "On", "On (Preset)"}},
+	{GroupOlympus, 0x0303}: {Name: "WhiteBalanceBracket", Type: 3, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x0303},
+	{GroupOlympus, 0x0304}: {Name: "WhiteBalanceBias", Type: 3, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x0304},
+	{GroupOlympus, 0x0403}: {Name: "SceneMode", Type: 3, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x0403},
+	{GroupOlympus, 0x0404}: {Name: "SerialNumber", Type: 2, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x0404},
+	{GroupOlympus, 0x0405}: {Name: "Firmware", Type: 2, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x0405},
+	{GroupOlympus, 0x0e00}: {Name: "PrintIM", Type: 7, flags: 0, arrayLen: [2]int{-1, 0}, ID: 0x0e00},
+	{GroupOlympus, 0x0f00}: {Name: "DataDump", Type: 7, flags: 0, arrayLen: [2]int{-1, 0}, ID: 0x0f00},
+	{GroupOlympus, 0x1000}: {Name: "ShutterSpeedValue", Type: 10, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x1000},
+	{GroupOlympus, 0x1001}: {Name: "ISOValue", Type: 10, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x1001},
+	{GroupOlympus, 0x1002}: {Name: "ApertureValue", Type: 10, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x1002},
+	{GroupOlympus, 0x1003}: {Name: "BrightnessValue", Type: 10, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x1003},
+	{GroupOlympus, 0x1004}: {Name: "FlashMode", Type: 3, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x1004, enum: []int64{2, 3}, enumString: []string{"On", "Off"}},
+	{GroupOlympus, 0x1005}: {Name: "FlashDevice", Type: 3, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x1005, enum: []int64{0, 1, 4, 5}, enumString: []string{"None", "Internal", "External", "Internal + External"}},
+	{GroupOlympus, 0x1006}: {Name: "ExposureCompensation", Type: 10, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x1006},
+	{GroupOlympus, 0x1007}: {Name: "SensorTemperature", Type: 8, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x1007},
+	{GroupOlympus, 0x1008}: {Name: "LensTemperature", Type: 8, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x1008},
+	{GroupOlympus, 0x100b}: {Name: "FocusMode", Type: 3, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x100b, enum: []int64{0, 1}, enumString: []string{"Auto", "Manual"}},
+	{GroupOlympus, 0x100c}: {Name: "ManualFocusDistance", Type: 5, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x100c},
+	{GroupOlympus, 0x100d}: {Name: "ZoomStepCount", Type: 3, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x100d},
+	{GroupOlympus, 0x100e}: {Name: "FocusStepCount", Type: 3, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x100e},
+	{GroupOlympus, 0x100f}: {Name: "Sharpness", Type: 3, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x100f, enum: []int64{0, 1, 2}, enumString: []string{"Normal", "Hard", "Soft"}},
+	{GroupOlympus, 0x1010}: {Name: "FlashChargeLevel", Type: 3, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x1010},
+	{GroupOlympus, 0x1011}: {Name: "ColorMatrix", Type: 3, flags: 0, arrayLen: [2]int{9, 1}, ID: 0x1011},
+	{GroupOlympus, 0x1012}: {Name: "BlackLevel", Type: 3, flags: 0, arrayLen: [2]int{4, 1}, ID: 0x1012},
+	{GroupOlympus, 0x1015}: {Name: "WBMode", Type: 3, flags: 0, arrayLen: [2]int{2, 1}, ID: 0x1015},
+	{GroupOlympus, 0x1017}: {Name: "RedBalance", Type: 3, flags: 0, arrayLen: [2]int{2, 1}, ID: 0x1017},
+	{GroupOlympus, 0x1018}: {Name: "BlueBalance", Type: 3, flags: 0, arrayLen: [2]int{2, 1}, ID: 0x1018},
+	{GroupOlympus, 0x1023}: {Name: "FlashExposureComp", Type: 10, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x1023},
+	{GroupOlympus, 0x1026}: {Name: "ExternalFlashBounce", Type: 3, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x1026, enum: []int64{0, 1}, enumString: []string{"No", "Yes"}},
+	{GroupOlympus, 0x1029}: {Name: "Contrast", Type: 3, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x1029, enum: []int64{0, 1, 2}, enumString: []string{"High", "Normal", "Low"}},
+	{GroupOlympus, 0x102a}: {Name: "SharpnessFactor", Type: 3, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x102a},
+	{GroupOlympus, 0x102b}: {Name: "ColorControl", Type: 3, flags: 0, arrayLen: [2]int{6, 1}, ID: 0x102b},
+	{GroupOlympus, 0x1034}: {Name: "CompressionRatio", Type: 5, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x1034},
+	{GroupOlympus, 0x1035}: {Name: "PreviewImageValid", Type: 4, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x1035, enum: []int64{0, 1}, enumString: []string{"No", "Yes"}},
+	{GroupOlympus, 0x1036}: {Name: "PreviewImageStart", Type: 4, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x1036},
+	{GroupOlympus, 0x1037}: {Name: "PreviewImageLength", Type: 4, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x1037},
+	{GroupOlympus, 0x1039}: {Name: "CCDScanMode", Type: 3, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x1039, enum: []int64{0, 1}, enumString: []string{"Interlaced", "Progressive"}},
+	{GroupOlympus, 0x103a}: {Name: "NoiseReduction", Type: 3, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x103a, enum: []int64{0, 1}, enumString: []string{"Off", "On"}},
+	{GroupOlympus, 0x103b}: {Name: "FocusStepInfinity", Type: 3, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x103b},
+	{GroupOlympus, 0x103c}: {Name: "FocusStepNear", Type: 3, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x103c},
+	{GroupOlympus, 0x2010}: {Name: "Equipment", Type: 4, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x2010},
+	{GroupOlympus, 0x2020}: {Name: "CameraSettings", Type: 4, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x2020},
+	{GroupOlympus, 0x2030}: {Name: "RawDevelopment", Type: 4, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x2030},
+	{GroupOlympus, 0x2031}: {Name: "RawDev2", Type: 4, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x2031},
+	{GroupOlympus, 0x2040}: {Name: "ImageProcessing", Type: 4, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x2040},
+	{GroupOlympus, 0x2050}: {Name: "FocusInfo", Type: 4, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x2050},
+	{GroupOlympus, 0x3000}: {Name: "RawInfo", Type: 4, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x3000},
+
+	// OlympusEquipment maker note tags.
+	{GroupOlympusEquipment, 0x0000}: {Name: "EquipmentVersion", Type: 7, flags: 0, arrayLen: [2]int{4, 1}, ID: 0x0000},
+	{GroupOlympusEquipment, 0x0100}: {Name: "CameraType2", Type: 2, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x0100},
+	{GroupOlympusEquipment, 0x0101}: {Name: "SerialNumber", Type: 2, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x0101},
+	{GroupOlympusEquipment, 0x0102}: {Name: "InternalSerialNumber", Type: 2, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x0102},
+	{GroupOlympusEquipment, 0x0103}: {Name: "FocalPlaneDiagonal", Type: 5, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x0103},
+	{GroupOlympusEquipment, 0x0104}: {Name: "BodyFirmwareVersion", Type: 4, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x0104},
+	{GroupOlympusEquipment, 0x0201}: {Name: "LensType", Type: 1, flags: 0, arrayLen: [2]int{6, 1}, ID: 0x0201},
+	{GroupOlympusEquipment, 0x0202}: {Name: "LensSerialNumber", Type: 2, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x0202},
+	{GroupOlympusEquipment, 0x0203}: {Name: "LensModel", Type: 2, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x0203},
+	{GroupOlympusEquipment, 0x0204}: {Name: "LensFirmwareVersion", Type: 4, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x0204},
+	{GroupOlympusEquipment, 0x0205}: {Name: "MaxApertureAtMinFocal", Type: 3, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x0205},
+	{GroupOlympusEquipment, 0x0206}: {Name: "MaxApertureAtMaxFocal", Type: 3, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x0206},
+	{GroupOlympusEquipment, 0x0207}: {Name: "MinFocalLength", Type: 3, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x0207},
+	{GroupOlympusEquipment, 0x0208}: {Name: "MaxFocalLength", Type: 3, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x0208},
+	{GroupOlympusEquipment, 0x020a}: {Name: "MaxAperture", Type: 3, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x020a},
+	{GroupOlympusEquipment, 0x020b}: {Name: "LensProperties", Type: 3, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x020b},
+	{GroupOlympusEquipment, 0x0301}: {Name: "Extender", Type: 1, flags: 0, arrayLen: [2]int{6, 1}, ID: 0x0301},
+	{GroupOlympusEquipment, 0x0302}: {Name: "ExtenderSerialNumber", Type: 2, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x0302},
+	{GroupOlympusEquipment, 0x0303}: {Name: "ExtenderModel", Type: 2, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x0303},
+	{GroupOlympusEquipment, 0x0304}: {Name: "ExtenderFirmwareVersion", Type: 4, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x0304},
+	{GroupOlympusEquipment, 0x0403}: {Name: "ConversionLens", Type: 2, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x0403},
+	{GroupOlympusEquipment, 0x1000}: {Name: "FlashType", Type: 3, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x1000, enum: []int64{0, 2, 3, 4}, enumString: []string{"None", "Simple E-System", "E-System", "E-System (illegal)"}},
+	{GroupOlympusEquipment, 0x1001}: {Name: "FlashModel", Type: 3, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x1001},
+	{GroupOlympusEquipment, 0x1002}: {Name: "FlashFirmwareVersion", Type: 4, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x1002},
+	{GroupOlympusEquipment, 0x1003}: {Name: "FlashSerialNumber", Type: 2, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x1003},
+
+	// OlympusCameraSettings maker note tags.
+	{GroupOlympusCameraSettings, 0x0000}: {Name: "CameraSettingsVersion", Type: 7, flags: 0, arrayLen: [2]int{4, 1}, ID: 0x0000},
+	{GroupOlympusCameraSettings, 0x0100}: {Name: "PreviewImageValid", Type: 4, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x0100, enum: []int64{0, 1}, enumString: []string{"No", "Yes"}},
+	{GroupOlympusCameraSettings, 0x0101}: {Name: "PreviewImageStart", Type: 4, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x0101},
+	{GroupOlympusCameraSettings, 0x0102}: {Name: "PreviewImageLength", Type: 4, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x0102},
+	{GroupOlympusCameraSettings, 0x0200}: {Name: "ExposureMode", Type: 3, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x0200, enum: []int64{1, 2, 3, 4, 5}, enumString: []string{"Manual", "Program", "Aperture-priority AE", "Shutter speed priority AE", "Program-shift"}},
+	{GroupOlympusCameraSettings, 0x0201}: {Name: "AELock", Type: 3, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x0201, enum: []int64{0, 1}, enumString: []string{"Off", "On"}},
+	{GroupOlympusCameraSettings, 0x0202}: {Name: "MeteringMode", Type: 3, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x0202, enum: []int64{2, 3, 5, 261, 515, 1027}, enumString: []string{"Center-weighted average", "Spot", "ESP", "Pattern+AF", "Spot+Highlight control", "Spot+Shadow control"}},
+	{GroupOlympusCameraSettings, 0x0203}: {Name: "ExposureShift", Type: 10, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x0203},
+	{GroupOlympusCameraSettings, 0x0204}: {Name: "NDFilter", Type: 3, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x0204, enum: []int64{0, 1}, enumString: []string{"Off", "On"}},
+	{GroupOlympusCameraSettings, 0x0300}: {Name: "MacroMode", Type: 3, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x0300, enum: []int64{0, 1, 2}, enumString: []string{"Off", "On", "Super Macro"}},
+	{GroupOlympusCameraSettings, 0x0301}: {Name: "FocusMode", Type: 3, flags: 0, arrayLen: [2]int{-1, 0}, ID: 0x0301},
+	{GroupOlympusCameraSettings, 0x0302}: {Name: "FocusProcess", Type: 3, flags: 0, arrayLen: [2]int{-1, 0}, ID: 0x0302},
+	{GroupOlympusCameraSettings, 0x0303}: {Name: "AFSearch", Type: 3, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x0303, enum: []int64{0, 1}, enumString: []string{"Not Ready", "Ready"}},
+	{GroupOlympusCameraSettings, 0x0304}: {Name: "AFAreas", Type: 4, flags: 0, arrayLen: [2]int{64, 1}, ID: 0x0304},
+	{GroupOlympusCameraSettings, 0x0305}: {Name: "AFPointSelected", Type: 10, flags: 0, arrayLen: [2]int{5, 1}, ID: 0x0305},
+	{GroupOlympusCameraSettings, 0x0306}: {Name: "AFFineTune", Type: 1, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x0306, enum: []int64{0, 1}, enumString: []string{"Off", "On"}},
+	{GroupOlympusCameraSettings, 0x0307}: {Name: "AFFineTuneAdj", Type: 8, flags: 0, arrayLen: [2]int{3, 1}, ID: 0x0307},
+	{GroupOlympusCameraSettings, 0x0400}: {Name: "FlashMode", Type: 3, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x0400},
+	{GroupOlympusCameraSettings, 0x0401}: {Name: "FlashExposureComp", Type: 10, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x0401},
+	{GroupOlympusCameraSettings, 0x0500}: {Name: "WhiteBalance2", Type: 3, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x0500},
+	{GroupOlympusCameraSettings, 0x0501}: {Name: "WhiteBalanceTemperature", Type: 3, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x0501},
+	{GroupOlympusCameraSettings, 0x0502}: {Name: "WhiteBalanceBracket", Type: 8, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x0502},
+	{GroupOlympusCameraSettings, 0x0503}: {Name: "CustomSaturation", Type: 8, flags: 0, arrayLen: [2]int{3, 1}, ID: 0x0503},
+	{GroupOlympusCameraSettings, 0x0504}: {Name: "ModifiedSaturation", Type: 3, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x0504, enum: []int64{0, 1, 2, 3, 4}, enumString: []string{"Off", "CM1 (Red Enhance)", "CM2 (Green Enhance)", "CM3 (Blue Enhance)", "CM4 (Skin Tones)"}},
+	{GroupOlympusCameraSettings, 0x0505}: {Name: "ContrastSetting", Type: 8, flags: 0, arrayLen: [2]int{3, 1}, ID: 0x0505},
+	{GroupOlympusCameraSettings, 0x0506}: {Name: "SharpnessSetting", Type: 8, flags: 0, arrayLen: [2]int{3, 1}, ID: 0x0506},
+	{GroupOlympusCameraSettings, 0x0507}: {Name: "ColorSpace", Type: 3, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x0507, enum: []int64{0, 1, 2}, enumString: []string{"sRGB", "Adobe RGB", "Pro Photo RGB"}},
+	{GroupOlympusCameraSettings, 0x0509}: {Name: "SceneMode", Type: 3, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x0509},
+	{GroupOlympusCameraSettings, 0x050a}: {Name: "NoiseReduction", Type: 3, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x050a},
+	{GroupOlympusCameraSettings, 0x050b}: {Name: "DistortionCorrection", Type: 3, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x050b, enum: []int64{0, 1}, enumString: []string{"Off", "On"}},
+	{GroupOlympusCameraSettings, 0x050c}: {Name: "ShadingCompensation", Type: 3, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x050c, enum: []int64{0, 1}, enumString: []string{"Off", "On"}},
+	{GroupOlympusCameraSettings, 0x050d}: {Name: "CompressionFactor", Type: 5, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x050d},
+	{GroupOlympusCameraSettings, 0x050f}: {Name: "Gradation", Type: 8, flags: 0, arrayLen: [2]int{-1, 0}, ID: 0x050f},
+	{GroupOlympusCameraSettings, 0x0520}: {Name: "PictureMode", Type: 3, flags: 0, arrayLen: [2]int{-1, 0}, ID: 0x0520},
+	{GroupOlympusCameraSettings, 0x0521}: {Name: "PictureModeSaturation", Type: 8, flags: 0, arrayLen: [2]int{3, 1}, ID: 0x0521},
+	{GroupOlympusCameraSettings, 0x0527}: {Name: "NoiseFilter", Type: 8, flags: 0, arrayLen: [2]int{3, 1}, ID: 0x0527},
+	{GroupOlympusCameraSettings, 0x052c}: {Name: "ArtFilter", Type: 3, flags: 0, arrayLen: [2]int{4, 1}, ID: 0x052c},
+	{GroupOlympusCameraSettings, 0x052f}: {Name: "MagicFilter", Type: 3, flags: 0, arrayLen: [2]int{4, 1}, ID: 0x052f},
+	{GroupOlympusCameraSettings, 0x0600}: {Name: "DriveMode", Type: 3, flags: 0, arrayLen: [2]int{-1, 0}, ID: 0x0600},
+	{GroupOlympusCameraSettings, 0x0601}: {Name: "PanoramaMode", Type: 3, flags: 0, arrayLen: [2]int{2, 1}, ID: 0x0601},
+	{GroupOlympusCameraSettings, 0x0603}: {Name: "ImageQuality2", Type: 3, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x0603, enum: []int64{1, 2, 3, 4}, enumString: []string{"SQ", "HQ", "SHQ", "RAW"}},
+	{GroupOlympusCameraSettings, 0x0604}: {Name: "ImageStabilization", Type: 4, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x0604, enum: []int64{0, 1, 2, 3, 4}, enumString: []string{"Off", "On, Mode 1", "On, Mode 2", "On, Mode 3", "On, Mode 4"}},
+	{GroupOlympusCameraSettings, 0x0804}: {Name: "StackedImage", Type: 4, flags: 0, arrayLen: [2]int{2, 1}, ID: 0x0804},
+	{GroupOlympusCameraSettings, 0x0900}: {Name: "ManometerPressure", Type: 3, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x0900},
+	{GroupOlympusCameraSettings, 0x0901}: {Name: "ManometerReading", Type: 9, flags: 0, arrayLen: [2]int{2, 1}, ID: 0x0901},
+	{GroupOlympusCameraSettings, 0x0902}: {Name: "ExtendedWBDetect", Type: 3, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x0902, enum: []int64{0, 1}, enumString: []string{"Off", "On"}},
+	{GroupOlympusCameraSettings, 0x0903}: {Name: "RollAngle", Type: 8, flags: 0, arrayLen: [2]int{2, 1}, ID: 0x0903},
+	{GroupOlympusCameraSettings, 0x0904}: {Name: "PitchAngle", Type: 8, flags: 0, arrayLen: [2]int{2, 1}, ID: 0x0904},
+	{GroupOlympusCameraSettings, 0x0908}: {Name: "DateTimeUTC", Type: 2, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x0908},
+
+	// Panasonic maker note tags.
+	{GroupPanasonic, 0x0001}: {Name: "ImageQuality", Type: 3, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x0001, enum: []int64{1, 2, 3, 6, 7, 9, 11, 12}, enumString: []string{"TIFF", "High", "Normal", "Very High", "RAW", "Motion Picture", "Full HD Movie", "4k Movie"}},
+	{GroupPanasonic, 0x0002}: {Name: "FirmwareVersion", Type: 7, flags: 0, arrayLen: [2]int{4, 1}, ID: 0x0002},
+	{GroupPanasonic, 0x0003}: {Name: "WhiteBalance", Type: 3, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x0003, enum: []int64{1, 2, 3, 4, 5, 8, 10, 11, 12, 13, 14, 15, 19, 24}, enumString: []string{"Auto", "Daylight", "Cloudy", "Incandescent", "Manual", "Flash", "Black & White", "Manual 2", "Shade", "Kelvin", "Manual 3", "Manual 4", "Auto (cool)", "Auto (warm)"}},
+	{GroupPanasonic, 0x0007}: {Name: "FocusMode", Type: 3, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x0007, enum: []int64{1, 2, 4, 5, 6, 7, 8}, enumString: []string{"Auto", "Manual", "Auto, Focus button", "Auto, Continuous", "AF-S", "AF-C", "AF-F"}},
+	{GroupPanasonic, 0x000f}: {Name: "AFAreaMode", Type: 1, flags: 0, arrayLen: [2]int{2, 1}, ID: 0x000f},
+	{GroupPanasonic, 0x001a}: {Name: "ImageStabilization", Type: 3, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x001a, enum: []int64{2, 3, 4, 5, 6, 7, 9, 10, 11, 12}, enumString: []string{"On, Optical", "Off", "On, Mode 2", "On, Optical Panning", "On, Body-only", "On, Body-only Panning", "Dual IS", "Dual IS Panning", "Dual2 IS", "Dual2 IS Panning"}},
+	{GroupPanasonic, 0x001c}: {Name: "MacroMode", Type: 3, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x001c, enum: []int64{1, 2, 257, 513}, enumString: []string{"On", "Off", "Tele-Macro", "Macro Zoom"}},
+	{GroupPanasonic, 0x001f}: {Name: "ShootingMode", Type: 3, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x001f},
+	{GroupPanasonic, 0x0020}: {Name: "Audio", Type: 3, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x0020, enum: []int64{1, 2, 3}, enumString: []string{"Yes", "No", "Stereo"}},
+	{GroupPanasonic, 0x0021}: {Name: "DataDump", Type: 7, flags: 0, arrayLen: [2]int{-1, 0}, ID: 0x0021},
+	{GroupPanasonic, 0x0023}: {Name: "WhiteBalanceBias", Type: 8, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x0023},
+	{GroupPanasonic, 0x0024}: {Name: "FlashBias", Type: 8, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x0024},
+	{GroupPanasonic, 0x0025}: {Name: "InternalSerialNumber", Type: 7, flags: 0, arrayLen: [2]int{16, 1}, ID: 0x0025},
+	{GroupPanasonic, 0x0026}: {Name: "PanasonicExifVersion", Type: 7, flags: 0, arrayLen: [2]int{4, 1}, ID: 0x0026},
+	{GroupPanasonic, 0x0028}: {Name: "ColorEffect", Type: 3, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x0028, enum: []int64{1, 2, 3, 4, 5, 6, 8}, enumString: []string{"Off", "Warm", "Cool", "Black & White", "Sepia", "Happy", "Vivid"}},
+	{GroupPanasonic, 0x0029}: {Name: "TimeSincePowerOn", Type: 4, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x0029},
+	{GroupPanasonic, 0x002a}: {Name: "BurstMode", Type: 3, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x002a, enum: []int64{0, 1, 2, 3, 4, 8, 17, 18}, enumString: []string{"Off", "On", "Auto Exposure Bracketing (AEB)", "Focus Bracketing", "Unlimited", "White Balance Bracketing", "On (with flash)", "Aperture Bracketing"}},
+	{GroupPanasonic, 0x002b}: {Name: "SequenceNumber", Type: 4, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x002b},
+	{GroupPanasonic, 0x002c}: {Name: "ContrastMode", Type: 3, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x002c},
+	{GroupPanasonic, 0x002d}: {Name: "NoiseReduction", Type: 3, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x002d},
+	{GroupPanasonic, 0x002e}: {Name: "SelfTimer", Type: 3, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x002e, enum: []int64{0, 1, 2, 3, 4, 258, 266, 778}, enumString: []string{"Off (0)", "Off", "10 s", "2 s", "10 s / 3 pictures", "2 s after shutter pressed", "10 s after shutter pressed", "3 photos after 10 s"}},
+	{GroupPanasonic, 0x0030}: {Name: "Rotation", Type: 3, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x0030, enum: []int64{1, 3, 6, 8}, enumString: []string{"Horizontal (normal)", "Rotate 180", "Rotate 90 CW", "Rotate 270 CW"}},
+	{GroupPanasonic, 0x0031}: {Name: "AFAssistLamp", Type: 3, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x0031, enum: []int64{1, 2, 3, 4}, enumString: []string{"Fired", "Enabled but Not Used", "Disabled but Required", "Disabled and Not Required"}},
+	{GroupPanasonic, 0x0032}: {Name: "ColorMode", Type: 3, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x0032, enum: []int64{0, 1, 2}, enumString: []string{"Normal", "Natural", "Vivid"}},
+	{GroupPanasonic, 0x0033}: {Name: "BabyAge", Type: 2, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x0033},
+	{GroupPanasonic, 0x0034}: {Name: "OpticalZoomMode", Type: 3, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x0034, enum: []int64{1, 2}, enumString: []string{"Standard", "Extended"}},
+	{GroupPanasonic, 0x0035}: {Name: "ConversionLens", Type: 3, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x0035, enum: []int64{1, 2, 3, 4}, enumString: []string{"Off", "Wide", "Telephoto", "Macro"}},
+	{GroupPanasonic, 0x0036}: {Name: "TravelDay", Type: 3, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x0036},
+	{GroupPanasonic, 0x0039}: {Name: "Contrast", Type: 8, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x0039},
+	{GroupPanasonic, 0x003a}: {Name: "WorldTimeLocation", Type: 3, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x003a, enum: []int64{1, 2}, enumString: []string{"Home", "Destination"}},
+	{GroupPanasonic, 0x003b}: {Name: "TextStamp", Type: 3, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x003b, enum: []int64{1, 2}, enumString: []string{"Off", "On"}},
+	{GroupPanasonic, 0x003c}: {Name: "ProgramISO", Type: 3, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x003c},
+	{GroupPanasonic, 0x003d}: {Name: "AdvancedSceneType", Type: 3, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x003d},
+	{GroupPanasonic, 0x003f}: {Name: "FacesDetected", Type: 3, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x003f},
+	{GroupPanasonic, 0x0040}: {Name: "Saturation", Type: 8, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x0040},
+	{GroupPanasonic, 0x0041}: {Name: "Sharpness", Type: 8, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x0041},
+	{GroupPanasonic, 0x0042}: {Name: "FilmMode", Type: 3, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x0042},
+	{GroupPanasonic, 0x0044}: {Name: "ColorTempKelvin", Type: 3, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x0044},
+	{GroupPanasonic, 0x0045}: {Name: "BracketSettings", Type: 3, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x0045},
+	{GroupPanasonic, 0x0046}: {Name: "WBShiftAB", Type: 8, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x0046},
+	{GroupPanasonic, 0x0047}: {Name: "WBShiftGM", Type: 8, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x0047},
+	{GroupPanasonic, 0x0048}: {Name: "FlashCurtain", Type: 3, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x0048, enum: []int64{0, 1, 2}, enumString: []string{"n/a", "1st", "2nd"}},
+	{GroupPanasonic, 0x0049}: {Name: "LongExposureNoiseReduction", Type: 3, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x0049, enum: []int64{1, 2}, enumString: []string{"Off", "On"}},
+	{GroupPanasonic, 0x004b}: {Name: "PanasonicImageWidth", Type: 4, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x004b},
+	{GroupPanasonic, 0x004c}: {Name: "PanasonicImageHeight", Type: 4, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x004c},
+	{GroupPanasonic, 0x004d}: {Name: "AFPointPosition", Type: 5, flags: 0, arrayLen: [2]int{2, 1}, ID: 0x004d},
+	{GroupPanasonic, 0x004e}: {Name: "FaceDetInfo", Type: 7, flags: 0, arrayLen: [2]int{-1, 0}, ID: 0x004e},
+	{GroupPanasonic, 0x0051}: {Name: "LensType", Type: 2, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x0051},
+	{GroupPanasonic, 0x0052}: {Name: "LensSerialNumber", Type: 2, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x0052},
+	{GroupPanasonic, 0x0053}: {Name: "AccessoryType", Type: 2, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x0053},
+	{GroupPanasonic, 0x0054}: {Name: "AccessorySerialNumber", Type: 2, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x0054},
+	{GroupPanasonic, 0x0059}: {Name: "Transform", Type: 7, flags: 0, arrayLen: [2]int{4, 1}, ID: 0x0059},
+	{GroupPanasonic, 0x005d}: {Name: "IntelligentExposure", Type: 3, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x005d, enum: []int64{0, 1, 2, 3}, enumString: []string{"Off", "Low", "Standard", "High"}},
+	{GroupPanasonic, 0x0060}: {Name: "LensFirmwareVersion", Type: 7, flags: 0, arrayLen: [2]int{4, 1}, ID: 0x0060},
+	{GroupPanasonic, 0x0061}: {Name: "FaceRecInfo", Type: 7, flags: 0, arrayLen: [2]int{-1, 0}, ID: 0x0061},
+	{GroupPanasonic, 0x0062}: {Name: "FlashWarning", Type: 3, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x0062, enum: []int64{0, 1}, enumString: []string{"No", "Yes (flash required but disabled)"}},
+	{GroupPanasonic, 0x0065}: {Name: "Title", Type: 2, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x0065},
+	{GroupPanasonic, 0x0066}: {Name: "BabyName", Type: 2, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x0066},
+	{GroupPanasonic, 0x0067}: {Name: "Location", Type: 2, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x0067},
+	{GroupPanasonic, 0x0069}: {Name: "Country", Type: 2, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x0069},
+	{GroupPanasonic, 0x006b}: {Name: "State", Type: 2, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x006b},
+	{GroupPanasonic, 0x006d}: {Name: "City", Type: 2, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x006d},
+	{GroupPanasonic, 0x006f}: {Name: "Landmark", Type: 2, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x006f},
+	{GroupPanasonic, 0x0070}: {Name: "IntelligentResolution", Type: 1, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x0070},
+	{GroupPanasonic, 0x0077}: {Name: "BurstSpeed", Type: 3, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x0077},
+	{GroupPanasonic, 0x0079}: {Name: "IntelligentDRange", Type: 3, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x0079, enum: []int64{0, 1, 2, 3}, enumString: []string{"Off", "Low", "Standard", "High"}},
+	{GroupPanasonic, 0x007c}: {Name: "ClearRetouch", Type: 3, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x007c, enum: []int64{0, 1}, enumString: []string{"Off", "On"}},
+	{GroupPanasonic, 0x0080}: {Name: "City2", Type: 2, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x0080},
+	{GroupPanasonic, 0x0086}: {Name: "ManometerPressure", Type: 3, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x0086},
+	{GroupPanasonic, 0x0089}: {Name: "PhotoStyle", Type: 3, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x0089},
+	{GroupPanasonic, 0x008a}: {Name: "ShadingCompensation", Type: 3, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x008a, enum: []int64{0, 1}, enumString: []string{"Off", "On"}},
+	{GroupPanasonic, 0x008c}: {Name: "AccelerometerZ", Type: 3, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x008c},
+	{GroupPanasonic, 0x008d}: {Name: "AccelerometerX", Type: 3, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x008d},
+	{GroupPanasonic, 0x008e}: {Name: "AccelerometerY", Type: 3, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x008e},
+	{GroupPanasonic, 0x008f}: {Name: "CameraOrientation", Type: 1, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x008f, enum: []int64{0, 1, 2, 3, 4, 5}, enumString: []string{"Normal", "Rotate CW", "Rotate 180", "Rotate CCW", "Tilt Upwards", "Tilt Downwards"}},
+	{GroupPanasonic, 0x0090}: {Name: "RollAngle", Type: 8, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x0090},
+	{GroupPanasonic, 0x0091}: {Name: "PitchAngle", Type: 8, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x0091},
+	{GroupPanasonic, 0x0093}: {Name: "SweepPanoramaDirection", Type: 1, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x0093},
+	{GroupPanasonic, 0x0096}: {Name: "TimerRecording", Type: 3, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x0096},
+	{GroupPanasonic, 0x009d}: {Name: "InternalNDFilter", Type: 5, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x009d},
+	{GroupPanasonic, 0x009e}: {Name: "HDR", Type: 3, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x009e},
+	{GroupPanasonic, 0x009f}: {Name: "ShutterType", Type: 3, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x009f, enum: []int64{0, 1, 2}, enumString: []string{"Mechanical", "Electronic", "Hybrid"}},
+	{GroupPanasonic, 0x00a3}: {Name: "ClearRetouchValue", Type: 5, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x00a3},
+	{GroupPanasonic, 0x00a7}: {Name: "OutputLUT", Type: 7, flags: 0, arrayLen: [2]int{-1, 0}, ID: 0x00a7},
+	{GroupPanasonic, 0x00ab}: {Name: "TouchAE", Type: 3, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x00ab, enum: []int64{0, 1}, enumString: []string{"Off", "On"}},
+	{GroupPanasonic, 0x00af}: {Name: "TimeStamp", Type: 2, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x00af},
+	{GroupPanasonic, 0x00b4}: {Name: "MultiExposure", Type: 3, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x00b4, enum: []int64{0, 1, 2}, enumString: []string{"n/a", "Off", "On"}},
+	{GroupPanasonic, 0x00b9}: {Name: "RedEyeRemoval", Type: 3, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x00b9, enum: []int64{0, 1}, enumString: []string{"Off", "On"}},
+	{GroupPanasonic, 0x00bb}: {Name: "VideoBurstMode", Type: 4, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x00bb},
+	{GroupPanasonic, 0x00bc}: {Name: "DiffractionCorrection", Type: 3, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x00bc, enum: []int64{0, 1}, enumString: []string{"Off", "Auto"}},
+	{GroupPanasonic, 0x00c4}: {Name: "LensTypeMake", Type: 3, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x00c4},
+	{GroupPanasonic, 0x00c5}: {Name: "LensTypeModel", Type: 3, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x00c5},
+	{GroupPanasonic, 0x00d1}: {Name: "ISO", Type: 4, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x00d1},
+	{GroupPanasonic, 0x00d2}: {Name: "MonochromeGrainEffect", Type: 3, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x00d2, enum: []int64{0, 1, 2, 3}, enumString: []string{"Off", "Low", "Standard", "High"}},
+	{GroupPanasonic, 0x00d6}: {Name: "NoiseReductionStrength", Type: 10, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x00d6},
+	{GroupPanasonic, 0x0e00}: {Name: "PrintIM", Type: 7, flags: 0, arrayLen: [2]int{-1, 0}, ID: 0x0e00},
+	{GroupPanasonic, 0x8000}: {Name: "MakerNoteVersion", Type: 7, flags: 0, arrayLen: [2]int{4, 1}, ID: 0x8000},
+	{GroupPanasonic, 0x8001}: {Name: "SceneMode", Type: 3, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x8001},
+	{GroupPanasonic, 0x8004}: {Name: "WBRedLevel", Type: 3, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x8004},
+	{GroupPanasonic, 0x8005}: {Name: "WBGreenLevel", Type: 3, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x8005},
+	{GroupPanasonic, 0x8006}: {Name: "WBBlueLevel", Type: 3, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x8006},
+	{GroupPanasonic, 0x8007}: {Name: "FlashFired", Type: 3, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x8007, enum: []int64{1, 2}, enumString: []string{"No", "Yes"}},
+	{GroupPanasonic, 0x8010}: {Name: "BabyAge2", Type: 2, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x8010},
+	{GroupPanasonic, 0x8012}: {Name: "Transform2", Type: 7, flags: 0, arrayLen: [2]int{4, 1}, ID: 0x8012},
+
+	// Pentax maker note tags.
+	{GroupPentax, 0x0000}: {Name: "PentaxVersion", Type: 1, flags: 0, arrayLen: [2]int{4, 1}, ID: 0x0000},
+	{GroupPentax, 0x0001}: {Name: "PentaxModelType", Type: 3, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x0001},
+	{GroupPentax, 0x0002}: {Name: "PreviewImageSize", Type: 3, flags: 0, arrayLen: [2]int{2, 1}, ID: 0x0002},
+	{GroupPentax, 0x0003}: {Name: "PreviewImageLength", Type: 4, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x0003},
+	{GroupPentax, 0x0004}: {Name: "PreviewImageStart", Type: 4, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x0004},
+	{GroupPentax, 0x0005}: {Name: "PentaxModelID", Type: 4, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x0005},
+	{GroupPentax, 0x0006}: {Name: "Date", Type: 7, flags: 0, arrayLen: [2]int{4, 1}, ID: 0x0006},
+	{GroupPentax, 0x0007}: {Name: "Time", Type: 7, flags: 0, arrayLen: [2]int{3, 1}, ID: 0x0007},
+	{GroupPentax, 0x0008}: {Name: "Quality", Type: 3, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x0008, enum: []int64{0, 1, 2, 3, 4, 5, 7, 8, 9, 65535}, enumString: []string{"Good", "Better", "Best", "TIFF", "RAW", "Premium", "RAW (pixel shift enabled)", "Dynamic Pixel Shift", "Monochrome", "n/a"}},
+	{GroupPentax, 0x0009}: {Name: "PentaxImageSize", Type: 3, flags: 0, arrayLen: [2]int{-1, 0}, ID: 0x0009},
+	{GroupPentax, 0x000b}: {Name: "PictureMode", Type: 3, flags: 0, arrayLen: [2]int{-1, 0}, ID: 0x000b},
+	{GroupPentax, 0x000c}: {Name: "FlashMode", Type: 3, flags: 0, arrayLen: [2]int{-1, 0}, ID: 0x000c},
+	{GroupPentax, 0x000d}: {Name: "FocusMode", Type: 3, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x000d},
+	{GroupPentax, 0x000e}: {Name: "AFPointSelected", Type: 3, flags: 0, arrayLen: [2]int{-1, 0}, ID: 0x000e},
+	{GroupPentax, 0x000f}: {Name: "AFPointsInFocus", Type: 4, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x000f},
+	{GroupPentax, 0x0010}: {Name: "FocusPosition", Type: 3, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x0010},
+	{GroupPentax, 0x0012}: {Name: "ExposureTime", Type: 4, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x0012},
+	{GroupPentax, 0x0013}: {Name: "FNumber", Type: 3, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x0013},
+	{GroupPentax, 0x0014}: {Name: "ISO", Type: 3, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x0014},
+	{GroupPentax, 0x0015}: {Name: "LightReading", Type: 3, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x0015},
+	{GroupPentax, 0x0016}: {Name: "ExposureCompensation", Type: 3, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x0016},
+	{GroupPentax, 0x0017}: {Name: "MeteringMode", Type: 3, flags: 0, arrayLen: [2]int{-1, 0}, ID: 0x0017},
+	{GroupPentax, 0x0018}: {Name: "AutoBracketing", Type: 3, flags: 0, arrayLen: [2]int{-1, 0}, ID: 0x0018},
+	{GroupPentax, 0x0019}: {Name: "WhiteBalance", Type: 3, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x0019, enum: []int64{0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 14, 15, 17, 65534, 65535}, enumString: []string{"Auto", "Daylight", "Shade", "Fluorescent", "Tungsten", "Manual", "Daylight Fluorescent", "Day White Fluorescent", "White Fluorescent", "Flash", "Cloudy", "Warm White Fluorescent", "Multi Auto", "Color Temperature Enhancement", "Kelvin", "Unknown", "User-Selected"}},
+	{GroupPentax, 0x001a}: {Name: "WhiteBalanceMode", Type: 3, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x001a},
+	{GroupPentax, 0x001b}: {Name: "BlueBalance", Type: 3, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x001b},
+	{GroupPentax, 0x001c}: {Name: "RedBalance", Type: 3, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x001c},
+	{GroupPentax, 0x001d}: {Name: "FocalLength", Type: 4, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x001d},
+	{GroupPentax, 0x001e}: {Name: "DigitalZoom", Type: 3, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x001e},
+	{GroupPentax, 0x001f}: {Name: "Saturation", Type: 3, flags: 0, arrayLen: [2]int{-1, 0}, ID: 0x001f},
+	{GroupPentax, 0x0020}: {Name: "Contrast", Type: 3, flags: 0, arrayLen: [2]int{-1, 0}, ID: 0x0020},
+	{GroupPentax, 0x0021}: {Name: "Sharpness", Type: 3, flags: 0, arrayLen: [2]int{-1, 0}, ID: 0x0021},
+	{GroupPentax, 0x0022}: {Name: "WorldTimeLocation", Type: 3, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x0022, enum: []int64{0, 1}, enumString: []string{"Hometown", "Destination"}},
+	{GroupPentax, 0x0023}: {Name: "HometownCity", Type: 3, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x0023},
+	{GroupPentax, 0x0024}: {Name: "DestinationCity", Type: 3, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x0024},
+	{GroupPentax, 0x0025}: {Name: "HometownDST", Type: 3, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x0025, enum: []int64{0, 1}, enumString: []string{"No", "Yes"}},
+	{GroupPentax, 0x0026}: {Name: "DestinationDST", Type: 3, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x0026, enum: []int64{0, 1}, enumString: []string{"No", "Yes"}},
+	{GroupPentax, 0x0027}: {Name: "DSPFirmwareVersion", Type: 7, flags: 0, arrayLen: [2]int{4, 1}, ID: 0x0027},
+	{GroupPentax, 0x0028}: {Name: "CPUFirmwareVersion", Type: 7, flags: 0, arrayLen: [2]int{4, 1}, ID: 0x0028},
+	{GroupPentax, 0x0029}: {Name: "FrameNumber", Type: 4, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x0029},
+	{GroupPentax, 0x002d}: {Name: "EffectiveLV", Type: 3, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x002d},
+	{GroupPentax, 0x0032}: {Name: "ImageEditing", Type: 7, flags: 0, arrayLen: [2]int{4, 1}, ID: 0x0032},
+	{GroupPentax, 0x0033}: {Name: "PictureMode2", Type: 1, flags: 0, arrayLen: [2]int{3, 1}, ID: 0x0033},
+	{GroupPentax, 0x0034}: {Name: "DriveMode", Type: 1, flags: 0, arrayLen: [2]int{4, 1}, ID: 0x0034},
+	{GroupPentax, 0x0035}: {Name: "SensorSize", Type: 3, flags: 0, arrayLen: [2]int{2, 1}, ID: 0x0035},
+	{GroupPentax, 0x0037}: {Name: "ColorSpace", Type: 3, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x0037, enum: []int64{0, 1}, enumString: []string{"sRGB", "Adobe RGB"}},
+	{GroupPentax, 0x0038}: {Name: "ImageAreaOffset", Type: 3, flags: 0, arrayLen: [2]int{2, 1}, ID: 0x0038},
+	{GroupPentax, 0x0039}: {Name: "RawImageSize", Type: 3, flags: 0, arrayLen: [2]int{2, 1}, ID: 0x0039},
+	{GroupPentax, 0x003e}: {Name: "PreviewImageBorders", Type: 1, flags: 0, arrayLen: [2]int{4, 1}, ID: 0x003e},
+	{GroupPentax, 0x003f}: {Name: "LensRec", Type: 7, flags: 0, arrayLen: [2]int{-1, 0}, ID: 0x003f},
+	{GroupPentax, 0x0040}: {Name: "SensitivityAdjust", Type: 3, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x0040},
+	{GroupPentax, 0x0041}: {Name: "ImageEditCount", Type: 3, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x0041},
+	{GroupPentax, 0x0047}: {Name: "CameraTemperature", Type: 6, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x0047},
+	{GroupPentax, 0x0048}: {Name: "AELock", Type: 3, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x0048, enum: []int64{0, 1}, enumString: []string{"Off", "On"}},
+	{GroupPentax, 0x0049}: {Name: "NoiseReduction", Type: 3, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x0049, enum: []int64{0, 1}, enumString: []string{"Off", "On"}},
+	{GroupPentax, 0x004d}: {Name: "FlashExposureComp", Type: 9, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x004d},
+	{GroupPentax, 0x004f}: {Name: "ImageTone", Type: 3, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x004f},
+	{GroupPentax, 0x0050}: {Name: "ColorTemperature", Type: 3, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x0050},
+	{GroupPentax, 0x005c}: {Name: "ShakeReductionInfo", Type: 7, flags: 0, arrayLen: [2]int{-1, 0}, ID: 0x005c},
+	{GroupPentax, 0x005d}: {Name: "ShutterCount", Type: 7, flags: 0, arrayLen: [2]int{4, 1}, ID: 0x005d},
+	{GroupPentax, 0x0060}: {Name: "FaceInfo", Type: 7, flags: 0, arrayLen: [2]int{-1, 0}, ID: 0x0060},
+	{GroupPentax, 0x0062}: {Name: "RawDevelopmentProcess", Type: 3, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x0062},
+	{GroupPentax, 0x0067}: {Name: "Hue", Type: 3, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x0067},
+	{GroupPentax, 0x0068}: {Name: "AWBInfo", Type: 7, flags: 0, arrayLen: [2]int{-1, 0}, ID: 0x0068},
+	{GroupPentax, 0x0069}: {Name: "DynamicRangeExpansion", Type: 7, flags: 0, arrayLen: [2]int{4, 1}, ID: 0x0069},
+	{GroupPentax, 0x006b}: {Name: "TimeInfo", Type: 7, flags: 0, arrayLen: [2]int{-1, 0}, ID: 0x006b},
+	{GroupPentax, 0x006c}: {Name: "HighLowKeyAdj", Type: 8, flags: 0, arrayLen: [2]int{2, 1}, ID: 0x006c},
+	{GroupPentax, 0x006d}: {Name: "ContrastHighlight", Type: 8, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x006d},
+	{GroupPentax, 0x006e}: {Name: "ContrastShadow", Type: 8, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x006e},
+	{GroupPentax, 0x006f}: {Name: "ContrastHighlightShadowAdj", Type: 1, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x006f},
+	{GroupPentax, 0x0070}: {Name: "FineSharpness", Type: 1, flags: 0, arrayLen: [2]int{-1, 0}, ID: 0x0070},
+	{GroupPentax, 0x0071}: {Name: "HighISONoiseReduction", Type: 1, flags: 0, arrayLen: [2]int{-1, 0}, ID: 0x0071},
+	{GroupPentax, 0x0072}: {Name: "AFAdjustment", Type: 8, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x0072},
+	{GroupPentax, 0x0073}: {Name: "MonochromeFilterEffect", Type: 3, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x0073},
+	{GroupPentax, 0x0074}: {Name: "MonochromeToning", Type: 3, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x0074},
+	{GroupPentax, 0x0076}: {Name: "FaceDetect", Type: 1, flags: 0, arrayLen: [2]int{2, 1}, ID: 0x0076},
+	{GroupPentax, 0x0077}: {Name: "FaceDetectFrameSize", Type: 3, flags: 0, arrayLen: [2]int{2, 1}, ID: 0x0077},
+	{GroupPentax, 0x0079}: {Name: "ShadowCorrection", Type: 1, flags: 0, arrayLen: [2]int{-1, 0}, ID: 0x0079},
+	{GroupPentax, 0x007a}: {Name: "ISOAutoParameters", Type: 1, flags: 0, arrayLen: [2]int{2, 1}, ID: 0x007a},
+	{GroupPentax, 0x007b}: {Name: "CrossProcess", Type: 1, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x007b},
+	{GroupPentax, 0x007d}: {Name: "LensCorr", Type: 7, flags: 0, arrayLen: [2]int{-1, 0}, ID: 0x007d},
+	{GroupPentax, 0x007e}: {Name: "WhiteLevel", Type: 4, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x007e},
+	{GroupPentax, 0x007f}: {Name: "BleachBypassToning", Type: 3, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x007f},
+	{GroupPentax, 0x0080}: {Name: "AspectRatio", Type: 1, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x0080, enum: []int64{0, 1, 2, 3}, enumString: []string{"4:3", "3:2", "16:9", "1:1"}},
+	{GroupPentax, 0x0082}: {Name: "BlurControl", Type: 1, flags: 0, arrayLen: [2]int{4, 1}, ID: 0x0082},
+	{GroupPentax, 0x0085}: {Name: "HDR", Type: 1, flags: 0, arrayLen: [2]int{4, 1}, ID: 0x0085},
+	{GroupPentax, 0x0087}: {Name: "ShutterType", Type: 1, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x0087, enum: []int64{0, 1}, enumString: []string{"Normal (mechanical)", "Electronic"}},
+	{GroupPentax, 0x0088}: {Name: "NeutralDensityFilter", Type: 1, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x0088, enum: []int64{1, 2}, enumString: []string{"Off", "On"}},
+	{GroupPentax, 0x008b}: {Name: "ISO2", Type: 4, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x008b},
+	{GroupPentax, 0x0092}: {Name: "IntervalShooting", Type: 3, flags: 0, arrayLen: [2]int{2, 1}, ID: 0x0092},
+	{GroupPentax, 0x0095}: {Name: "SkinToneCorrection", Type: 6, flags: 0, arrayLen: [2]int{-1, 0}, ID: 0x0095},
+	{GroupPentax, 0x0096}: {Name: "ClarityControl", Type: 6, flags: 0, arrayLen: [2]int{-1, 0}, ID: 0x0096},
+	{GroupPentax, 0x0200}: {Name: "BlackPoint", Type: 3, flags: 0, arrayLen: [2]int{4, 1}, ID: 0x0200},
+	{GroupPentax, 0x0201}: {Name: "WhitePoint", Type: 3, flags: 0, arrayLen: [2]int{4, 1}, ID: 0x0201},
+	{GroupPentax, 0x0203}: {Name: "ColorMatrixA", Type: 8, flags: 0, arrayLen: [2]int{9, 1}, ID: 0x0203},
+	{GroupPentax, 0x0204}: {Name: "ColorMatrixB", Type: 8, flags: 0, arrayLen: [2]int{9, 1}, ID: 0x0204},
+	{GroupPentax, 0x0205}: {Name: "CameraSettings", Type: 7, flags: 0, arrayLen: [2]int{-1, 0}, ID: 0x0205},
+	{GroupPentax, 0x0206}: {Name: "AEInfo", Type: 7, flags: 0, arrayLen: [2]int{-1, 0}, ID: 0x0206},
+	{GroupPentax, 0x0207}: {Name: "LensInfo", Type: 7, flags: 0, arrayLen: [2]int{-1, 0}, ID: 0x0207},
+	{GroupPentax, 0x0208}: {Name: "FlashInfo", Type: 7, flags: 0, arrayLen: [2]int{-1, 0}, ID: 0x0208},
+	{GroupPentax, 0x0209}: {Name: "AEMeteringSegments", Type: 1, flags: 0, arrayLen: [2]int{-1, 0}, ID: 0x0209},
+	{GroupPentax, 0x020a}: {Name: "FlashMeteringSegments", Type: 1, flags: 0, arrayLen: [2]int{-1, 0}, ID: 0x020a},
+	{GroupPentax, 0x020b}: {Name: "SlaveFlashMeteringSegments", Type: 1, flags: 0, arrayLen: [2]int{-1, 0}, ID: 0x020b},
+	{GroupPentax, 0x020d}: {Name: "WB_RGGBLevelsDaylight", Type: 3, flags: 0, arrayLen: [2]int{4, 1}, ID: 0x020d},
+	{GroupPentax, 0x0215}: {Name: "CameraInfo", Type: 4, flags: 0, arrayLen: [2]int{-1, 0}, ID: 0x0215},
+	{GroupPentax, 0x0216}: {Name: "BatteryInfo", Type: 7, flags: 0, arrayLen: [2]int{-1, 0}, ID: 0x0216},
+	{GroupPentax, 0x021f}: {Name: "AFInfo", Type: 7, flags: 0, arrayLen: [2]int{-1, 0}, ID: 0x021f},
+	{GroupPentax, 0x0220}: {Name: "HuffmanTable", Type: 7, flags: 0, arrayLen: [2]int{-1, 0}, ID: 0x0220},
+	{GroupPentax, 0x0221}: {Name: "KelvinWB", Type: 7, flags: 0, arrayLen: [2]int{-1, 0}, ID: 0x0221},
+	{GroupPentax, 0x0222}: {Name: "ColorInfo", Type: 7, flags: 0, arrayLen: [2]int{-1, 0}, ID: 0x0222},
+	{GroupPentax, 0x0224}: {Name: "EVStepInfo", Type: 7, flags: 0, arrayLen: [2]int{-1, 0}, ID: 0x0224},
+	{GroupPentax, 0x0226}: {Name: "ShotInfo", Type: 7, flags: 0, arrayLen: [2]int{-1, 0}, ID: 0x0226},
+	{GroupPentax, 0x0227}: {Name: "FacePos", Type: 7, flags: 0, arrayLen: [2]int{-1, 0}, ID: 0x0227},
+	{GroupPentax, 0x0228}: {Name: "FaceSize", Type: 7, flags: 0, arrayLen: [2]int{-1, 0}, ID: 0x0228},
+	{GroupPentax, 0x0229}: {Name: "SerialNumber", Type: 2, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x0229},
+	{GroupPentax, 0x022a}: {Name: "FilterInfo", Type: 7, flags: 0, arrayLen: [2]int{-1, 0}, ID: 0x022a},
+	{GroupPentax, 0x022b}: {Name: "LevelInfo", Type: 7, flags: 0, arrayLen: [2]int{-1, 0}, ID: 0x022b},
+	{GroupPentax, 0x022e}: {Name: "Artist", Type: 2, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x022e},
+	{GroupPentax, 0x022f}: {Name: "Copyright", Type: 2, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x022f},
+	{GroupPentax, 0x0230}: {Name: "FirmwareVersion", Type: 2, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x0230},
+	{GroupPentax, 0x0231}: {Name: "ContrastDetectAFArea", Type: 3, flags: 0, arrayLen: [2]int{4, 1}, ID: 0x0231},
+	{GroupPentax, 0x0235}: {Name: "CrossProcessParams", Type: 7, flags: 0, arrayLen: [2]int{-1, 0}, ID: 0x0235},
+	{GroupPentax, 0x0239}: {Name: "LensInfoQ", Type: 7, flags: 0, arrayLen: [2]int{-1, 0}, ID: 0x0239},
+	{GroupPentax, 0x023f}: {Name: "Model", Type: 2, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x023f},
+	{GroupPentax, 0x0243}: {Name: "PixelShiftInfo", Type: 7, flags: 0, arrayLen: [2]int{-1, 0}, ID: 0x0243},
+	{GroupPentax, 0x0245}: {Name: "AFPointInfo", Type: 7, flags: 0, arrayLen: [2]int{-1, 0}, ID: 0x0245},
+	{GroupPentax, 0x03fe}: {Name: "DataDump", Type: 7, flags: 0, arrayLen: [2]int{-1, 0}, ID: 0x03fe},
+	{GroupPentax, 0x03ff}: {Name: "TempInfo", Type: 7, flags: 0, arrayLen: [2]int{-1, 0}, ID: 0x03ff},
+	{GroupPentax, 0x0402}: {Name: "ToneCurve", Type: 7, flags: 0, arrayLen: [2]int{-1, 0}, ID: 0x0402},
+	{GroupPentax, 0x0403}: {Name: "ToneCurves", Type: 7, flags: 0, arrayLen: [2]int{-1, 0}, ID: 0x0403},
+	{GroupPentax, 0x0e00}: {Name: "PrintIM", Type: 7, flags: 0, arrayLen: [2]int{-1, 0}, ID: 0x0e00},
+
+	// Apple maker note tags.
+	{GroupApple, 0x0001}: {Name: "MakerNoteVersion", Type: 9, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x0001},
+	{GroupApple, 0x0002}: {Name: "AEMatrix", Type: 7, flags: 0, arrayLen: [2]int{-1, 0}, ID: 0x0002},
+	{GroupApple, 0x0003}: {Name: "RunTime", Type: 7, flags: 0, arrayLen: [2]int{-1, 0}, ID: 0x0003},
+	{GroupApple, 0x0004}: {Name: "AEStable", Type: 9, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x0004, enum: []int64{0, 1}, enumString: []string{"No", "Yes"}},
+	{GroupApple, 0x0005}: {Name: "AETarget", Type: 9, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x0005},
+	{GroupApple, 0x0006}: {Name: "AEAverage", Type: 9, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x0006},
+	{GroupApple, 0x0007}: {Name: "AFStable", Type: 9, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x0007, enum: []int64{0, 1}, enumString: []string{"No", "Yes"}},
+	{GroupApple, 0x0008}: {Name: "AccelerationVector", Type: 10, flags: 0, arrayLen: [2]int{3, 1}, ID: 0x0008},
+	{GroupApple, 0x000a}: {Name: "HDRImageType", Type: 9, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x000a, enum: []int64{3, 4}, enumString: []string{"HDR Image", "Original Image"}},
+	{GroupApple, 0x000b}: {Name: "BurstUUID", Type: 2, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x000b},
+	{GroupApple, 0x000c}: {Name: "FocusDistanceRange", Type: 10, flags: 0, arrayLen: [2]int{2, 1}, ID: 0x000c},
+	{GroupApple, 0x000f}: {Name: "OISMode", Type: 9, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x000f},
+	{GroupApple, 0x0011}: {Name: "ContentIdentifier", Type: 2, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x0011},
+	{GroupApple, 0x0014}: {Name: "ImageCaptureType", Type: 9, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x0014, enum: []int64{1, 2, 10, 11, 12}, enumString: []string{"ProRAW", "Portrait", "Photo", "Manual Focus", "Scene"}},
+	{GroupApple, 0x0015}: {Name: "ImageUniqueID", Type: 2, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x0015},
+	{GroupApple, 0x0017}: {Name: "LivePhotoVideoIndex", Type: 4, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x0017},
+	{GroupApple, 0x0019}: {Name: "ImageProcessingFlags", Type: 9, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x0019},
+	{GroupApple, 0x001a}: {Name: "QualityHint", Type: 2, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x001a},
+	{GroupApple, 0x001d}: {Name: "LuminanceNoiseAmplitude", Type: 10, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x001d},
+	{GroupApple, 0x001f}: {Name: "PhotosAppFeatureFlags", Type: 9, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x001f},
+	{GroupApple, 0x0020}: {Name: "ImageCaptureRequestID", Type: 2, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x0020},
+	{GroupApple, 0x0021}: {Name: "HDRHeadroom", Type: 10, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x0021},
+	{GroupApple, 0x0023}: {Name: "AFPerformance", Type: 9, flags: 0, arrayLen: [2]int{2, 1}, ID: 0x0023},
+	{GroupApple, 0x0025}: {Name: "SceneFlags", Type: 9, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x0025},
+	{GroupApple, 0x0026}: {Name: "SignalToNoiseRatioType", Type: 9, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x0026},
+	{GroupApple, 0x0027}: {Name: "SignalToNoiseRatio", Type: 10, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x0027},
+	{GroupApple, 0x002b}: {Name: "PhotoIdentifier", Type: 2, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x002b},
+	{GroupApple, 0x002d}: {Name: "ColorTemperature", Type: 9, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x002d},
+	{GroupApple, 0x002e}: {Name: "CameraType", Type: 9, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x002e, enum: []int64{0, 1, 6}, enumString: []string{"Back Wide Angle", "Back Normal", "Front"}},
+	{GroupApple, 0x002f}: {Name: "FocusPosition", Type: 9, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x002f},
+	{GroupApple, 0x0030}: {Name: "HDRGain", Type: 10, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x0030},
+	{GroupApple, 0x0038}: {Name: "AFMeasuredDepth", Type: 9, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x0038},
+	{GroupApple, 0x003d}: {Name: "AFConfidence", Type: 9, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x003d},
+	{GroupApple, 0x003e}: {Name: "ColorCorrectionMatrix", Type: 7, flags: 0, arrayLen: [2]int{-1, 0}, ID: 0x003e},
+	{GroupApple, 0x003f}: {Name: "GreenGhostMitigationStatus", Type: 9, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x003f},
+	{GroupApple, 0x0040}: {Name: "SemanticStyle", Type: 7, flags: 0, arrayLen: [2]int{-1, 0}, ID: 0x0040},
+	{GroupApple, 0x0041}: {Name: "SemanticStyleRenderingVer", Type: 7, flags: 0, arrayLen: [2]int{-1, 0}, ID: 0x0041},
+	{GroupApple, 0x0042}: {Name: "SemanticStylePreset", Type: 7, flags: 0, arrayLen: [2]int{-1, 0}, ID: 0x0042},
+
+	// AppleRunTime maker note tags.
+	{GroupAppleRunTime, 0x0000}: {Name: "RunTimeFlags", Type: 9, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x0000},
+	{GroupAppleRunTime, 0x0001}: {Name: "RunTimeValue", Type: 17, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x0001},
+	{GroupAppleRunTime, 0x0002}: {Name: "RunTimeScale", Type: 9, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x0002},
+	{GroupAppleRunTime, 0x0003}: {Name: "RunTimeEpoch", Type: 17, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x0003},
+	{GroupAppleRunTime, 0x0004}: {Name: "RunTimeSinceBoot", Type: 12, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x0004},
+
+	// Samsung maker note tags.
+	{GroupSamsung, 0x0001}: {Name: "MakerNoteVersion", Type: 7, flags: 0, arrayLen: [2]int{4, 1}, ID: 0x0001},
+	{GroupSamsung, 0x0002}: {Name: "DeviceType", Type: 4, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x0002, enum: []int64{4096, 8192, 12288, 73728, 3145728}, enumString: []string{"Compact Digital Camera", "High-end NX Camera", "HXM Video Camera", "Cell Phone", "SMX Video Camera"}},
+	{GroupSamsung, 0x0003}: {Name: "SamsungModelID", Type: 4, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x0003},
+	{GroupSamsung, 0x0021}: {Name: "PictureWizard", Type: 3, flags: 0, arrayLen: [2]int{5, 1}, ID: 0x0021},
+	{GroupSamsung, 0x0030}: {Name: "LocalLocationName", Type: 2, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x0030},
+	{GroupSamsung, 0x0031}: {Name: "LocationName", Type: 2, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x0031},
+	{GroupSamsung, 0x0035}: {Name: "PreviewIFD", Type: 4, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x0035},
+	{GroupSamsung, 0x0040}: {Name: "RawDataByteOrder", Type: 4, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x0040},
+	{GroupSamsung, 0x0041}: {Name: "WhiteBalanceSetup", Type: 4, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x0041, enum: []int64{0, 1}, enumString: []string{"Auto", "Manual"}},
+	{GroupSamsung, 0x0043}: {Name: "CameraTemperature", Type: 10, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x0043},
+	{GroupSamsung, 0x0050}: {Name: "RawDataCFAPattern", Type: 4, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x0050},
+	{GroupSamsung, 0x0100}: {Name: "FaceDetect", Type: 3, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x0100, enum: []int64{0, 1}, enumString: []string{"Off", "On"}},
+	{GroupSamsung, 0x0120}: {Name: "FaceRecognition", Type: 4, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x0120, enum: []int64{0, 1}, enumString: []string{"Off", "On"}},
+	{GroupSamsung, 0x0123}: {Name: "FaceName", Type: 2, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x0123},
+	{GroupSamsung, 0xa001}: {Name: "FirmwareName", Type: 2, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0xa001},
+	{GroupSamsung, 0xa002}: {Name: "SerialNumber", Type: 2, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0xa002},
+	{GroupSamsung, 0xa003}: {Name: "LensType", Type: 3, flags: 0, arrayLen: [2]int{-1, 0}, ID: 0xa003},
+	{GroupSamsung, 0xa004}: {Name: "LensFirmware", Type: 2, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0xa004},
+	{GroupSamsung, 0xa005}: {Name: "InternalLensSerialNumber", Type: 2, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0xa005},
+	{GroupSamsung, 0xa010}: {Name: "SensorAreas", Type: 4, flags: 0, arrayLen: [2]int{8, 1}, ID: 0xa010},
+	{GroupSamsung, 0xa011}: {Name: "ColorSpace", Type: 3, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0xa011, enum: []int64{0, 1}, enumString: []string{"sRGB", "Adobe RGB"}},
+	{GroupSamsung, 0xa012}: {Name: "SmartRange", Type: 3, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0xa012, enum: []int64{0, 1}, enumString: []string{"Off", "On"}},
+	{GroupSamsung, 0xa013}: {Name: "ExposureCompensation", Type: 10, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0xa013},
+	{GroupSamsung, 0xa014}: {Name: "ISO", Type: 4, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0xa014},
+	{GroupSamsung, 0xa018}: {Name: "ExposureTime", Type: 5, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0xa018},
+	{GroupSamsung, 0xa019}: {Name: "FNumber", Type: 5, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0xa019},
+	{GroupSamsung, 0xa01a}: {Name: "FocalLengthIn35mmFormat", Type: 4, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0xa01a},
+	{GroupSamsung, 0xa020}: {Name: "EncryptionKey", Type: 4, flags: 0, arrayLen: [2]int{11, 1}, ID: 0xa020},
+	{GroupSamsung, 0xa021}: {Name: "WB_RGGBLevelsUncorrected", Type: 4, flags: 0, arrayLen: [2]int{4, 1}, ID: 0xa021},
+	{GroupSamsung, 0xa022}: {Name: "WB_RGGBLevelsAuto", Type: 4, flags: 0, arrayLen: [2]int{4, 1}, ID: 0xa022},
+	{GroupSamsung, 0xa023}: {Name: "WB_RGGBLevelsIlluminator1", Type: 4, flags: 0, arrayLen: [2]int{4, 1}, ID: 0xa023},
+	{GroupSamsung, 0xa024}: {Name: "WB_RGGBLevelsIlluminator2", Type: 4, flags: 0, arrayLen: [2]int{4, 1}, ID: 0xa024},
+	{GroupSamsung, 0xa028}: {Name: "WB_RGGBLevelsBlack", Type: 4, flags: 0, arrayLen: [2]int{4, 1}, ID: 0xa028},
+	{GroupSamsung, 0xa030}: {Name: "ColorMatrix", Type: 9, flags: 0, arrayLen: [2]int{9, 1}, ID: 0xa030},
+	{GroupSamsung, 0xa031}: {Name: "ColorMatrixSRGB", Type: 9, flags: 0, arrayLen: [2]int{9, 1}, ID: 0xa031},
+	{GroupSamsung, 0xa032}: {Name: "ColorMatrixAdobeRGB", Type: 9, flags: 0, arrayLen: [2]int{9, 1}, ID: 0xa032},
+	{GroupSamsung, 0xa040}: {Name: "ToneCurve1", Type: 4, flags: 0, arrayLen: [2]int{23, 1}, ID: 0xa040},
+	{GroupSamsung, 0xa041}: {Name: "ToneCurve2", Type: 4, flags: 0, arrayLen: [2]int{23, 1}, ID: 0xa041},
+	{GroupSamsung, 0xa042}: {Name: "ToneCurve3", Type: 4, flags: 0, arrayLen: [2]int{23, 1}, ID: 0xa042},
+	{GroupSamsung, 0xa043}: {Name: "ToneCurve4", Type: 4, flags: 0, arrayLen: [2]int{23, 1}, ID: 0xa043},
+
+	// Google maker note tags.
+	{GroupGoogle, 0x0000}: {Name: "HDRPSignature", Type: 7, flags: 0, arrayLen: [2]int{4, 1}, ID: 0x0000},
+	{GroupGoogle, 0x0004}: {Name: "HDRPVersion", Type: 1, flags: 0, arrayLen: [2]int{-1, 1}, ID: 0x0004},
+	{GroupGoogle, 0x0005}: {Name: "HDRPData", Type: 7, flags: 0, arrayLen: [2]int{-1, 0}, ID: 0x0005},
 }
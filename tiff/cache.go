@@ -0,0 +1,46 @@
+package tiff
+
+import "container/list"
+
+// blockCache is a least recently used cache of decoded strips or tiles.
+type blockCache struct {
+	size int
+	// lru holds the cached blocks as *cachedBlock, most recently used first.
+	lru     *list.List
+	entries map[int]*list.Element
+}
+
+type cachedBlock struct {
+	index int
+	data  []byte
+}
+
+// newBlockCache returns a cache holding up to size blocks.
+func newBlockCache(size int) *blockCache {
+	return &blockCache{size: size, lru: list.New(), entries: make(map[int]*list.Element)}
+}
+
+// get returns the block with index i and marks it as most recently used.
+func (c *blockCache) get(i int) ([]byte, bool) {
+	e, ok := c.entries[i]
+	if !ok {
+		return nil, false
+	}
+	c.lru.MoveToFront(e)
+	return e.Value.(*cachedBlock).data, true
+}
+
+// add adds the block with index i, evicting the least recently used block if the cache is full.
+func (c *blockCache) add(i int, data []byte) {
+	if e, ok := c.entries[i]; ok {
+		e.Value.(*cachedBlock).data = data
+		c.lru.MoveToFront(e)
+		return
+	}
+	if c.lru.Len() >= c.size {
+		oldest := c.lru.Back()
+		c.lru.Remove(oldest)
+		delete(c.entries, oldest.Value.(*cachedBlock).index)
+	}
+	c.entries[i] = c.lru.PushFront(&cachedBlock{index: i, data: data})
+}
@@ -0,0 +1,384 @@
+package tiff
+
+import (
+	"errors"
+	"io"
+)
+
+// CCITT compressed data consists of alternating runs of white and black pixels,
+// starting with a white run, coded with the Modified Huffman codes of ITU-T T.4.
+// Two dimensionally coded rows describe the positions where the color changes
+// relative to the changes of the previous (reference) row.
+
+// ccittMode is the coding scheme of CCITT compressed data.
+type ccittMode int
+
+const (
+	// ccittMH is Modified Huffman coding (Compression 2): one dimensionally
+	// coded rows starting at byte boundaries, without EOL codes.
+	ccittMH ccittMode = iota
+	// ccittT4 is T.4 coding (Compression 3): rows preceded by optional EOL
+	// codes and, if T4Options enables it, coded in two dimensions.
+	ccittT4
+	// ccittT6 is T.6 coding (Compression 4): two dimensionally coded rows.
+	ccittT6
+)
+
+// T4Options and T6Options flags.
+const (
+	t4TwoDimensional = 1 << 0
+	t4Uncompressed   = 1 << 1
+	t6Uncompressed   = 1 << 1
+)
+
+// Codes of the modes of two dimensional coding.
+const (
+	modePass = iota
+	modeHorizontal
+	modeV0
+	modeVR1
+	modeVR2
+	modeVR3
+	modeVL1
+	modeVL2
+	modeVL3
+	modeExtension
+	modeEOL
+)
+
+// ccittCode is a code of a CCITT code table and the run length or mode it represents.
+type ccittCode struct {
+	bits  string
+	value int
+}
+
+var modeCodes = []ccittCode{
+	{"0001", modePass}, {"001", modeHorizontal}, {"1", modeV0},
+	{"011", modeVR1}, {"000011", modeVR2}, {"0000011", modeVR3},
+	{"010", modeVL1}, {"000010", modeVL2}, {"0000010", modeVL3},
+	{"0000001", modeExtension}, {"000000000001", modeEOL},
+}
+
+var whiteCodes = []ccittCode{
+	// Terminating codes.
+	{"00110101", 0}, {"000111", 1}, {"0111", 2}, {"1000", 3},
+	{"1011", 4}, {"1100", 5}, {"1110", 6}, {"1111", 7},
+	{"10011", 8}, {"10100", 9}, {"00111", 10}, {"01000", 11},
+	{"001000", 12}, {"000011", 13}, {"110100", 14}, {"110101", 15},
+	{"101010", 16}, {"101011", 17}, {"0100111", 18}, {"0001100", 19},
+	{"0001000", 20}, {"0010111", 21}, {"0000011", 22}, {"0000100", 23},
+	{"0101000", 24}, {"0101011", 25}, {"0010011", 26}, {"0100100", 27},
+	{"0011000", 28}, {"00000010", 29}, {"00000011", 30}, {"00011010", 31},
+	{"00011011", 32}, {"00010010", 33}, {"00010011", 34}, {"00010100", 35},
+	{"00010101", 36}, {"00010110", 37}, {"00010111", 38}, {"00101000", 39},
+	{"00101001", 40}, {"00101010", 41}, {"00101011", 42}, {"00101100", 43},
+	{"00101101", 44}, {"00000100", 45}, {"00000101", 46}, {"00001010", 47},
+	{"00001011", 48}, {"01010010", 49}, {"01010011", 50}, {"01010100", 51},
+	{"01010101", 52}, {"00100100", 53}, {"00100101", 54}, {"01011000", 55},
+	{"01011001", 56}, {"01011010", 57}, {"01011011", 58}, {"01001010", 59},
+	{"01001011", 60}, {"00110010", 61}, {"00110011", 62}, {"00110100", 63},
+	// Makeup codes.
+	{"11011", 64}, {"10010", 128}, {"010111", 192}, {"0110111", 256},
+	{"00110110", 320}, {"00110111", 384}, {"01100100", 448}, {"01100101", 512},
+	{"01101000", 576}, {"01100111", 640}, {"011001100", 704}, {"011001101", 768},
+	{"011010010", 832}, {"011010011", 896}, {"011010100", 960}, {"011010101", 1024},
+	{"011010110", 1088}, {"011010111", 1152}, {"011011000", 1216}, {"011011001", 1280},
+	{"011011010", 1344}, {"011011011", 1408}, {"010011000", 1472}, {"010011001", 1536},
+	{"010011010", 1600}, {"011000", 1664}, {"010011011", 1728},
+}
+
+var blackCodes = []ccittCode{
+	// Terminating codes.
+	{"0000110111", 0}, {"010", 1}, {"11", 2}, {"10", 3},
+	{"011", 4}, {"0011", 5}, {"0010", 6}, {"00011", 7},
+	{"000101", 8}, {"000100", 9}, {"0000100", 10}, {"0000101", 11},
+	{"0000111", 12}, {"00000100", 13}, {"00000111", 14}, {"000011000", 15},
+	{"0000010111", 16}, {"0000011000", 17}, {"0000001000", 18}, {"00001100111", 19},
+	{"00001101000", 20}, {"00001101100", 21}, {"00000110111", 22}, {"00000101000", 23},
+	{"00000010111", 24}, {"00000011000", 25}, {"000011001010", 26}, {"000011001011", 27},
+	{"000011001100", 28}, {"000011001101", 29}, {"000001101000", 30}, {"000001101001", 31},
+	{"000001101010", 32}, {"000001101011", 33}, {"000011010010", 34}, {"000011010011", 35},
+	{"000011010100", 36}, {"000011010101", 37}, {"000011010110", 38}, {"000011010111", 39},
+	{"000001101100", 40}, {"000001101101", 41}, {"000011011010", 42}, {"000011011011", 43},
+	{"000001010100", 44}, {"000001010101", 45}, {"000001010110", 46}, {"000001010111", 47},
+	{"000001100100", 48}, {"000001100101", 49}, {"000001010010", 50}, {"000001010011", 51},
+	{"000000100100", 52}, {"000000110111", 53}, {"000000111000", 54}, {"000000100111", 55},
+	{"000000101000", 56}, {"000001011000", 57}, {"000001011001", 58}, {"000000101011", 59},
+	{"000000101100", 60}, {"000001011010", 61}, {"000001100110", 62}, {"000001100111", 63},
+	// Makeup codes.
+	{"0000001111", 64}, {"000011001000", 128}, {"000011001001", 192}, {"000001011011", 256},
+	{"000000110011", 320}, {"000000110100", 384}, {"000000110101", 448}, {"0000001101100", 512},
+	{"0000001101101", 576}, {"0000001001010", 640}, {"0000001001011", 704}, {"0000001001100", 768},
+	{"0000001001101", 832}, {"0000001110010", 896}, {"0000001110011", 960}, {"0000001110100", 1024},
+	{"0000001110101", 1088}, {"0000001110110", 1152}, {"0000001110111", 1216}, {"0000001010010", 1280},
+	{"0000001010011", 1344}, {"0000001010100", 1408}, {"0000001010101", 1472}, {"0000001011010", 1536},
+	{"0000001011011", 1600}, {"0000001100100", 1664}, {"0000001100101", 1728},
+}
+
+// extendedMakeupCodes are makeup codes shared by white and black runs.
+var extendedMakeupCodes = []ccittCode{
+	{"00000001000", 1792}, {"00000001100", 1856}, {"00000001101", 1920}, {"000000010010", 1984},
+	{"000000010011", 2048}, {"000000010100", 2112}, {"000000010101", 2176}, {"000000010110", 2240},
+	{"000000010111", 2304}, {"000000011100", 2368}, {"000000011101", 2432}, {"000000011110", 2496},
+	{"000000011111", 2560},
+}
+
+var (
+	modeTree  = newCCITTTree(modeCodes)
+	whiteTree = newCCITTTree(whiteCodes, extendedMakeupCodes)
+	blackTree = newCCITTTree(blackCodes, extendedMakeupCodes)
+)
+
+// ccittTree is a binary tree decoding the codes of a code table bit by bit.
+// Each node holds the children for bits 0 and 1: a positive child is the index
+// of a node, a negative child c is a leaf with value -c-1 and zero is an invalid code.
+type ccittTree [][2]int
+
+func newCCITTTree(tables ...[]ccittCode) ccittTree {
+	t := ccittTree{{}}
+	for _, table := range tables {
+		for _, code := range table {
+			n := 0
+			for i, c := range code.bits {
+				b := int(c - '0')
+				if i == len(code.bits)-1 {
+					t[n][b] = -code.value - 1
+					break
+				}
+				if t[n][b] == 0 {
+					t = append(t, [2]int{})
+					t[n][b] = len(t) - 1
+				}
+				n = t[n][b]
+			}
+		}
+	}
+	return t
+}
+
+// decode reads a code from br and returns its value.
+func (t ccittTree) decode(br *bitReader) (int, error) {
+	n := 0
+	for {
+		b, err := br.bit()
+		if err != nil {
+			return 0, err
+		}
+		c := t[n][b]
+		switch {
+		case c < 0:
+			return -c - 1, nil
+		case c == 0:
+			return 0, errors.New("invalid CCITT code")
+		}
+		n = c
+	}
+}
+
+// bitReader reads the bits of data, most significant bit first.
+type bitReader struct {
+	data []byte
+	pos  int // Position in bits.
+}
+
+func (br *bitReader) bit() (int, error) {
+	if br.pos >= 8*len(br.data) {
+		return 0, io.ErrUnexpectedEOF
+	}
+	b := br.data[br.pos/8] >> (7 - br.pos%8) & 1
+	br.pos++
+	return int(b), nil
+}
+
+// align skips to the next byte boundary.
+func (br *bitReader) align() { br.pos = (br.pos + 7) &^ 7 }
+
+// skipEOL skips an EOL code and the fill bits preceding it and reports
+// whether one was found. EOL codes are eleven zeros followed by a one,
+// a sequence no other code contains.
+func (br *bitReader) skipEOL() bool {
+	pos := br.pos
+	for pos < 8*len(br.data) && br.data[pos/8]>>(7-pos%8)&1 == 0 {
+		pos++
+	}
+	if pos-br.pos < 11 || pos == 8*len(br.data) {
+		return false
+	}
+	br.pos = pos + 1
+	return true
+}
+
+// decodeCCITT decodes rows rows of width pixels of CCITT compressed data into rows
+// of 1 bit per pixel, each starting at a byte boundary. Black pixels are ones
+// unless blackIsZero is set. options are the flags of T4Options or T6Options.
+func decodeCCITT(data []byte, width, rows int, mode ccittMode, options int, blackIsZero bool) ([]byte, error) {
+	if mode == ccittT4 && options&t4Uncompressed != 0 || mode == ccittT6 && options&t6Uncompressed != 0 {
+		return nil, errors.New("unsupported CCITT uncompressed mode")
+	}
+	stride := (width + 7) / 8
+	dst := make([]byte, stride*rows)
+	br := &bitReader{data: data}
+	// Changes holds the positions of the pixels where the color of the row changes,
+	// the even ones from white to black. The reference row is initially all white.
+	var ref, cur []int
+	var err error
+	for y := 0; y < rows; y++ {
+		twoDimensional := mode == ccittT6
+		switch mode {
+		case ccittMH:
+			br.align()
+		case ccittT4:
+			eol := br.skipEOL()
+			if options&t4TwoDimensional != 0 {
+				if !eol {
+					return nil, errors.New("missing CCITT EOL code")
+				}
+				tag, err := br.bit()
+				if err != nil {
+					return nil, err
+				}
+				twoDimensional = tag == 0
+			}
+		}
+		if twoDimensional {
+			cur, err = decodeRow2D(br, cur[:0], ref, width)
+		} else {
+			cur, err = decodeRow1D(br, cur[:0], width)
+		}
+		if err == errEndOfBlock {
+			break // The remaining rows are white.
+		} else if err != nil {
+			return nil, err
+		}
+		fillRow(dst[y*stride:(y+1)*stride], cur, width)
+		ref, cur = cur, ref
+	}
+	if blackIsZero {
+		for i := range dst {
+			dst[i] = ^dst[i]
+		}
+	}
+	return dst, nil
+}
+
+// errEndOfBlock is returned by decodeRow2D if the data ends with an EOFB code
+// before all rows are decoded.
+var errEndOfBlock = errors.New("CCITT end of block")
+
+// decodeRow1D decodes a one dimensionally coded row of width pixels
+// and appends the positions where its color changes to changes.
+func decodeRow1D(br *bitReader, changes []int, width int) ([]int, error) {
+	white := true
+	for a0 := 0; a0 < width; white = !white {
+		run, err := decodeRun(br, white)
+		if err != nil {
+			return nil, err
+		}
+		a0 += run
+		if a0 > width {
+			return nil, errors.New("CCITT run exceeds row width")
+		}
+		if a0 < width {
+			changes = append(changes, a0)
+		}
+	}
+	return changes, nil
+}
+
+// decodeRow2D decodes a two dimensionally coded row of width pixels relative
+// to the changes of the reference row and appends its changes to changes.
+func decodeRow2D(br *bitReader, changes, ref []int, width int) ([]int, error) {
+	// a0 is the position of the last decoded change, starting before the row.
+	a0, white, i := -1, true, 0
+	for a0 < width {
+		mode, err := modeTree.decode(br)
+		if err != nil {
+			return nil, err
+		}
+		// b1 is the first change of the reference row after a0 to the color
+		// opposite to that of a0 and b2 is the change following it.
+		for i > 0 && ref[i-1] > a0 {
+			i--
+		}
+		for i < len(ref) && ref[i] <= a0 {
+			i++
+		}
+		if white != (i%2 == 0) {
+			i++
+		}
+		b1, b2 := width, width
+		if i < len(ref) {
+			b1 = ref[i]
+		}
+		if i+1 < len(ref) {
+			b2 = ref[i+1]
+		}
+		switch mode {
+		case modePass:
+			a0 = b2
+		case modeHorizontal:
+			if a0 < 0 {
+				a0 = 0
+			}
+			for k := 0; k < 2; k++ {
+				run, err := decodeRun(br, white != (k == 1))
+				if err != nil {
+					return nil, err
+				}
+				a0 += run
+				if a0 > width {
+					return nil, errors.New("CCITT run exceeds row width")
+				}
+				changes = append(changes, a0)
+			}
+		case modeExtension:
+			return nil, errors.New("unsupported CCITT uncompressed mode")
+		case modeEOL:
+			if a0 < 0 && len(changes) == 0 {
+				return nil, errEndOfBlock
+			}
+			return nil, errors.New("unexpected CCITT EOL code")
+		default:
+			a1 := b1 + [...]int{0, 1, 2, 3, -1, -2, -3}[mode-modeV0]
+			if a1 < 0 || a1 < a0 || a1 > width {
+				return nil, errors.New("CCITT vertical mode change out of range")
+			}
+			changes = append(changes, a1)
+			a0 = a1
+			white = !white
+		}
+	}
+	return changes, nil
+}
+
+// decodeRun decodes the makeup codes and terminating code of a run of the given color.
+func decodeRun(br *bitReader, white bool) (int, error) {
+	t := blackTree
+	if white {
+		t = whiteTree
+	}
+	run := 0
+	for {
+		n, err := t.decode(br)
+		if err != nil {
+			return 0, err
+		}
+		run += n
+		if n < 64 {
+			return run, nil
+		}
+	}
+}
+
+// fillRow sets the bits of the black pixels of the row of width pixels with the given changes.
+func fillRow(row []byte, changes []int, width int) {
+	for k := 0; k < len(changes); k += 2 {
+		start, end := changes[k], width
+		if k+1 < len(changes) {
+			end = changes[k+1]
+		}
+		for x := start; x < end && x < width; x++ {
+			row[x/8] |= 0x80 >> (x % 8)
+		}
+	}
+}
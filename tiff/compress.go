@@ -0,0 +1,164 @@
+package tiff
+
+import (
+	"bytes"
+	"compress/zlib"
+	"encoding/binary"
+	"errors"
+	"io"
+	"math"
+)
+
+// decodePackBits decompresses the PackBits data src, returning at most size bytes.
+func decodePackBits(src []byte, size int) ([]byte, error) {
+	dst := make([]byte, 0, size)
+	for len(src) > 0 && len(dst) < size {
+		n := int(int8(src[0]))
+		src = src[1:]
+		switch {
+		case n >= 0:
+			// Literal run of n+1 bytes.
+			if n+1 > len(src) {
+				return nil, errors.New("truncated PackBits literal run")
+			}
+			dst = append(dst, src[:n+1]...)
+			src = src[n+1:]
+		case n > -128:
+			// The next byte repeated 1-n times.
+			if len(src) == 0 {
+				return nil, errors.New("truncated PackBits replicate run")
+			}
+			for i := 0; i < 1-n; i++ {
+				dst = append(dst, src[0])
+			}
+			src = src[1:]
+		}
+	}
+	if len(dst) > size {
+		dst = dst[:size]
+	}
+	return dst, nil
+}
+
+// encodePackBits appends src compressed with PackBits to dst. Runs of three
+// or more equal bytes are replicated and other bytes are copied literally.
+func encodePackBits(dst, src []byte) []byte {
+	for len(src) > 0 {
+		run := 1
+		for run < len(src) && run < 128 && src[run] == src[0] {
+			run++
+		}
+		if run >= 3 {
+			dst = append(dst, byte(1-run), src[0])
+			src = src[run:]
+			continue
+		}
+		// Copy bytes up to the next run of three.
+		n := 1
+		for n < len(src) && n < 128 && !(n+2 < len(src) && src[n] == src[n+1] && src[n] == src[n+2]) {
+			n++
+		}
+		dst = append(dst, byte(n-1))
+		dst = append(dst, src[:n]...)
+		src = src[n:]
+	}
+	return dst
+}
+
+// decodeDeflate decompresses the zlib data src, returning at most size bytes.
+func decodeDeflate(src []byte, size int) ([]byte, error) {
+	zr, err := zlib.NewReader(bytes.NewReader(src))
+	if err != nil {
+		return nil, err
+	}
+	dst := make([]byte, size)
+	n, err := io.ReadFull(zr, dst)
+	if err != nil && err != io.ErrUnexpectedEOF && err != io.EOF {
+		return nil, err
+	}
+	return dst[:n], nil
+}
+
+// Predictor values.
+const (
+	prNone          = 1
+	prHorizontal    = 2
+	prFloatingPoint = 3
+)
+
+// undoHorizontal reverses horizontal differencing of a row of samples of
+// bitsPerSample bits, where each sample is the difference to the sample
+// n samples before it.
+func undoHorizontal(row []byte, order binary.ByteOrder, bitsPerSample, n int) {
+	switch bitsPerSample {
+	case 8:
+		for i := n; i < len(row); i++ {
+			row[i] += row[i-n]
+		}
+	case 16:
+		for i := 2 * n; i+2 <= len(row); i += 2 {
+			order.PutUint16(row[i:], order.Uint16(row[i:])+order.Uint16(row[i-2*n:]))
+		}
+	case 32:
+		for i := 4 * n; i+4 <= len(row); i += 4 {
+			order.PutUint32(row[i:], order.Uint32(row[i:])+order.Uint32(row[i-4*n:]))
+		}
+	case 64:
+		for i := 8 * n; i+8 <= len(row); i += 8 {
+			order.PutUint64(row[i:], order.Uint64(row[i:])+order.Uint64(row[i-8*n:]))
+		}
+	}
+}
+
+// applyHorizontal applies horizontal differencing to a row of samples of
+// bitsPerSample bits, replacing each sample by the difference to the sample
+// n samples before it. It is the inverse of undoHorizontal.
+func applyHorizontal(row []byte, order binary.ByteOrder, bitsPerSample, n int) {
+	switch bitsPerSample {
+	case 8:
+		for i := len(row) - 1; i >= n; i-- {
+			row[i] -= row[i-n]
+		}
+	case 16:
+		for i := len(row)/2*2 - 2; i >= 2*n; i -= 2 {
+			order.PutUint16(row[i:], order.Uint16(row[i:])-order.Uint16(row[i-2*n:]))
+		}
+	}
+}
+
+// undoFloatingPoint reverses the floating point predictor of Adobe's TIFF
+// Technical Note 3 on a row of floating point samples of bitsPerSample bits,
+// using tmp as scratch space of the row's length. The bytes of the samples are
+// stored ordered by significance, most significant first, with each byte being
+// the difference to the byte n bytes before it. The samples are written in order.
+func undoFloatingPoint(row, tmp []byte, order binary.ByteOrder, bitsPerSample, n int) {
+	for i := n; i < len(row); i++ {
+		row[i] += row[i-n]
+	}
+	copy(tmp, row)
+	size := bitsPerSample / 8
+	count := len(row) / size
+	for i := 0; i < count; i++ {
+		sample := row[i*size : (i+1)*size]
+		for b := range sample {
+			// Byte b of the sample in order of significance.
+			if order == binary.BigEndian {
+				sample[b] = tmp[b*count+i]
+			} else {
+				sample[size-1-b] = tmp[b*count+i]
+			}
+		}
+	}
+}
+
+// floatSample converts a floating point sample to 16 bits, mapping 0 to black
+// and 1 to full intensity. Samples outside of that range are clamped.
+func floatSample(f float64) uint32 {
+	switch {
+	case math.IsNaN(f) || f <= 0:
+		return 0
+	case f >= 1:
+		return 0xffff
+	}
+	return uint32(f*0xffff + 0.5)
+}
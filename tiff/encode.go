@@ -0,0 +1,434 @@
+package tiff
+
+import (
+	"bytes"
+	"compress/zlib"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"image"
+	"image/color"
+	"io"
+	"math"
+
+	"github.com/soypat/exif"
+	"github.com/soypat/exif/exifid"
+	"github.com/soypat/exif/rational"
+)
+
+// Compression is the compression of the strips or tiles of an image written by [Encode].
+type Compression int
+
+// Compression schemes supported by Encode.
+const (
+	Uncompressed Compression = iota
+	Deflate
+	LZW
+	PackBits
+)
+
+// defaultStripSize is the approximate size of strips written by Encode
+// if EncodeOptions does not specify the number of rows per strip.
+const defaultStripSize = 8 << 10
+
+// EncodeOptions configures how images are written by [Encode]. The zero value
+// writes an uncompressed little endian image in strips.
+type EncodeOptions struct {
+	// ByteOrder is the byte order of the file, binary.LittleEndian if nil.
+	ByteOrder   binary.ByteOrder
+	Compression Compression
+	// Predictor enables horizontal differencing of samples before compression,
+	// which improves the compression of photographs and other continuous tone
+	// images. It requires LZW or Deflate compression and 8 or 16 bits per sample.
+	Predictor bool
+	// RowsPerStrip is the number of rows of each strip. If zero, strips of about 8 KiB are written.
+	RowsPerStrip int
+	// TileWidth and TileHeight are the dimensions of the tiles the image is
+	// written in, which must be multiples of 16. If zero, the image is written in strips.
+	TileWidth, TileHeight int
+	// IFDs holds metadata written with the image, as encoded by [exif.Marshal]. Tags of
+	// IFD0 describing the layout of the image, such as ImageWidth or StripOffsets,
+	// are replaced by those of the written image. Other tags of IFD0 and other
+	// directories, such as the ExifIFD and GPS directories, are written as given.
+	// Directories which exif.Marshal does not encode, such as maker notes, are skipped.
+	IFDs []exif.IFD
+}
+
+// layoutIDs are the IDs of the IFD0 tags describing the layout of an image.
+var layoutIDs = map[exif.ID]bool{
+	exifid.ImageWidth: true, exifid.ImageHeight: true, exifid.BitsPerSample: true,
+	exifid.Compression: true, exifid.PhotometricInterpretation: true, exifid.FillOrder: true,
+	exifid.StripOffsets: true, exifid.SamplesPerPixel: true, exifid.RowsPerStrip: true,
+	exifid.StripByteCounts: true, exifid.PlanarConfiguration: true, exifid.T4Options: true,
+	exifid.T6Options: true, exifid.Predictor: true, exifid.ColorMap: true,
+	exifid.TileWidth: true, exifid.TileLength: true, exifid.TileOffsets: true,
+	exifid.TileByteCounts: true, exifid.ExtraSamples: true, exifid.SampleFormat: true,
+}
+
+// Encode writes the image m to w in TIFF format. Gray, Gray16, CMYK and Paletted
+// images are written with their color model. RGBA and RGBA64 images are written
+// as RGB, with an associated alpha sample unless they are opaque. Images of other
+// types are converted to RGBA. opts may be nil to use the default options.
+func Encode(w io.Writer, m image.Image, opts *EncodeOptions) error {
+	if opts == nil {
+		opts = &EncodeOptions{}
+	}
+	order := opts.ByteOrder
+	if order == nil {
+		order = binary.LittleEndian
+	}
+	e, err := newEncoder(m, order)
+	if err != nil {
+		return err
+	}
+	err = e.setLayout(opts)
+	if err != nil {
+		return err
+	}
+	blocks := make([][]byte, 0, e.blocksAcross*e.blocksDown)
+	for by := 0; by < e.blocksDown; by++ {
+		for bx := 0; bx < e.blocksAcross; bx++ {
+			block, err := e.encodeBlock(bx, by)
+			if err != nil {
+				return err
+			}
+			blocks = append(blocks, block)
+		}
+	}
+	build := func(start int64) ([]byte, error) {
+		offsets := make([]int64, len(blocks))
+		counts := make([]int64, len(blocks))
+		for i, block := range blocks {
+			offsets[i] = start
+			counts[i] = int64(len(block))
+			start += int64(len(block))
+		}
+		if start > math.MaxUint32 {
+			return nil, errors.New("image too large for TIFF")
+		}
+		ifds, err := e.ifds(opts.IFDs, offsets, counts)
+		if err != nil {
+			return nil, err
+		}
+		return exif.Marshal(order, ifds)
+	}
+	// The size of the directories does not depend on the offsets of the blocks.
+	header, err := build(0)
+	if err != nil {
+		return err
+	}
+	header, err = build(int64(len(header)))
+	if err != nil {
+		return err
+	}
+	_, err = w.Write(header)
+	if err != nil {
+		return err
+	}
+	for _, block := range blocks {
+		_, err = w.Write(block)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// encoder writes the samples of an image in strips or tiles.
+type encoder struct {
+	m     image.Image
+	order binary.ByteOrder
+
+	photometric     int
+	bitsPerSample   int
+	samplesPerPixel int
+	extraSamples    []int64
+	colorMap        []int64
+	// row writes the samples of the pixels from x0 up to x1 of row y to dst.
+	row func(dst []byte, x0, x1, y int)
+
+	compression              Compression
+	predictor                bool
+	tiled                    bool
+	blockWidth, blockHeight  int
+	blocksAcross, blocksDown int
+}
+
+func newEncoder(m image.Image, order binary.ByteOrder) (*encoder, error) {
+	if m.Bounds().Empty() {
+		return nil, errors.New("cannot encode empty image")
+	}
+	e := &encoder{m: m, order: order, photometric: pRGB, bitsPerSample: 8, samplesPerPixel: 1}
+	switch m := m.(type) {
+	case *image.Gray:
+		e.photometric = pBlackIsZero
+		e.row = func(dst []byte, x0, x1, y int) {
+			i := m.PixOffset(x0, y)
+			copy(dst, m.Pix[i:i+x1-x0])
+		}
+	case *image.Gray16:
+		e.photometric, e.bitsPerSample = pBlackIsZero, 16
+		e.row = func(dst []byte, x0, x1, y int) {
+			pix := m.Pix[m.PixOffset(x0, y):]
+			for i := 0; i < 2*(x1-x0); i += 2 {
+				order.PutUint16(dst[i:], uint16(pix[i])<<8|uint16(pix[i+1]))
+			}
+		}
+	case *image.RGBA:
+		e.setRGB(m.Opaque())
+		n := e.samplesPerPixel
+		e.row = func(dst []byte, x0, x1, y int) {
+			pix := m.Pix[m.PixOffset(x0, y):]
+			for x := 0; x < x1-x0; x++ {
+				copy(dst[n*x:n*x+n], pix[4*x:])
+			}
+		}
+	case *image.RGBA64:
+		e.setRGB(m.Opaque())
+		e.bitsPerSample = 16
+		n := e.samplesPerPixel
+		e.row = func(dst []byte, x0, x1, y int) {
+			pix := m.Pix[m.PixOffset(x0, y):]
+			for x := 0; x < x1-x0; x++ {
+				for s := 0; s < n; s++ {
+					i := 8*x + 2*s
+					order.PutUint16(dst[2*(n*x+s):], uint16(pix[i])<<8|uint16(pix[i+1]))
+				}
+			}
+		}
+	case *image.CMYK:
+		e.photometric, e.samplesPerPixel = pCMYK, 4
+		e.row = func(dst []byte, x0, x1, y int) {
+			i := m.PixOffset(x0, y)
+			copy(dst, m.Pix[i:i+4*(x1-x0)])
+		}
+	case *image.Paletted:
+		err := e.setPalette(m.Palette)
+		if err != nil {
+			return nil, err
+		}
+		bps := e.bitsPerSample
+		e.row = func(dst []byte, x0, x1, y int) {
+			pix := m.Pix[m.PixOffset(x0, y):]
+			for x := 0; x < x1-x0; x++ {
+				bit := x * bps
+				dst[bit/8] |= (pix[x] & (1<<bps - 1)) << (8 - bps - bit%8)
+			}
+		}
+	default:
+		opaque, ok := m.(interface{ Opaque() bool })
+		e.setRGB(ok && opaque.Opaque())
+		n := e.samplesPerPixel
+		e.row = func(dst []byte, x0, x1, y int) {
+			for x := x0; x < x1; x++ {
+				c := color.RGBAModel.Convert(m.At(x, y)).(color.RGBA)
+				px := dst[n*(x-x0):]
+				px[0], px[1], px[2] = c.R, c.G, c.B
+				if n == 4 {
+					px[3] = c.A
+				}
+			}
+		}
+	}
+	return e, nil
+}
+
+// setRGB sets the encoder's format to RGB with an associated alpha sample unless opaque.
+func (e *encoder) setRGB(opaque bool) {
+	e.photometric, e.samplesPerPixel = pRGB, 3
+	if !opaque {
+		e.samplesPerPixel = 4
+		e.extraSamples = []int64{alphaAssociated}
+	}
+}
+
+// setPalette sets the encoder's format to paletted with the fewest bits per
+// sample which index all colors of p.
+func (e *encoder) setPalette(p color.Palette) error {
+	if len(p) == 0 || len(p) > 256 {
+		return fmt.Errorf("cannot encode palette of %d colors", len(p))
+	}
+	e.photometric = pPaletted
+	e.bitsPerSample = 1
+	for len(p) > 1<<e.bitsPerSample {
+		e.bitsPerSample *= 2
+	}
+	n := 1 << e.bitsPerSample
+	e.colorMap = make([]int64, 3*n)
+	for i, c := range p {
+		r, g, b, _ := c.RGBA()
+		e.colorMap[i], e.colorMap[i+n], e.colorMap[i+2*n] = int64(r), int64(g), int64(b)
+	}
+	return nil
+}
+
+// setLayout validates opts and sets the dimensions of the strips or tiles.
+func (e *encoder) setLayout(opts *EncodeOptions) error {
+	switch opts.Compression {
+	case Uncompressed, Deflate, LZW, PackBits:
+	default:
+		return fmt.Errorf("unknown compression %d", opts.Compression)
+	}
+	e.compression = opts.Compression
+	e.predictor = opts.Predictor
+	if e.predictor {
+		if e.compression != LZW && e.compression != Deflate {
+			return errors.New("predictor requires LZW or Deflate compression")
+		}
+		if e.bitsPerSample != 8 && e.bitsPerSample != 16 {
+			return fmt.Errorf("predictor unsupported for %d bits per sample", e.bitsPerSample)
+		}
+	}
+	width, height := e.m.Bounds().Dx(), e.m.Bounds().Dy()
+	e.tiled = opts.TileWidth != 0 || opts.TileHeight != 0
+	if e.tiled {
+		if opts.TileWidth <= 0 || opts.TileHeight <= 0 || opts.TileWidth%16 != 0 || opts.TileHeight%16 != 0 {
+			return errors.New("tile dimensions must be positive multiples of 16")
+		}
+		e.blockWidth, e.blockHeight = opts.TileWidth, opts.TileHeight
+	} else {
+		e.blockWidth, e.blockHeight = width, opts.RowsPerStrip
+		if e.blockHeight <= 0 {
+			e.blockHeight = defaultStripSize / e.stride()
+		}
+		if e.blockHeight < 1 {
+			e.blockHeight = 1
+		} else if e.blockHeight > height {
+			e.blockHeight = height
+		}
+	}
+	if int64(e.blockHeight)*int64(e.stride()) > maxBlockSize {
+		return errors.New("strip or tile too large")
+	}
+	e.blocksAcross = (width + e.blockWidth - 1) / e.blockWidth
+	e.blocksDown = (height + e.blockHeight - 1) / e.blockHeight
+	return nil
+}
+
+// stride returns the number of bytes of a row of a strip or tile.
+func (e *encoder) stride() int {
+	return (e.blockWidth*e.bitsPerSample*e.samplesPerPixel + 7) / 8
+}
+
+// encodeBlock returns the compressed strip or tile at column bx and row by of the
+// grid of blocks. Tiles extending past the image are padded with zeros.
+func (e *encoder) encodeBlock(bx, by int) ([]byte, error) {
+	b := e.m.Bounds()
+	x0, y0 := b.Min.X+bx*e.blockWidth, b.Min.Y+by*e.blockHeight
+	x1, y1 := x0+e.blockWidth, y0+e.blockHeight
+	if x1 > b.Max.X {
+		x1 = b.Max.X
+	}
+	if y1 > b.Max.Y {
+		y1 = b.Max.Y
+	}
+	rows := y1 - y0
+	if e.tiled {
+		rows = e.blockHeight
+	}
+	stride := e.stride()
+	raw := make([]byte, stride*rows)
+	for y := y0; y < y1; y++ {
+		row := raw[(y-y0)*stride : (y-y0+1)*stride]
+		e.row(row, x0, x1, y)
+		if e.predictor {
+			applyHorizontal(row, e.order, e.bitsPerSample, e.samplesPerPixel)
+		}
+	}
+	switch e.compression {
+	case Deflate:
+		var buf bytes.Buffer
+		zw := zlib.NewWriter(&buf)
+		_, err := zw.Write(raw)
+		if err == nil {
+			err = zw.Close()
+		}
+		return buf.Bytes(), err
+	case LZW:
+		return encodeLZW(raw), nil
+	case PackBits:
+		// Rows are compressed separately.
+		var dst []byte
+		for i := 0; i < len(raw); i += stride {
+			dst = encodePackBits(dst, raw[i:i+stride])
+		}
+		return dst, nil
+	}
+	return raw, nil
+}
+
+// ifds returns the directories of the file, with the tags describing the image
+// whose blocks are at offsets and of counts bytes added to IFD0 of metadata.
+func (e *encoder) ifds(metadata []exif.IFD, offsets, counts []int64) ([]exif.IFD, error) {
+	var ifds []exif.IFD
+	var tags []exif.Tag
+	hasResolution := false
+	for _, ifd := range metadata {
+		if ifd.Group != exif.GroupIFD0 {
+			ifds = append(ifds, ifd)
+			continue
+		}
+		for _, tag := range ifd.Tags {
+			switch {
+			case layoutIDs[tag.ID]:
+				continue
+			case tag.ID == exifid.XResolution || tag.ID == exifid.YResolution || tag.ID == exifid.ResolutionUnit:
+				hasResolution = true
+			}
+			tags = append(tags, tag)
+		}
+	}
+	var err error
+	add := func(id exif.ID, value any) {
+		if err != nil {
+			return
+		}
+		var tag exif.Tag
+		tag, err = exif.NewGroupTag(exif.GroupIFD0, id, value)
+		if err != nil {
+			err = fmt.Errorf("creating %s tag: %w", id.String(), err)
+		}
+		tags = append(tags, tag)
+	}
+	b := e.m.Bounds()
+	bps := make([]int64, e.samplesPerPixel)
+	for i := range bps {
+		bps[i] = int64(e.bitsPerSample)
+	}
+	add(exifid.ImageWidth, int64(b.Dx()))
+	add(exifid.ImageHeight, int64(b.Dy()))
+	add(exifid.BitsPerSample, bps)
+	add(exifid.Compression, [...]int64{Uncompressed: cNone, Deflate: cDeflate, LZW: cLZW, PackBits: cPackBits}[e.compression])
+	add(exifid.PhotometricInterpretation, int64(e.photometric))
+	add(exifid.SamplesPerPixel, int64(e.samplesPerPixel))
+	add(exifid.PlanarConfiguration, int64(1))
+	if e.tiled {
+		add(exifid.TileWidth, int64(e.blockWidth))
+		add(exifid.TileLength, int64(e.blockHeight))
+		add(exifid.TileOffsets, offsets)
+		add(exifid.TileByteCounts, counts)
+	} else {
+		add(exifid.RowsPerStrip, int64(e.blockHeight))
+		add(exifid.StripOffsets, offsets)
+		add(exifid.StripByteCounts, counts)
+	}
+	if e.predictor {
+		add(exifid.Predictor, int64(prHorizontal))
+	}
+	if e.extraSamples != nil {
+		add(exifid.ExtraSamples, e.extraSamples)
+	}
+	if e.colorMap != nil {
+		add(exifid.ColorMap, e.colorMap)
+	}
+	if !hasResolution {
+		// Baseline TIFF readers require a resolution.
+		add(exifid.XResolution, rational.NewU64(72, 1))
+		add(exifid.YResolution, rational.NewU64(72, 1))
+		add(exifid.ResolutionUnit, int64(2)) // Inches.
+	}
+	if err != nil {
+		return nil, err
+	}
+	return append([]exif.IFD{{Group: exif.GroupIFD0, Tags: tags}}, ifds...), nil
+}
@@ -0,0 +1,222 @@
+package tiff
+
+import (
+	"bytes"
+	"encoding/binary"
+	"image"
+	"image/color"
+	"math/rand"
+	"testing"
+
+	"github.com/soypat/exif"
+	"github.com/soypat/exif/exifid"
+)
+
+func TestEncodeLZW(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	for _, n := range []int{0, 1, 2, 300, 5000, 100000} {
+		data := make([]byte, n)
+		for i := range data {
+			data[i] = byte(rng.Intn(16))
+		}
+		src := encodeLZW(data)
+		if want := encodeTestLZW(data, false); !bytes.Equal(src, want) {
+			t.Errorf("%d bytes: encoding differs from reference encoder", n)
+		}
+		got, err := decodeLZW(src, n)
+		if err != nil || !bytes.Equal(got, data) {
+			t.Errorf("%d bytes: round trip failed (%v)", n, err)
+		}
+	}
+}
+
+func TestEncodePackBits(t *testing.T) {
+	for _, data := range [][]byte{
+		{},
+		{1},
+		{1, 1},
+		{1, 1, 1},
+		{1, 2, 3, 3, 3, 3, 4, 4, 5},
+		bytes.Repeat([]byte{7}, 300),
+		append(bytes.Repeat([]byte{1, 2}, 200), 9, 9, 9),
+	} {
+		src := encodePackBits(nil, data)
+		got, err := decodePackBits(src, len(data))
+		if err != nil || !bytes.Equal(got, data) {
+			t.Errorf("% x: got % x (%v)", data, got, err)
+		}
+	}
+}
+
+// testImages returns images of each type Encode writes natively, of a size
+// not divisible by the tile dimensions used in tests, and with a nonzero origin.
+func testImages() []image.Image {
+	r := image.Rect(3, 5, 3+37, 5+21)
+	gray := image.NewGray(r)
+	gray16 := image.NewGray16(r)
+	rgba := image.NewRGBA(r)
+	opaque := image.NewRGBA(r)
+	rgba64 := image.NewRGBA64(r)
+	cmyk := image.NewCMYK(r)
+	bilevel := image.NewPaletted(r, color.Palette{color.Black, color.White})
+	paletted := image.NewPaletted(r, color.Palette{
+		color.RGBA{0xff, 0, 0, 0xff}, color.RGBA{0, 0xff, 0, 0xff}, color.RGBA{0, 0, 0xff, 0xff},
+		color.RGBA{0x12, 0x34, 0x56, 0xff}, color.White,
+	})
+	nrgba := image.NewNRGBA(r)
+	for y := r.Min.Y; y < r.Max.Y; y++ {
+		for x := r.Min.X; x < r.Max.X; x++ {
+			v := uint8(x*7 + y*3)
+			a := uint8(x * 13)
+			gray.SetGray(x, y, color.Gray{Y: v})
+			gray16.SetGray16(x, y, color.Gray16{Y: uint16(v)<<8 | uint16(a)})
+			rgba.SetRGBA(x, y, color.RGBA{R: v / 2, G: a / 3, B: v / 4, A: a})
+			opaque.SetRGBA(x, y, color.RGBA{R: v, G: a, B: v ^ a, A: 0xff})
+			rgba64.SetRGBA64(x, y, color.RGBA64{R: uint16(v) << 7, G: uint16(a) << 6, B: uint16(v) << 5, A: uint16(a)<<8 | uint16(v)})
+			cmyk.SetCMYK(x, y, color.CMYK{C: v, M: a, Y: v ^ a, K: v / 2})
+			bilevel.SetColorIndex(x, y, uint8(x+y)%2)
+			paletted.SetColorIndex(x, y, uint8(x*y)%5)
+			nrgba.SetNRGBA(x, y, color.NRGBA{R: v, G: a, B: 3 * v, A: a | 1})
+		}
+	}
+	return []image.Image{gray, gray16, rgba, opaque, rgba64, cmyk, bilevel, paletted, nrgba}
+}
+
+func TestEncode(t *testing.T) {
+	for _, opts := range []EncodeOptions{
+		{},
+		{ByteOrder: binary.BigEndian, RowsPerStrip: 4},
+		{Compression: Deflate, Predictor: true, RowsPerStrip: 5},
+		{Compression: LZW, Predictor: true, ByteOrder: binary.BigEndian},
+		{Compression: LZW, TileWidth: 16, TileHeight: 32},
+		{Compression: PackBits, TileWidth: 32, TileHeight: 16, ByteOrder: binary.BigEndian},
+		{Compression: Deflate, Predictor: true, TileWidth: 16, TileHeight: 16},
+	} {
+		for _, src := range testImages() {
+			if _, ok := src.(*image.Paletted); ok && opts.Predictor {
+				continue
+			}
+			var buf bytes.Buffer
+			err := Encode(&buf, src, &opts)
+			if err != nil {
+				t.Fatalf("%T with options %+v: %v", src, opts, err)
+			}
+			m, err := Open(bytes.NewReader(buf.Bytes()), Options{})
+			if err != nil {
+				t.Fatalf("%T with options %+v: %v", src, opts, err)
+			}
+			testEncodedPixels(t, src, m, opts)
+		}
+	}
+}
+
+// testEncodedPixels checks that the pixels of m are those of src encoded with opts.
+func testEncodedPixels(t *testing.T, src image.Image, m *Image, opts EncodeOptions) {
+	t.Helper()
+	b := src.Bounds()
+	if m.Bounds() != image.Rect(0, 0, b.Dx(), b.Dy()) {
+		t.Fatalf("%T with options %+v: got bounds %v", src, opts, m.Bounds())
+	}
+	_, native := src.(*image.NRGBA)
+	native = !native
+	for y := b.Min.Y; y < b.Max.Y; y++ {
+		for x := b.Min.X; x < b.Max.X; x++ {
+			want := src.At(x, y)
+			if !native {
+				want = color.RGBAModel.Convert(want)
+			}
+			got := m.At(x-b.Min.X, y-b.Min.Y)
+			r0, g0, b0, a0 := want.RGBA()
+			r1, g1, b1, a1 := got.RGBA()
+			if r0 != r1 || g0 != g1 || b0 != b1 || a0 != a1 {
+				t.Fatalf("%T with options %+v: pixel (%d, %d): got %v, want %v", src, opts, x, y, got, want)
+			}
+		}
+	}
+	if err := m.Err(); err != nil {
+		t.Fatalf("%T with options %+v: %v", src, opts, err)
+	}
+}
+
+func TestEncode_metadata(t *testing.T) {
+	mustTag := func(g exif.Group, id exif.ID, value any) exif.Tag {
+		t.Helper()
+		tag, err := exif.NewGroupTag(g, id, value)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return tag
+	}
+	src := image.NewGray(image.Rect(0, 0, 4, 4))
+	var buf bytes.Buffer
+	err := Encode(&buf, src, &EncodeOptions{
+		ByteOrder: binary.BigEndian,
+		IFDs: []exif.IFD{
+			{Group: exif.GroupIFD0, Tags: []exif.Tag{
+				mustTag(exif.GroupIFD0, exifid.Artist, "Scanner"),
+				mustTag(exif.GroupIFD0, exifid.ImageWidth, int64(1000)), // Replaced.
+			}},
+			{Group: exif.GroupExifIFD, Tags: []exif.Tag{
+				mustTag(exif.GroupExifIFD, exifid.DateTimeOriginal, "2024:01:02 03:04:05"),
+			}},
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	data := buf.Bytes()
+	if string(data[:4]) != beHeader {
+		t.Errorf("got header %q", data[:4])
+	}
+	r := bytes.NewReader(data)
+	lt := new(exif.LazyDecoder)
+	err = lt.Decode(r)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, tc := range []struct {
+		g    exif.Group
+		id   exif.ID
+		want any
+	}{
+		{exif.GroupIFD0, exifid.Artist, "Scanner\x00"},
+		{exif.GroupIFD0, exifid.ImageWidth, int64(4)},
+		{exif.GroupIFD0, exifid.ResolutionUnit, int64(2)},
+		{exif.GroupExifIFD, exifid.DateTimeOriginal, "2024:01:02 03:04:05\x00"},
+	} {
+		tag, err := lt.GetTag(r, tc.g, tc.id)
+		if err != nil {
+			t.Errorf("%s: %v", tc.id.String(), err)
+			continue
+		}
+		if got := tag.Value(); got != tc.want {
+			t.Errorf("%s: got %#v, want %#v", tc.id.String(), got, tc.want)
+		}
+	}
+	m, err := Open(r, Options{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	testEncodedPixels(t, src, m, EncodeOptions{})
+}
+
+func TestEncode_errors(t *testing.T) {
+	gray := image.NewGray(image.Rect(0, 0, 4, 4))
+	bilevel := image.NewPaletted(gray.Rect, color.Palette{color.Black, color.White})
+	for _, tc := range []struct {
+		name string
+		m    image.Image
+		opts EncodeOptions
+	}{
+		{"empty image", image.NewGray(image.Rectangle{}), EncodeOptions{}},
+		{"tile size", gray, EncodeOptions{TileWidth: 20, TileHeight: 16}},
+		{"predictor without compression", gray, EncodeOptions{Predictor: true}},
+		{"predictor of 1 bit samples", bilevel, EncodeOptions{Compression: LZW, Predictor: true}},
+		{"unknown compression", gray, EncodeOptions{Compression: -1}},
+		{"palette too large", image.NewPaletted(gray.Rect, make(color.Palette, 257)), EncodeOptions{}},
+	} {
+		if err := Encode(new(bytes.Buffer), tc.m, &tc.opts); err == nil {
+			t.Errorf("%s: expected error", tc.name)
+		}
+	}
+}
@@ -0,0 +1,632 @@
+package tiff
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"image"
+	"image/color"
+	"io"
+	"math"
+	"strings"
+	"sync"
+
+	"github.com/soypat/exif"
+	"github.com/soypat/exif/exifid"
+)
+
+// PhotometricInterpretation values.
+const (
+	pWhiteIsZero = 0
+	pBlackIsZero = 1
+	pRGB         = 2
+	pPaletted    = 3
+	pCMYK        = 5
+)
+
+// Compression values.
+const (
+	cNone       = 1
+	cCCITT      = 2 // Modified Huffman run length coding.
+	cCCITTFax3  = 3 // T.4 (Group 3 fax).
+	cCCITTFax4  = 4 // T.6 (Group 4 fax).
+	cLZW        = 5
+	cDeflate    = 8
+	cPackBits   = 32773
+	cDeflateOld = 32946 // Deflate before its registration as Compression 8.
+)
+
+// SampleFormat values.
+const (
+	sfUint  = 1
+	sfFloat = 3
+)
+
+// ExtraSamples values describing an alpha channel.
+const (
+	alphaNone         = 0
+	alphaAssociated   = 1
+	alphaUnassociated = 2
+)
+
+// maxColorSamples is the maximum number of samples used to obtain a pixel's
+// color, which are the samples of the CMYK color space and alpha.
+const maxColorSamples = 5
+
+// maxBlockSize is the maximum size of a decoded strip or tile.
+const maxBlockSize = 1 << 30
+
+// maxBlockExpansion bounds the byte count of a compressed strip or tile to a
+// multiple of its decoded size, well above the expansion of any supported compression.
+const maxBlockExpansion = 8
+
+// Image is a TIFF image whose pixels are decoded on demand. It implements image.Image.
+// The strips or tiles containing accessed pixels are decoded and kept in a least
+// recently used cache. Image is safe for concurrent use.
+type Image struct {
+	r     io.ReaderAt
+	size  int64 // Size of r, or -1 if unknown.
+	order binary.ByteOrder
+
+	width, height   int
+	bitsPerSample   int
+	floating        bool // Samples are IEEE floating point numbers.
+	samplesPerPixel int
+	photometric     int
+	compression     int
+	ccittOptions    int // T4Options or T6Options.
+	fillOrder       int
+	predictor       int
+	planar          bool
+	alpha           int
+	palette         color.Palette
+	model           color.Model
+
+	// Strips are blocks which span the width of the image.
+	blockWidth, blockHeight  int
+	blocksAcross, blocksDown int
+	tiled                    bool
+	offsets, counts          []int64
+
+	mu    sync.Mutex
+	cache *blockCache
+	err   error
+}
+
+// newImage returns the image described by the directory dir of the TIFF file r
+// decoded by lt. order is the byte order of the file.
+func newImage(r io.ReaderAt, lt *exif.LazyDecoder, dir *exif.Dir, order binary.ByteOrder, opts Options) (*Image, error) {
+	if dir == nil {
+		return nil, errors.New("missing image file directory")
+	}
+	ifd := ifdReader{lt: lt, r: r, dir: dir}
+	m := &Image{
+		r:               r,
+		size:            readerSize(r),
+		order:           order,
+		width:           int(ifd.int(exifid.ImageWidth, 0)),
+		height:          int(ifd.int(exifid.ImageHeight, 0)),
+		samplesPerPixel: int(ifd.int(exifid.SamplesPerPixel, 1)),
+		photometric:     int(ifd.int(exifid.PhotometricInterpretation, -1)),
+		compression:     int(ifd.int(exifid.Compression, cNone)),
+		fillOrder:       int(ifd.int(exifid.FillOrder, 1)),
+		predictor:       int(ifd.int(exifid.Predictor, prNone)),
+		planar:          ifd.int(exifid.PlanarConfiguration, 1) == 2,
+	}
+	bps := ifd.ints(exifid.BitsPerSample)
+	extra := ifd.ints(exifid.ExtraSamples)
+	sampleFormat := ifd.ints(exifid.SampleFormat)
+	if ifd.err != nil {
+		return nil, ifd.err
+	}
+	if m.width <= 0 || m.height <= 0 {
+		return nil, errors.New("missing or invalid image dimensions")
+	}
+	m.bitsPerSample = 1
+	for i, b := range bps {
+		if i == 0 {
+			m.bitsPerSample = int(b)
+		} else if int(b) != m.bitsPerSample {
+			return nil, errors.New("unsupported BitsPerSample differing between samples")
+		}
+	}
+	for _, f := range sampleFormat {
+		if (f != sfUint && f != sfFloat) || f != sampleFormat[0] {
+			return nil, fmt.Errorf("unsupported SampleFormat %d", f)
+		}
+	}
+	m.floating = len(sampleFormat) > 0 && sampleFormat[0] == sfFloat
+	switch {
+	case m.floating && (m.bitsPerSample == 32 || m.bitsPerSample == 64):
+	case m.floating:
+		return nil, fmt.Errorf("unsupported floating point BitsPerSample %d", m.bitsPerSample)
+	case m.bitsPerSample == 1, m.bitsPerSample == 2, m.bitsPerSample == 4, m.bitsPerSample == 8, m.bitsPerSample == 16:
+	default:
+		return nil, fmt.Errorf("unsupported BitsPerSample %d", m.bitsPerSample)
+	}
+	if len(extra) > 0 {
+		m.alpha = int(extra[0])
+	}
+	switch m.compression {
+	case cNone, cLZW, cDeflate, cDeflateOld, cPackBits:
+	case cCCITT, cCCITTFax3, cCCITTFax4:
+		if m.bitsPerSample != 1 || m.samplesPerPixel != 1 || (m.photometric != pWhiteIsZero && m.photometric != pBlackIsZero) {
+			return nil, errors.New("CCITT compression requires bilevel images")
+		}
+		switch m.compression {
+		case cCCITTFax3:
+			m.ccittOptions = int(ifd.int(exifid.T4Options, 0))
+		case cCCITTFax4:
+			m.ccittOptions = int(ifd.int(exifid.T6Options, 0))
+		}
+	default:
+		return nil, fmt.Errorf("unsupported Compression %d", m.compression)
+	}
+	switch {
+	case m.predictor == prNone:
+	case m.predictor == prHorizontal && m.bitsPerSample >= 8:
+	case m.predictor == prFloatingPoint && m.floating:
+	default:
+		return nil, fmt.Errorf("unsupported Predictor %d for BitsPerSample %d", m.predictor, m.bitsPerSample)
+	}
+	err := m.setColorModel(&ifd)
+	if err != nil {
+		return nil, err
+	}
+	err = m.setLayout(&ifd)
+	if err != nil {
+		return nil, err
+	}
+	size := opts.CacheSize
+	if size <= 0 {
+		size = DefaultCacheSize
+	}
+	m.cache = newBlockCache(size)
+	return m, nil
+}
+
+// colorSamples returns the number of samples which determine the color of a
+// pixel for the photometric interpretation, excluding alpha.
+func colorSamples(photometric int) int {
+	switch photometric {
+	case pRGB:
+		return 3
+	case pCMYK:
+		return 4
+	}
+	return 1
+}
+
+// setColorModel validates the photometric interpretation and sets the color model.
+func (m *Image) setColorModel(ifd *ifdReader) error {
+	n := colorSamples(m.photometric)
+	if m.samplesPerPixel < n {
+		return fmt.Errorf("too few samples (%d) for PhotometricInterpretation %d", m.samplesPerPixel, m.photometric)
+	}
+	if m.samplesPerPixel == n || m.alpha == alphaNone {
+		m.alpha = alphaNone
+	} else if m.alpha != alphaAssociated && m.alpha != alphaUnassociated {
+		m.alpha = alphaNone
+	}
+	wide := m.bitsPerSample >= 16
+	switch m.photometric {
+	case pWhiteIsZero, pBlackIsZero, pRGB:
+		switch {
+		case m.alpha == alphaAssociated && wide:
+			m.model = color.RGBA64Model
+		case m.alpha == alphaAssociated:
+			m.model = color.RGBAModel
+		case m.alpha == alphaUnassociated && wide:
+			m.model = color.NRGBA64Model
+		case m.alpha == alphaUnassociated:
+			m.model = color.NRGBAModel
+		case m.photometric == pRGB && wide:
+			m.model = color.RGBA64Model
+		case m.photometric == pRGB:
+			m.model = color.RGBAModel
+		case wide:
+			m.model = color.Gray16Model
+		default:
+			m.model = color.GrayModel
+		}
+	case pPaletted:
+		if m.bitsPerSample > 8 {
+			return fmt.Errorf("unsupported paletted BitsPerSample %d", m.bitsPerSample)
+		}
+		cmap := ifd.ints(exifid.ColorMap)
+		if ifd.err != nil {
+			return ifd.err
+		}
+		n := 1 << m.bitsPerSample
+		if len(cmap) != 3*n {
+			return errors.New("missing or invalid ColorMap")
+		}
+		m.palette = make(color.Palette, n)
+		for i := range m.palette {
+			m.palette[i] = color.RGBA64{
+				R: uint16(cmap[i]),
+				G: uint16(cmap[i+n]),
+				B: uint16(cmap[i+2*n]),
+				A: 0xffff,
+			}
+		}
+		m.model = m.palette
+	case pCMYK:
+		if ifd.int(exifid.InkSet, 1) != 1 {
+			return errors.New("unsupported InkSet")
+		}
+		m.model = color.CMYKModel
+	default:
+		return fmt.Errorf("unsupported PhotometricInterpretation %d", m.photometric)
+	}
+	return ifd.err
+}
+
+// setLayout reads the location of the image's strips or tiles.
+func (m *Image) setLayout(ifd *ifdReader) error {
+	m.tiled = ifd.has(exifid.TileWidth)
+	if m.tiled {
+		m.blockWidth = int(ifd.int(exifid.TileWidth, 0))
+		m.blockHeight = int(ifd.int(exifid.TileLength, 0))
+		m.offsets = ifd.ints(exifid.TileOffsets)
+		m.counts = ifd.ints(exifid.TileByteCounts)
+	} else {
+		m.blockWidth = m.width
+		m.blockHeight = int(ifd.int(exifid.RowsPerStrip, int64(m.height)))
+		if m.blockHeight > m.height {
+			m.blockHeight = m.height
+		}
+		m.offsets = ifd.ints(exifid.StripOffsets)
+		m.counts = ifd.ints(exifid.StripByteCounts)
+	}
+	if ifd.err != nil {
+		return ifd.err
+	}
+	if m.blockWidth <= 0 || m.blockHeight <= 0 {
+		return errors.New("invalid strip or tile dimensions")
+	}
+	if int64(m.blockHeight)*int64(m.stride()) > maxBlockSize {
+		return errors.New("strip or tile too large")
+	}
+	m.blocksAcross = (m.width + m.blockWidth - 1) / m.blockWidth
+	m.blocksDown = (m.height + m.blockHeight - 1) / m.blockHeight
+	n := m.blocksAcross * m.blocksDown
+	if m.planar {
+		n *= m.samplesPerPixel
+	}
+	if len(m.offsets) != n || len(m.counts) != n {
+		return fmt.Errorf("expected %d strip or tile offsets and byte counts, got %d and %d", n, len(m.offsets), len(m.counts))
+	}
+	return nil
+}
+
+// ColorModel returns the color model of the image, which is a color.Palette for paletted images.
+func (m *Image) ColorModel() color.Model { return m.model }
+
+// Bounds returns the bounds of the image, which start at the origin.
+func (m *Image) Bounds() image.Rectangle { return image.Rect(0, 0, m.width, m.height) }
+
+// At returns the color of the pixel at (x, y), decoding the strip or tile containing
+// it if it is not cached. If the strip or tile fails to decode At returns the color
+// of zero valued samples and the error is reported by [Image.Err].
+func (m *Image) At(x, y int) color.Color {
+	var samples [maxColorSamples]uint32
+	if image.Pt(x, y).In(m.Bounds()) {
+		m.mu.Lock()
+		err := m.samples(samples[:], x, y)
+		if err != nil && m.err == nil {
+			m.err = err
+		}
+		m.mu.Unlock()
+	}
+	return m.color(samples[:])
+}
+
+// Err returns the first error encountered decoding strips or tiles in [Image.At].
+func (m *Image) Err() error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.err
+}
+
+// NumBlocks returns the number of strips or tiles of the image. Images with
+// PlanarConfiguration 2 store each sample in separate strips or tiles, which
+// are indexed by sample first.
+func (m *Image) NumBlocks() int { return len(m.offsets) }
+
+// Block returns the decompressed samples of the strip or tile with index i,
+// with the predictor reversed. Each row starts at a byte boundary and samples
+// wider than a byte are in the byte order of the file. Short strips and tiles
+// are padded with zeros.
+func (m *Image) Block(i int) ([]byte, error) {
+	if i < 0 || i >= len(m.offsets) {
+		return nil, errors.New("strip or tile index out of range")
+	}
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	data, err := m.block(i)
+	if err != nil {
+		return nil, err
+	}
+	return append([]byte{}, data...), nil
+}
+
+// stride returns the number of bytes of a row of a strip or tile.
+func (m *Image) stride() int {
+	bits := m.blockWidth * m.bitsPerSample
+	if !m.planar {
+		bits *= m.samplesPerPixel
+	}
+	return (bits + 7) / 8
+}
+
+// blockRows returns the number of rows of the strip or tile with index i.
+// The last strip of an image may be shorter than the others.
+func (m *Image) blockRows(i int) int {
+	if m.tiled {
+		return m.blockHeight
+	}
+	i %= m.blocksAcross * m.blocksDown // Index within the plane.
+	if rows := m.height - i*m.blockHeight; rows < m.blockHeight {
+		return rows
+	}
+	return m.blockHeight
+}
+
+// samples reads the color and alpha samples of the pixel at (x, y) into dst.
+func (m *Image) samples(dst []uint32, x, y int) error {
+	n := colorSamples(m.photometric)
+	if m.alpha != alphaNone {
+		n++
+	}
+	bx, by := x/m.blockWidth, y/m.blockHeight
+	index := by*m.blocksAcross + bx
+	x -= bx * m.blockWidth
+	y -= by * m.blockHeight
+	rowBits := 8 * y * m.stride()
+	if m.planar {
+		for s := 0; s < n; s++ {
+			block, err := m.block(s*m.blocksAcross*m.blocksDown + index)
+			if err != nil {
+				return err
+			}
+			dst[s] = m.sample(block, rowBits+x*m.bitsPerSample)
+		}
+		return nil
+	}
+	block, err := m.block(index)
+	if err != nil {
+		return err
+	}
+	pixelBits := rowBits + x*m.bitsPerSample*m.samplesPerPixel
+	for s := 0; s < n; s++ {
+		dst[s] = m.sample(block, pixelBits+s*m.bitsPerSample)
+	}
+	return nil
+}
+
+// sample returns the sample starting at bit offset bit of the decoded block.
+func (m *Image) sample(block []byte, bit int) uint32 {
+	switch m.bitsPerSample {
+	case 8:
+		return uint32(block[bit/8])
+	case 16:
+		return uint32(m.order.Uint16(block[bit/8:]))
+	case 32:
+		return floatSample(float64(math.Float32frombits(m.order.Uint32(block[bit/8:]))))
+	case 64:
+		return floatSample(math.Float64frombits(m.order.Uint64(block[bit/8:])))
+	}
+	shift := 8 - m.bitsPerSample - bit%8
+	return uint32(block[bit/8]>>shift) & (1<<m.bitsPerSample - 1)
+}
+
+// color returns the color of a pixel with the given color and alpha samples.
+func (m *Image) color(s []uint32) color.Color {
+	if m.photometric == pPaletted {
+		return m.palette[s[0]]
+	}
+	// Scale samples to 16 bits. Floating point samples are scaled by sample.
+	if m.bitsPerSample < 16 {
+		full := uint32(1)<<m.bitsPerSample - 1
+		for i := range s {
+			s[i] = s[i] * 0xffff / full
+		}
+	}
+	if m.photometric == pCMYK {
+		return color.CMYK{C: uint8(s[0] >> 8), M: uint8(s[1] >> 8), Y: uint8(s[2] >> 8), K: uint8(s[3] >> 8)}
+	}
+	r, g, b, a := s[0], s[0], s[0], uint32(0xffff)
+	switch m.photometric {
+	case pWhiteIsZero:
+		r, g, b = 0xffff-r, 0xffff-r, 0xffff-r
+	case pRGB:
+		g, b = s[1], s[2]
+	}
+	if m.alpha != alphaNone {
+		a = s[colorSamples(m.photometric)]
+	}
+	wide := m.bitsPerSample >= 16
+	switch {
+	case m.alpha == alphaAssociated && wide:
+		return color.RGBA64{R: uint16(r), G: uint16(g), B: uint16(b), A: uint16(a)}
+	case m.alpha == alphaAssociated:
+		return color.RGBA{R: uint8(r >> 8), G: uint8(g >> 8), B: uint8(b >> 8), A: uint8(a >> 8)}
+	case m.alpha == alphaUnassociated && wide:
+		return color.NRGBA64{R: uint16(r), G: uint16(g), B: uint16(b), A: uint16(a)}
+	case m.alpha == alphaUnassociated:
+		return color.NRGBA{R: uint8(r >> 8), G: uint8(g >> 8), B: uint8(b >> 8), A: uint8(a >> 8)}
+	case m.photometric == pRGB && wide:
+		return color.RGBA64{R: uint16(r), G: uint16(g), B: uint16(b), A: 0xffff}
+	case m.photometric == pRGB:
+		return color.RGBA{R: uint8(r >> 8), G: uint8(g >> 8), B: uint8(b >> 8), A: 0xff}
+	case wide:
+		return color.Gray16{Y: uint16(r)}
+	}
+	return color.Gray{Y: uint8(r >> 8)}
+}
+
+// block returns the decoded strip or tile with index i from the cache,
+// decoding it if it is not cached.
+func (m *Image) block(i int) ([]byte, error) {
+	if data, ok := m.cache.get(i); ok {
+		return data, nil
+	}
+	data, err := m.decodeBlock(i)
+	if err != nil {
+		return nil, fmt.Errorf("decoding strip or tile %d: %w", i, err)
+	}
+	m.cache.add(i, data)
+	return data, nil
+}
+
+// decodeBlock reads and decompresses the strip or tile with index i. The
+// returned data holds all rows of the block, padded with zeros if short.
+func (m *Image) decodeBlock(i int) ([]byte, error) {
+	offset, count := m.offsets[i], m.counts[i]
+	size := m.stride() * m.blockRows(i)
+	if m.compression == cNone && count > int64(size) {
+		count = int64(size) // Data past the block's rows is unused.
+	}
+	if offset < 0 || count < 0 || count > maxBlockSize || count > int64(size)*maxBlockExpansion+1024 ||
+		m.size >= 0 && offset+count > m.size {
+		return nil, errors.New("invalid offset or byte count")
+	}
+	raw := make([]byte, count)
+	n, err := m.r.ReadAt(raw, offset)
+	if err != nil && !(err == io.EOF && n == len(raw)) {
+		return nil, err
+	}
+	if m.fillOrder == 2 {
+		reverseBits(raw)
+	}
+	var data []byte
+	switch m.compression {
+	case cNone:
+		data = raw
+	case cLZW:
+		data, err = decodeLZW(raw, size)
+	case cDeflate, cDeflateOld:
+		data, err = decodeDeflate(raw, size)
+	case cPackBits:
+		data, err = decodePackBits(raw, size)
+	case cCCITT, cCCITTFax3, cCCITTFax4:
+		mode := [...]ccittMode{cCCITT: ccittMH, cCCITTFax3: ccittT4, cCCITTFax4: ccittT6}[m.compression]
+		data, err = decodeCCITT(raw, m.blockWidth, m.blockRows(i), mode, m.ccittOptions, m.photometric == pBlackIsZero)
+	default:
+		return nil, fmt.Errorf("unsupported Compression %d", m.compression)
+	}
+	if err != nil {
+		return nil, err
+	}
+	if len(data) < size {
+		data = append(data, make([]byte, size-len(data))...)
+	}
+	data = data[:size]
+	m.undoPredictor(data)
+	return data, nil
+}
+
+// undoPredictor reverses the predictor applied to the rows of a decoded block.
+func (m *Image) undoPredictor(block []byte) {
+	if m.predictor == prNone {
+		return
+	}
+	stride := m.stride()
+	n := 1 // Samples per pixel within a row.
+	if !m.planar {
+		n = m.samplesPerPixel
+	}
+	var tmp []byte
+	if m.predictor == prFloatingPoint {
+		tmp = make([]byte, stride)
+	}
+	for y := 0; y+stride <= len(block); y += stride {
+		row := block[y : y+stride]
+		if m.predictor == prHorizontal {
+			undoHorizontal(row, m.order, m.bitsPerSample, n)
+		} else {
+			undoFloatingPoint(row, tmp, m.order, m.bitsPerSample, n)
+		}
+	}
+}
+
+// reverseBits reverses the order of the bits of each byte of b, converting
+// data of FillOrder 2 (least significant bit first) to FillOrder 1.
+func reverseBits(b []byte) {
+	for i, c := range b {
+		c = c>>4 | c<<4
+		c = (c&0xcc)>>2 | (c&0x33)<<2
+		b[i] = (c&0xaa)>>1 | (c&0x55)<<1
+	}
+}
+
+// ifdReader reads integer tags of a directory. The first error encountered
+// is kept in err, after which reads return default values.
+type ifdReader struct {
+	lt  *exif.LazyDecoder
+	r   io.ReaderAt
+	dir *exif.Dir
+	err error
+}
+
+// has reports whether the directory contains the tag with the given ID.
+func (d *ifdReader) has(id exif.ID) bool {
+	for _, tagID := range d.dir.IDs() {
+		if tagID == id {
+			return true
+		}
+	}
+	return false
+}
+
+// tag returns the tag with the given ID. ok is false if it is not found.
+func (d *ifdReader) tag(id exif.ID) (tag exif.Tag, ok bool) {
+	if d.err != nil || !d.has(id) {
+		return exif.Tag{}, false
+	}
+	tag, err := d.lt.GetDirTag(d.r, d.dir, id)
+	if err != nil {
+		d.err = fmt.Errorf("reading %s: %w", id.String(), err)
+		return exif.Tag{}, false
+	}
+	return tag, true
+}
+
+// ints returns the values of the tag with the given ID, or nil if it is not found.
+func (d *ifdReader) ints(id exif.ID) []int64 {
+	tag, ok := d.tag(id)
+	if !ok {
+		return nil
+	}
+	v, err := tag.Ints()
+	if err != nil {
+		d.err = fmt.Errorf("reading %s: %w", id.String(), err)
+		return nil
+	}
+	return v
+}
+
+// int returns the first value of the tag with the given ID, or def if it is not found.
+func (d *ifdReader) int(id exif.ID, def int64) int64 {
+	v := d.ints(id)
+	if len(v) == 0 {
+		return def
+	}
+	return v[0]
+}
+
+// string returns the text of the ASCII tag with the given ID without
+// its terminating NULs, or "" if it is not found.
+func (d *ifdReader) string(id exif.ID) string {
+	tag, ok := d.tag(id)
+	if !ok {
+		return ""
+	}
+	s, ok := tag.Value().(string)
+	if !ok {
+		d.err = fmt.Errorf("reading %s: not a string", id.String())
+		return ""
+	}
+	return strings.TrimRight(s, "\x00")
+}
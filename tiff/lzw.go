@@ -0,0 +1,167 @@
+package tiff
+
+import "errors"
+
+// TIFF LZW codes are 9 to 12 bits wide, stored most significant bit first. The
+// code width grows one code before the table requires it ("early change"). Data
+// written by encoders predating TIFF 6.0 stores codes least significant bit first
+// and grows the code width only when the table requires it.
+const (
+	lzwClear    = 256
+	lzwEOI      = 257
+	lzwFirst    = 258
+	lzwMaxWidth = 12
+	lzwMaxCode  = 1 << lzwMaxWidth
+)
+
+// isOldLZW reports whether the LZW data src was written in the old style. Data
+// starts with a clear code, whose first 9 bits in old style data are 0 followed by 1.
+func isOldLZW(src []byte) bool {
+	return len(src) >= 2 && src[0] == 0 && src[1]&1 != 0
+}
+
+// decodeLZW decompresses the LZW data src, returning at most size bytes.
+// Data ending without an EOI code is returned as decoded so far.
+func decodeLZW(src []byte, size int) ([]byte, error) {
+	var (
+		prefix [lzwMaxCode]uint16
+		suffix [lzwMaxCode]byte
+		first  [lzwMaxCode]byte // First byte of each code's string.
+		length [lzwMaxCode]int
+	)
+	for c := 0; c < 256; c++ {
+		suffix[c], first[c], length[c] = byte(c), byte(c), 1
+	}
+	old := isOldLZW(src)
+	early := 1
+	if old {
+		early = 0
+	}
+	dst := make([]byte, 0, size)
+	var (
+		acc   uint32 // Bits read but not yet consumed.
+		nbits int
+		pos   int
+		width = 9
+		next  = lzwFirst
+		prev  = -1
+	)
+	for len(dst) < size {
+		for nbits < width {
+			if pos == len(src) {
+				return dst, nil
+			}
+			if old {
+				acc |= uint32(src[pos]) << nbits
+			} else {
+				acc = acc<<8 | uint32(src[pos])
+			}
+			pos++
+			nbits += 8
+		}
+		var code int
+		if old {
+			code = int(acc & (1<<width - 1))
+			acc >>= width
+		} else {
+			code = int(acc >> (nbits - width) & (1<<width - 1))
+		}
+		nbits -= width
+		switch {
+		case code == lzwClear:
+			width, next, prev = 9, lzwFirst, -1
+			continue
+		case code == lzwEOI:
+			return dst, nil
+		case prev < 0:
+			if code > 255 {
+				return nil, errors.New("invalid LZW code after clear code")
+			}
+		case code > next || code == next && next == lzwMaxCode:
+			return nil, errors.New("invalid LZW code")
+		case next < lzwMaxCode:
+			// The new entry is the string of prev followed by the first byte of code's
+			// string, which when code is the new entry is the first byte of prev's.
+			c := first[code]
+			if code == next {
+				c = first[prev]
+			}
+			prefix[next], suffix[next], first[next], length[next] = uint16(prev), c, first[prev], length[prev]+1
+			next++
+			if next+early >= 1<<width && width < lzwMaxWidth {
+				width++
+			}
+		}
+		// Write the string of code backwards, clipped to size.
+		n := length[code]
+		start := len(dst)
+		if start+n > size {
+			dst = dst[:size]
+		} else {
+			dst = dst[:start+n]
+		}
+		for c, i := code, start+n-1; i >= start; i-- {
+			if i < len(dst) {
+				dst[i] = suffix[c]
+			}
+			c = int(prefix[c])
+		}
+		prev = code
+	}
+	return dst, nil
+}
+
+// encodeLZW compresses src with TIFF LZW, writing codes most significant bit
+// first with early change. A clear code is written when the code table is full.
+func encodeLZW(src []byte) []byte {
+	var (
+		dst   []byte
+		acc   uint32 // Bits not yet written.
+		nbits int
+		width = 9
+		next  = lzwFirst
+		// table holds the codes of the strings of a code followed by a byte.
+		table = make(map[uint32]uint16)
+	)
+	emit := func(code int) {
+		acc = acc<<width | uint32(code)
+		for nbits += width; nbits >= 8; nbits -= 8 {
+			dst = append(dst, byte(acc>>(nbits-8)))
+		}
+	}
+	emit(lzwClear)
+	if len(src) > 0 {
+		w := int(src[0])
+		for _, c := range src[1:] {
+			key := uint32(w)<<8 | uint32(c)
+			if code, ok := table[key]; ok {
+				w = int(code)
+				continue
+			}
+			emit(w)
+			table[key] = uint16(next)
+			next++
+			// The decoder adds entries one code later, so it grows the
+			// code width after reading the code following this one.
+			if next >= 1<<width && width < lzwMaxWidth {
+				width++
+			}
+			if next == lzwMaxCode-2 {
+				emit(lzwClear)
+				table = make(map[uint32]uint16)
+				next, width = lzwFirst, 9
+			}
+			w = int(c)
+		}
+		emit(w)
+		// The decoder adds an entry for the last code before reading EOI.
+		if next > lzwFirst && next+1 >= 1<<width && width < lzwMaxWidth {
+			width++
+		}
+	}
+	emit(lzwEOI)
+	if nbits > 0 {
+		dst = append(dst, byte(acc<<(8-nbits)))
+	}
+	return dst
+}
@@ -0,0 +1,142 @@
+package tiff
+
+import (
+	"encoding/binary"
+	"fmt"
+	"io"
+
+	"github.com/soypat/exif"
+	"github.com/soypat/exif/exifid"
+)
+
+// Flags of the SubfileType tag.
+const (
+	SubfileReducedResolution = 1 << 0 // Thumbnail or other reduced resolution version of another image.
+	SubfilePage              = 1 << 1 // Single page of a multi-page image.
+	SubfileMask              = 1 << 2 // Transparency mask of another image.
+)
+
+// Page is an image of the IFD0 chain of a TIFF file. Multi-page files such as
+// faxes store a page per directory, and the chain may also hold thumbnails
+// and transparency masks of the pages, which are told apart by SubfileType.
+type Page struct {
+	// Index is the position of the page's directory in the IFD0 chain, starting at 0.
+	Index int
+	// Dir is the image file directory of the page.
+	Dir *exif.Dir
+	// SubfileType holds the flags of the SubfileType tag. The values of the
+	// obsolete OldSubfileType tag are converted to flags.
+	SubfileType   int
+	Width, Height int
+	// Name is the text of the PageName tag, or "" if there is none.
+	Name string
+	// Number is the page number of the PageNumber tag, starting at 0, and Total the
+	// number of pages of the document, or 0 if unknown. Both are -1 if there is no
+	// PageNumber tag.
+	Number, Total int
+
+	r     io.ReaderAt
+	lt    *exif.LazyDecoder
+	order binary.ByteOrder
+	opts  Options
+}
+
+// IsThumbnail reports whether the page is a reduced resolution version of another.
+func (p *Page) IsThumbnail() bool { return p.SubfileType&SubfileReducedResolution != 0 }
+
+// IsMask reports whether the page is a transparency mask of another.
+func (p *Page) IsMask() bool { return p.SubfileType&SubfileMask != 0 }
+
+// Image returns the image of the page, whose pixels are read when accessed. See [Open].
+func (p *Page) Image() (*Image, error) {
+	return newImage(p.r, p.lt, p.Dir, p.order, p.opts)
+}
+
+// PageIterator iterates over the pages of the IFD0 chain of a TIFF file.
+//
+//	it, err := tiff.Pages(r, tiff.Options{})
+//	if err != nil {
+//		return err
+//	}
+//	for it.Next() {
+//		page := it.Page()
+//		if page.IsThumbnail() || page.IsMask() {
+//			continue
+//		}
+//		// Use page.
+//	}
+//	return it.Err()
+type PageIterator struct {
+	r     io.ReaderAt
+	lt    *exif.LazyDecoder
+	order binary.ByteOrder
+	opts  Options
+
+	next  *exif.Dir
+	index int // Index of the next page.
+	page  *Page
+	err   error
+}
+
+// Pages decodes the image file directories of the TIFF file r and returns an
+// iterator over the pages of its IFD0 chain. Pixel data of pages is read from
+// r when accessed, so r must remain readable for as long as pages are used.
+func Pages(r io.ReaderAt, opts Options) (*PageIterator, error) {
+	lt, order, err := decodeDirs(r)
+	if err != nil {
+		return nil, err
+	}
+	return &PageIterator{r: r, lt: lt, order: order, opts: opts, next: lt.Root()}, nil
+}
+
+// Next advances the iterator to the next page, which is then returned by [PageIterator.Page].
+// It returns false at the end of the chain or if reading the page's tags fails,
+// in which case [PageIterator.Err] returns the error.
+func (it *PageIterator) Next() bool {
+	it.page = nil
+	if it.err != nil || it.next == nil {
+		return false
+	}
+	dir := it.next
+	ifd := ifdReader{lt: it.lt, r: it.r, dir: dir}
+	p := &Page{
+		Index:       it.index,
+		Dir:         dir,
+		SubfileType: int(ifd.int(exifid.SubfileType, 0)),
+		Width:       int(ifd.int(exifid.ImageWidth, 0)),
+		Height:      int(ifd.int(exifid.ImageHeight, 0)),
+		Name:        ifd.string(exifid.PageName),
+		Number:      -1,
+		Total:       -1,
+		r:           it.r,
+		lt:          it.lt,
+		order:       it.order,
+		opts:        it.opts,
+	}
+	if !ifd.has(exifid.SubfileType) {
+		switch ifd.int(exifid.OldSubfileType, 1) {
+		case 2:
+			p.SubfileType = SubfileReducedResolution
+		case 3:
+			p.SubfileType = SubfilePage
+		}
+	}
+	if number := ifd.ints(exifid.PageNumber); len(number) == 2 {
+		p.Number, p.Total = int(number[0]), int(number[1])
+	}
+	if ifd.err != nil {
+		it.err = fmt.Errorf("reading page %d: %w", it.index, ifd.err)
+		return false
+	}
+	it.page = p
+	it.next = dir.Next
+	it.index++
+	return true
+}
+
+// Page returns the current page of the iterator, or nil if [PageIterator.Next]
+// has not been called or returned false.
+func (it *PageIterator) Page() *Page { return it.page }
+
+// Err returns the error which stopped the iterator, if any.
+func (it *PageIterator) Err() error { return it.err }
@@ -0,0 +1,135 @@
+// Package tiff decodes TIFF images lazily. Strips or tiles of an image are read and
+// decoded when one of their pixels is accessed and kept in a bounded cache, so large
+// images may be read with little memory. Image file directories are decoded with
+// [exif.LazyDecoder]. [Encode] writes images with metadata in TIFF format.
+//
+// Importing the package registers the TIFF format with the image package.
+package tiff
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"image"
+	"io"
+	"io/fs"
+
+	"github.com/soypat/exif"
+)
+
+// Byte order marks and magic numbers at the start of TIFF and BigTIFF files.
+const (
+	leHeader        = "II\x2a\x00"
+	beHeader        = "MM\x00\x2a"
+	leBigTIFFHeader = "II\x2b\x00"
+	beBigTIFFHeader = "MM\x00\x2b"
+)
+
+func init() {
+	image.RegisterFormat("tiff", leHeader, Decode, DecodeConfig)
+	image.RegisterFormat("tiff", beHeader, Decode, DecodeConfig)
+	image.RegisterFormat("tiff", leBigTIFFHeader, Decode, DecodeConfig)
+	image.RegisterFormat("tiff", beBigTIFFHeader, Decode, DecodeConfig)
+}
+
+// DefaultCacheSize is the number of decoded strips or tiles an [Image] keeps
+// in memory if [Options] does not specify a cache size.
+const DefaultCacheSize = 16
+
+// Options configures how TIFF images are decoded. The zero value is valid.
+type Options struct {
+	// CacheSize is the maximum number of decoded strips or tiles kept in
+	// memory by an Image. If zero, DefaultCacheSize is used.
+	CacheSize int
+}
+
+// Open decodes the image file directories of the TIFF file r and returns the
+// image of IFD0. Pixel data is read from r when accessed, so r must remain
+// readable for as long as the image is used.
+func Open(r io.ReaderAt, opts Options) (*Image, error) {
+	lt, order, err := decodeDirs(r)
+	if err != nil {
+		return nil, err
+	}
+	return newImage(r, lt, lt.Root(), order, opts)
+}
+
+// decodeDirs decodes the image file directories of the TIFF file r.
+func decodeDirs(r io.ReaderAt) (*exif.LazyDecoder, binary.ByteOrder, error) {
+	order, err := byteOrder(r)
+	if err != nil {
+		return nil, nil, err
+	}
+	lt := new(exif.LazyDecoder)
+	err = lt.Decode(r)
+	if err != nil {
+		return nil, nil, err
+	}
+	return lt, order, nil
+}
+
+// Decode reads a TIFF image from r and returns it as an [*Image]. If r does
+// not implement io.ReaderAt the file is read into memory. Otherwise the file
+// is read from the start of r regardless of r's position.
+func Decode(r io.Reader) (image.Image, error) {
+	ra, err := readerAt(r)
+	if err != nil {
+		return nil, err
+	}
+	return Open(ra, Options{})
+}
+
+// DecodeConfig returns the color model and dimensions of the TIFF image
+// in r without decoding pixel data. See [Decode].
+func DecodeConfig(r io.Reader) (image.Config, error) {
+	ra, err := readerAt(r)
+	if err != nil {
+		return image.Config{}, err
+	}
+	m, err := Open(ra, Options{})
+	if err != nil {
+		return image.Config{}, err
+	}
+	return image.Config{ColorModel: m.ColorModel(), Width: m.width, Height: m.height}, nil
+}
+
+func readerAt(r io.Reader) (io.ReaderAt, error) {
+	if ra, ok := r.(io.ReaderAt); ok {
+		return ra, nil
+	}
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+	return bytes.NewReader(data), nil
+}
+
+// readerSize returns the size of the data in r, or -1 if it is unknown.
+func readerSize(r io.ReaderAt) int64 {
+	switch r := r.(type) {
+	case interface{ Size() int64 }:
+		return r.Size()
+	case interface{ Stat() (fs.FileInfo, error) }:
+		info, err := r.Stat()
+		if err == nil && info.Mode().IsRegular() {
+			return info.Size()
+		}
+	}
+	return -1
+}
+
+// byteOrder returns the byte order of the TIFF file r from its header.
+func byteOrder(r io.ReaderAt) (binary.ByteOrder, error) {
+	var header [4]byte
+	_, err := r.ReadAt(header[:], 0)
+	if err != nil {
+		return nil, err
+	}
+	switch string(header[:]) {
+	case leHeader, leBigTIFFHeader:
+		return binary.LittleEndian, nil
+	case beHeader, beBigTIFFHeader:
+		return binary.BigEndian, nil
+	}
+	return nil, errors.New("not a TIFF file")
+}
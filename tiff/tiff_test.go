@@ -0,0 +1,852 @@
+package tiff
+
+import (
+	"bytes"
+	"compress/zlib"
+	"encoding/binary"
+	"fmt"
+	"image"
+	"image/color"
+	"math"
+	"os"
+	"runtime"
+	"sort"
+	"testing"
+
+	"github.com/soypat/exif"
+	"github.com/soypat/exif/exifid"
+)
+
+// testTag returns a tag of IFD0 with the given ID and value.
+func testTag(t *testing.T, id exif.ID, value any) exif.Tag {
+	t.Helper()
+	tag, err := exif.NewGroupTag(exif.GroupIFD0, id, value)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return tag
+}
+
+// buildTestTIFF returns a TIFF file with an IFD0 containing tags followed by the
+// strips or tiles blocks, whose offsets and byte counts are stored in the tags
+// with IDs offsetsID and countsID.
+func buildTestTIFF(t *testing.T, order binary.ByteOrder, tags []exif.Tag, offsetsID, countsID exif.ID, blocks ...[]byte) []byte {
+	t.Helper()
+	build := func(start int64) []byte {
+		offsets := make([]int64, len(blocks))
+		counts := make([]int64, len(blocks))
+		for i, block := range blocks {
+			offsets[i] = start
+			counts[i] = int64(len(block))
+			start += int64(len(block))
+		}
+		ifd0 := append(append([]exif.Tag{}, tags...), testTag(t, offsetsID, offsets), testTag(t, countsID, counts))
+		data, err := exif.Marshal(order, []exif.IFD{{Group: exif.GroupIFD0, Tags: ifd0}})
+		if err != nil {
+			t.Fatal(err)
+		}
+		return data
+	}
+	data := build(int64(len(build(0))))
+	for _, block := range blocks {
+		data = append(data, block...)
+	}
+	return data
+}
+
+// testImageTags returns the tags of an image of the given dimensions and format.
+func testImageTags(t *testing.T, width, height, photometric, samplesPerPixel, bitsPerSample int64) []exif.Tag {
+	bps := make([]int64, samplesPerPixel)
+	for i := range bps {
+		bps[i] = bitsPerSample
+	}
+	return []exif.Tag{
+		testTag(t, exifid.ImageWidth, width),
+		testTag(t, exifid.ImageHeight, height),
+		testTag(t, exifid.BitsPerSample, bps),
+		testTag(t, exifid.Compression, int64(1)),
+		testTag(t, exifid.PhotometricInterpretation, photometric),
+		testTag(t, exifid.SamplesPerPixel, samplesPerPixel),
+	}
+}
+
+func testPixels(t *testing.T, m image.Image, want [][]color.Color) {
+	t.Helper()
+	if got := m.Bounds(); got != image.Rect(0, 0, len(want[0]), len(want)) {
+		t.Fatalf("got bounds %v", got)
+	}
+	for y := range want {
+		for x := range want[y] {
+			if got := m.At(x, y); got != want[y][x] {
+				t.Errorf("pixel (%d, %d): got %#v, want %#v", x, y, got, want[y][x])
+			}
+		}
+	}
+	if err := m.(*Image).Err(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestOpen_grayStrips(t *testing.T) {
+	tags := append(testImageTags(t, 2, 3, pBlackIsZero, 1, 8), testTag(t, exifid.RowsPerStrip, int64(2)))
+	data := buildTestTIFF(t, binary.LittleEndian, tags, exifid.StripOffsets, exifid.StripByteCounts,
+		[]byte{0, 50, 100, 150},
+		[]byte{200, 255},
+	)
+	m, err := Open(bytes.NewReader(data), Options{CacheSize: 1})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if m.ColorModel() != color.GrayModel {
+		t.Error("expected gray color model")
+	}
+	g := func(y uint8) color.Color { return color.Gray{Y: y} }
+	testPixels(t, m, [][]color.Color{
+		{g(0), g(50)},
+		{g(100), g(150)},
+		{g(200), g(255)},
+	})
+	if m.cache.lru.Len() != 1 {
+		t.Errorf("got %d cached strips, want 1", m.cache.lru.Len())
+	}
+}
+
+func TestOpen_bilevelFillOrder(t *testing.T) {
+	tags := append(testImageTags(t, 10, 1, pWhiteIsZero, 1, 1), testTag(t, exifid.FillOrder, int64(2)))
+	// Bits 1000000011 in FillOrder 2, padded to a byte boundary.
+	data := buildTestTIFF(t, binary.BigEndian, tags, exifid.StripOffsets, exifid.StripByteCounts, []byte{0b00000001, 0b00000011})
+	m, err := Open(bytes.NewReader(data), Options{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	b, w := color.Gray{Y: 0}, color.Gray{Y: 0xff}
+	testPixels(t, m, [][]color.Color{{b, w, w, w, w, w, w, w, b, b}})
+}
+
+func TestOpen_rgb16Tiles(t *testing.T) {
+	order := binary.BigEndian
+	tags := append(testImageTags(t, 3, 1, pRGB, 3, 16),
+		testTag(t, exifid.TileWidth, int64(2)),
+		testTag(t, exifid.TileLength, int64(1)),
+	)
+	tile := func(rgb ...uint16) []byte {
+		b := make([]byte, 2*len(rgb))
+		for i, v := range rgb {
+			order.PutUint16(b[2*i:], v)
+		}
+		return b
+	}
+	// The second tile is padded past the right edge of the image.
+	data := buildTestTIFF(t, order, tags, exifid.TileOffsets, exifid.TileByteCounts,
+		tile(0xffff, 0, 0, 0, 0xffff, 0),
+		tile(0x1234, 0x5678, 0x9abc, 0, 0, 0),
+	)
+	img, _, err := image.Decode(bytes.NewReader(data))
+	if err != nil {
+		t.Fatal(err)
+	}
+	testPixels(t, img, [][]color.Color{{
+		color.RGBA64{R: 0xffff, A: 0xffff},
+		color.RGBA64{G: 0xffff, A: 0xffff},
+		color.RGBA64{R: 0x1234, G: 0x5678, B: 0x9abc, A: 0xffff},
+	}})
+}
+
+func TestOpen_planarAlpha(t *testing.T) {
+	tags := append(testImageTags(t, 2, 1, pRGB, 4, 8),
+		testTag(t, exifid.PlanarConfiguration, int64(2)),
+		testTag(t, exifid.ExtraSamples, int64(2)),
+	)
+	data := buildTestTIFF(t, binary.LittleEndian, tags, exifid.StripOffsets, exifid.StripByteCounts,
+		[]byte{10, 20}, []byte{30, 40}, []byte{50, 60}, []byte{0xff, 0x80},
+	)
+	m, err := Open(bytes.NewReader(data), Options{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if m.ColorModel() != color.NRGBAModel {
+		t.Error("expected NRGBA color model")
+	}
+	testPixels(t, m, [][]color.Color{{
+		color.NRGBA{R: 10, G: 30, B: 50, A: 0xff},
+		color.NRGBA{R: 20, G: 40, B: 60, A: 0x80},
+	}})
+}
+
+func TestOpen_paletted(t *testing.T) {
+	cmap := make([]int64, 3*4)
+	cmap[1], cmap[4+2], cmap[8+3] = 0xffff, 0xffff, 0xffff // Red, green and blue entries.
+	tags := append(testImageTags(t, 4, 1, pPaletted, 1, 2), testTag(t, exifid.ColorMap, cmap))
+	data := buildTestTIFF(t, binary.LittleEndian, tags, exifid.StripOffsets, exifid.StripByteCounts, []byte{0b00011011})
+	cfg, format, err := image.DecodeConfig(bytes.NewReader(data))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if format != "tiff" || cfg.Width != 4 || cfg.Height != 1 {
+		t.Errorf("got format %q and config %+v", format, cfg)
+	}
+	if p, ok := cfg.ColorModel.(color.Palette); !ok || len(p) != 4 {
+		t.Error("expected palette color model")
+	}
+	m, err := Open(bytes.NewReader(data), Options{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	testPixels(t, m, [][]color.Color{{
+		color.RGBA64{A: 0xffff},
+		color.RGBA64{R: 0xffff, A: 0xffff},
+		color.RGBA64{G: 0xffff, A: 0xffff},
+		color.RGBA64{B: 0xffff, A: 0xffff},
+	}})
+}
+
+func TestImage_Err(t *testing.T) {
+	tags := testImageTags(t, 4, 1, pBlackIsZero, 1, 8)
+	data := buildTestTIFF(t, binary.LittleEndian, tags, exifid.StripOffsets, exifid.StripByteCounts, []byte{1, 2, 3, 4})
+	// Truncate the strip.
+	m, err := Open(bytes.NewReader(data[:len(data)-2]), Options{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if c := m.At(0, 0); c != (color.Gray{}) {
+		t.Errorf("got %v for undecodable strip", c)
+	}
+	if m.Err() == nil {
+		t.Error("expected error reading truncated strip")
+	}
+}
+
+func TestBlockCache(t *testing.T) {
+	c := newBlockCache(2)
+	c.add(0, []byte{0})
+	c.add(1, []byte{1})
+	c.get(0) // Block 1 becomes least recently used.
+	c.add(2, []byte{2})
+	if _, ok := c.get(1); ok {
+		t.Error("least recently used block not evicted")
+	}
+	for _, i := range []int{0, 2} {
+		if data, ok := c.get(i); !ok || data[0] != byte(i) {
+			t.Errorf("block %d not cached", i)
+		}
+	}
+}
+
+// packBits packs a string of '0' and '1' characters into bytes, most significant
+// bit first, ignoring spaces. The last byte is padded with zeros.
+func packBits(s string) []byte {
+	var b []byte
+	n := 0
+	for _, c := range s {
+		if c == ' ' {
+			continue
+		}
+		if n%8 == 0 {
+			b = append(b, 0)
+		}
+		if c == '1' {
+			b[n/8] |= 0x80 >> (n % 8)
+		}
+		n++
+	}
+	return b
+}
+
+func TestCCITTTrees(t *testing.T) {
+	for _, tc := range []struct {
+		tree   ccittTree
+		tables [][]ccittCode
+	}{
+		{modeTree, [][]ccittCode{modeCodes}},
+		{whiteTree, [][]ccittCode{whiteCodes, extendedMakeupCodes}},
+		{blackTree, [][]ccittCode{blackCodes, extendedMakeupCodes}},
+	} {
+		for _, table := range tc.tables {
+			for _, code := range table {
+				br := &bitReader{data: packBits(code.bits + "1")} // Trailing bit so codes are not padded.
+				v, err := tc.tree.decode(br)
+				if err != nil || v != code.value || br.pos != len(code.bits) {
+					t.Errorf("code %s: got %d after %d bits (%v), want %d", code.bits, v, br.pos, err, code.value)
+				}
+			}
+		}
+	}
+}
+
+func TestOpen_ccitt(t *testing.T) {
+	const (
+		eol = "000000000001"
+		// White run of 2, black run of 3 and white run of 3.
+		row1D = "0111 10 1000"
+	)
+	for _, tc := range []struct {
+		name        string
+		compression int64
+		options     int64
+		photometric int64
+		fillOrder   int64
+		data        string
+	}{
+		{"MH", cCCITT, 0, pWhiteIsZero, 1, row1D + " 000000" + row1D},
+		{"T4 1D", cCCITTFax3, 0, pWhiteIsZero, 1, eol + row1D + eol + row1D + eol + eol},
+		{"T4 2D", cCCITTFax3, 1, pBlackIsZero, 1, eol + "1" + row1D + eol + "0" + "1 1 1"},
+		{"T4 fill bits", cCCITTFax3, 4, pWhiteIsZero, 1, "0000" + eol + row1D + "000000" + eol + row1D},
+		// Horizontal mode (white 2, black 3) and V0 against the all white reference
+		// row, then three V0 codes, followed by EOFB.
+		{"T6", cCCITTFax4, 0, pWhiteIsZero, 1, "001 0111 10 1" + "1 1 1" + eol + eol},
+		{"T6 FillOrder", cCCITTFax4, 0, pBlackIsZero, 2, "001 0111 10 1" + "1 1 1" + eol + eol},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			tags := append(testImageTags(t, 8, 2, tc.photometric, 1, 1), testTag(t, exifid.FillOrder, tc.fillOrder))
+			tags[3] = testTag(t, exifid.Compression, tc.compression)
+			switch tc.compression {
+			case cCCITTFax3:
+				tags = append(tags, testTag(t, exifid.T4Options, tc.options))
+			case cCCITTFax4:
+				tags = append(tags, testTag(t, exifid.T6Options, tc.options))
+			}
+			strip := packBits(tc.data)
+			if tc.fillOrder == 2 {
+				reverseBits(strip)
+			}
+			data := buildTestTIFF(t, binary.LittleEndian, tags, exifid.StripOffsets, exifid.StripByteCounts, strip)
+			m, err := Open(bytes.NewReader(data), Options{})
+			if err != nil {
+				t.Fatal(err)
+			}
+			b, w := color.Gray{Y: 0}, color.Gray{Y: 0xff}
+			row := []color.Color{w, w, b, b, b, w, w, w}
+			testPixels(t, m, [][]color.Color{row, row})
+		})
+	}
+}
+
+func TestOpen_sample1(t *testing.T) {
+	f, err := os.Open("../testdata/sample1.tiff")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+	m, err := Open(f, Options{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if m.Bounds() != image.Rect(0, 0, 1728, 2376) || m.compression != cCCITTFax4 || m.fillOrder != 2 {
+		t.Fatalf("got bounds %v, Compression %d and FillOrder %d", m.Bounds(), m.compression, m.fillOrder)
+	}
+	black := 0
+	for y := 0; y < m.height; y++ {
+		for x := 0; x < m.width; x++ {
+			if m.At(x, y) == (color.Gray{}) {
+				black++
+			}
+		}
+	}
+	if err := m.Err(); err != nil {
+		t.Fatal(err)
+	}
+	if black != 155591 {
+		t.Errorf("got %d black pixels, want 155591", black)
+	}
+}
+
+// encodeTestLZW compresses data with TIFF LZW. If old is set codes are written least
+// significant bit first and the code width grows without early change.
+func encodeTestLZW(data []byte, old bool) []byte {
+	var (
+		out   []byte
+		acc   uint64
+		nbits int
+		width = 9
+		early = 1
+		// State of the decoder, which adds entries one code after the encoder.
+		decNext, first = lzwFirst, true
+	)
+	if old {
+		early = 0
+	}
+	emit := func(code int) {
+		if old {
+			acc |= uint64(code) << nbits
+			for nbits += width; nbits >= 8; nbits -= 8 {
+				out = append(out, byte(acc))
+				acc >>= 8
+			}
+		} else {
+			acc = acc<<width | uint64(code)
+			for nbits += width; nbits >= 8; nbits -= 8 {
+				out = append(out, byte(acc>>(nbits-8)))
+			}
+		}
+		switch {
+		case code == lzwClear:
+			width, decNext, first = 9, lzwFirst, true
+		case first:
+			first = false
+		default:
+			decNext++
+			if decNext+early >= 1<<width && width < lzwMaxWidth {
+				width++
+			}
+		}
+	}
+	var dict map[string]int
+	next := lzwFirst
+	reset := func() {
+		dict = make(map[string]int)
+		for c := 0; c < 256; c++ {
+			dict[string([]byte{byte(c)})] = c
+		}
+		next = lzwFirst
+		emit(lzwClear)
+	}
+	reset()
+	w := ""
+	for _, c := range data {
+		wc := w + string([]byte{c})
+		if _, ok := dict[wc]; ok {
+			w = wc
+			continue
+		}
+		emit(dict[w])
+		dict[wc] = next
+		next++
+		w = string([]byte{c})
+		if next == lzwMaxCode-2 {
+			reset()
+		}
+	}
+	if w != "" {
+		emit(dict[w])
+	}
+	emit(lzwEOI)
+	if nbits > 0 {
+		if old {
+			out = append(out, byte(acc))
+		} else {
+			out = append(out, byte(acc<<(8-nbits)))
+		}
+	}
+	return out
+}
+
+func TestDecodeLZW(t *testing.T) {
+	// Repetitive data filling the code table several times.
+	data := make([]byte, 50000)
+	x := uint32(1)
+	for i := range data {
+		x = x*1103515245 + 12345
+		data[i] = "abcdefgh"[x>>28%8]
+	}
+	for _, old := range []bool{false, true} {
+		src := encodeTestLZW(data, old)
+		if isOldLZW(src) != old {
+			t.Errorf("old style %t: not detected", old)
+		}
+		got, err := decodeLZW(src, len(data))
+		if err != nil {
+			t.Fatalf("old style %t: %v", old, err)
+		}
+		if !bytes.Equal(got, data) {
+			t.Errorf("old style %t: decoded data differs", old)
+		}
+		// Output is limited to the requested size.
+		got, err = decodeLZW(src, 100)
+		if err != nil || !bytes.Equal(got, data[:100]) {
+			t.Errorf("old style %t: got %d bytes (%v) decoding prefix", old, len(got), err)
+		}
+	}
+}
+
+func TestDecodePackBits(t *testing.T) {
+	// Example of Apple's technical note TN1023.
+	src := []byte{0xfe, 0xaa, 0x02, 0x80, 0x00, 0x2a, 0xfd, 0xaa, 0x03, 0x80, 0x00, 0x2a, 0x22, 0xf7, 0xaa}
+	want := []byte{
+		0xaa, 0xaa, 0xaa, 0x80, 0x00, 0x2a, 0xaa, 0xaa, 0xaa, 0xaa, 0x80, 0x00, 0x2a, 0x22,
+		0xaa, 0xaa, 0xaa, 0xaa, 0xaa, 0xaa, 0xaa, 0xaa, 0xaa, 0xaa,
+	}
+	got, err := decodePackBits(src, 100)
+	if err != nil || !bytes.Equal(got, want) {
+		t.Errorf("got % x (%v), want % x", got, err, want)
+	}
+	if _, err := decodePackBits(src[:4], 100); err == nil {
+		t.Error("expected error decoding truncated literal run")
+	}
+}
+
+// predictFloatingPoint applies the floating point predictor to a row of
+// floating point samples of size bytes stored in the given byte order, with
+// n samples per pixel.
+func predictFloatingPoint(row []byte, order binary.ByteOrder, size, n int) []byte {
+	count := len(row) / size
+	out := make([]byte, len(row))
+	for i := 0; i < count; i++ {
+		for b := 0; b < size; b++ {
+			if order == binary.BigEndian {
+				out[b*count+i] = row[i*size+b]
+			} else {
+				out[b*count+i] = row[i*size+size-1-b]
+			}
+		}
+	}
+	for i := len(out) - 1; i >= n; i-- {
+		out[i] -= out[i-n]
+	}
+	return out
+}
+
+func deflate(t *testing.T, data []byte) []byte {
+	var buf bytes.Buffer
+	zw := zlib.NewWriter(&buf)
+	if _, err := zw.Write(data); err != nil {
+		t.Fatal(err)
+	}
+	if err := zw.Close(); err != nil {
+		t.Fatal(err)
+	}
+	return buf.Bytes()
+}
+
+func TestOpen_compression(t *testing.T) {
+	float32Row := func(order binary.ByteOrder, v ...float32) []byte {
+		b := make([]byte, 4*len(v))
+		for i, f := range v {
+			order.PutUint32(b[4*i:], math.Float32bits(f))
+		}
+		return b
+	}
+	g := func(y uint8) color.Color { return color.Gray{Y: y} }
+	g16 := func(y uint16) color.Color { return color.Gray16{Y: y} }
+	for _, tc := range []struct {
+		name            string
+		order           binary.ByteOrder
+		tags            []exif.Tag
+		compression     int64
+		predictor       int64
+		samplesPerPixel int64
+		bitsPerSample   int64
+		strip           []byte
+		want            [][]color.Color
+	}{
+		{
+			name: "LZW predictor", order: binary.LittleEndian, compression: cLZW, predictor: prHorizontal,
+			samplesPerPixel: 1, bitsPerSample: 8,
+			// Rows 10 20 15 255 and 1 2 3 4.
+			strip: encodeTestLZW([]byte{10, 10, 251, 240, 1, 1, 1, 1}, false),
+			want:  [][]color.Color{{g(10), g(20), g(15), g(255)}, {g(1), g(2), g(3), g(4)}},
+		},
+		{
+			name: "old LZW", order: binary.BigEndian, compression: cLZW, predictor: prNone,
+			samplesPerPixel: 1, bitsPerSample: 8,
+			strip: encodeTestLZW([]byte{1, 2, 1, 2, 1, 2, 1, 2}, true),
+			want:  [][]color.Color{{g(1), g(2), g(1), g(2)}, {g(1), g(2), g(1), g(2)}},
+		},
+		{
+			name: "PackBits", order: binary.LittleEndian, compression: cPackBits, predictor: prNone,
+			samplesPerPixel: 1, bitsPerSample: 8,
+			strip: []byte{0xfd, 7, 0x03, 1, 2, 3, 4},
+			want:  [][]color.Color{{g(7), g(7), g(7), g(7)}, {g(1), g(2), g(3), g(4)}},
+		},
+		{
+			name: "Deflate RGB predictor", order: binary.BigEndian, compression: cDeflate, predictor: prHorizontal,
+			samplesPerPixel: 3, bitsPerSample: 8,
+			strip: deflate(t, []byte{
+				10, 20, 30, 1, 1, 1, 1, 1, 1, 0xff, 0xff, 0xff,
+				0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+			}),
+			want: [][]color.Color{
+				{
+					color.RGBA{R: 10, G: 20, B: 30, A: 0xff}, color.RGBA{R: 11, G: 21, B: 31, A: 0xff},
+					color.RGBA{R: 12, G: 22, B: 32, A: 0xff}, color.RGBA{R: 11, G: 21, B: 31, A: 0xff},
+				},
+				{color.RGBA{A: 0xff}, color.RGBA{A: 0xff}, color.RGBA{A: 0xff}, color.RGBA{A: 0xff}},
+			},
+		},
+		{
+			name: "old Deflate 16 bit predictor", order: binary.LittleEndian, compression: cDeflateOld, predictor: prHorizontal,
+			samplesPerPixel: 1, bitsPerSample: 16,
+			strip: deflate(t, []byte{
+				0xe8, 0x03, 0xd0, 0x07, 0x18, 0xfc, 0x00, 0x00,
+				0xff, 0xff, 0x00, 0x00, 0x00, 0x00, 0x01, 0x00,
+			}),
+			want: [][]color.Color{
+				{g16(1000), g16(3000), g16(2000), g16(2000)},
+				{g16(0xffff), g16(0xffff), g16(0xffff), g16(0)},
+			},
+		},
+		{
+			name: "floating point predictor", order: binary.BigEndian, compression: cDeflate, predictor: prFloatingPoint,
+			samplesPerPixel: 1, bitsPerSample: 32,
+			tags: []exif.Tag{testTag(t, exifid.SampleFormat, int64(sfFloat))},
+			strip: deflate(t, append(
+				predictFloatingPoint(float32Row(binary.BigEndian, 0, 0.25, 0.5, 1), binary.BigEndian, 4, 1),
+				predictFloatingPoint(float32Row(binary.BigEndian, -1, 2, float32(math.NaN()), 0.75), binary.BigEndian, 4, 1)...,
+			)),
+			want: [][]color.Color{
+				{g16(0), g16(0x4000), g16(0x8000), g16(0xffff)},
+				{g16(0), g16(0xffff), g16(0), g16(0xbfff)},
+			},
+		},
+		{
+			name: "floating point predictor little endian", order: binary.LittleEndian, compression: cLZW, predictor: prFloatingPoint,
+			samplesPerPixel: 1, bitsPerSample: 32,
+			tags: []exif.Tag{testTag(t, exifid.SampleFormat, int64(sfFloat))},
+			strip: encodeTestLZW(append(
+				predictFloatingPoint(float32Row(binary.LittleEndian, 0, 0.25, 0.5, 1), binary.LittleEndian, 4, 1),
+				predictFloatingPoint(float32Row(binary.LittleEndian, 1, 1, 1, 1), binary.LittleEndian, 4, 1)...,
+			), false),
+			want: [][]color.Color{
+				{g16(0), g16(0x4000), g16(0x8000), g16(0xffff)},
+				{g16(0xffff), g16(0xffff), g16(0xffff), g16(0xffff)},
+			},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			photometric := int64(pBlackIsZero)
+			if tc.samplesPerPixel == 3 {
+				photometric = pRGB
+			}
+			tags := append(testImageTags(t, 4, 2, photometric, tc.samplesPerPixel, tc.bitsPerSample), tc.tags...)
+			tags[3] = testTag(t, exifid.Compression, tc.compression)
+			tags = append(tags, testTag(t, exifid.Predictor, tc.predictor))
+			data := buildTestTIFF(t, tc.order, tags, exifid.StripOffsets, exifid.StripByteCounts, tc.strip)
+			m, err := Open(bytes.NewReader(data), Options{})
+			if err != nil {
+				t.Fatal(err)
+			}
+			testPixels(t, m, tc.want)
+		})
+	}
+}
+
+// setTestEntry sets the count and value of the entry id of IFD0 in the little
+// endian TIFF file data.
+func setTestEntry(t *testing.T, data []byte, id exif.ID, count, value uint32) {
+	t.Helper()
+	ifd := binary.LittleEndian.Uint32(data[4:])
+	n := int(binary.LittleEndian.Uint16(data[ifd:]))
+	for i := 0; i < n; i++ {
+		entry := data[int(ifd)+2+12*i:]
+		if exif.ID(binary.LittleEndian.Uint16(entry)) == id {
+			binary.LittleEndian.PutUint32(entry[4:], count)
+			binary.LittleEndian.PutUint32(entry[8:], value)
+			return
+		}
+	}
+	t.Fatalf("entry %#x not found", id)
+}
+
+func TestOpen_invalidCounts(t *testing.T) {
+	tags := testImageTags(t, 4, 1, pBlackIsZero, 1, 8)
+	valid := buildTestTIFF(t, binary.LittleEndian, tags, exifid.StripOffsets, exifid.StripByteCounts, []byte{1, 2, 3, 4})
+	var before, after runtime.MemStats
+	runtime.ReadMemStats(&before)
+
+	// Fuzzer found input whose Compression entry count allocated about 1.5GB.
+	data := append([]byte{}, valid...)
+	setTestEntry(t, data, exifid.Compression, 0x31000001, 8)
+	_, err := Open(bytes.NewReader(data), Options{})
+	if err == nil {
+		t.Error("expected error for Compression value exceeding file")
+	}
+
+	// Byte count of a compressed strip exceeding the file.
+	data = append([]byte{}, valid...)
+	setTestEntry(t, data, exifid.Compression, 1, cPackBits)
+	setTestEntry(t, data, exifid.StripByteCounts, 1, 0x3fffffff)
+	m, err := Open(bytes.NewReader(data), Options{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := m.Block(0); err == nil {
+		t.Error("expected error for strip byte count exceeding file")
+	}
+
+	// Byte count of a compressed strip far exceeding its decoded size.
+	data = append([]byte{}, valid...)
+	setTestEntry(t, data, exifid.Compression, 1, cPackBits)
+	setTestEntry(t, data, exifid.StripByteCounts, 1, 1<<20)
+	data = append(data, make([]byte, 1<<20)...)
+	m, err = Open(bytes.NewReader(data), Options{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := m.Block(0); err == nil {
+		t.Error("expected error for strip byte count exceeding decoded size")
+	}
+
+	runtime.ReadMemStats(&after)
+	if alloc := after.TotalAlloc - before.TotalAlloc; alloc > 16<<20 {
+		t.Errorf("allocated %d bytes decoding invalid files", alloc)
+	}
+}
+
+func TestImage_Block(t *testing.T) {
+	tags := append(testImageTags(t, 2, 3, pBlackIsZero, 1, 8),
+		testTag(t, exifid.RowsPerStrip, int64(2)),
+		testTag(t, exifid.Predictor, int64(prHorizontal)),
+	)
+	data := buildTestTIFF(t, binary.LittleEndian, tags, exifid.StripOffsets, exifid.StripByteCounts,
+		[]byte{1, 1, 2, 2},
+		[]byte{3},
+	)
+	m, err := Open(bytes.NewReader(data), Options{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if m.NumBlocks() != 2 {
+		t.Fatalf("got %d strips, want 2", m.NumBlocks())
+	}
+	for i, want := range [][]byte{{1, 2, 2, 4}, {3, 3}} {
+		got, err := m.Block(i)
+		if err != nil || !bytes.Equal(got, want) {
+			t.Errorf("strip %d: got %v (%v), want %v", i, got, err, want)
+		}
+	}
+	if _, err := m.Block(2); err == nil {
+		t.Error("expected error for out of range strip")
+	}
+}
+
+// testEntry is an IFD entry of a file built by buildTestChain. Values are
+// stored as LONG unless the tag is ASCII or SHORT.
+type testEntry struct {
+	id    exif.ID
+	short bool
+	ints  []int64
+	ascii string
+}
+
+// buildTestChain returns a little endian TIFF file whose IFD0 chain holds a
+// directory per page with the page's entries. Each page has a single strip
+// of pixel data, whose StripOffsets and StripByteCounts entries are added.
+func buildTestChain(pages [][]testEntry, strips [][]byte) []byte {
+	order := binary.LittleEndian
+	data := []byte("II\x2a\x00\x00\x00\x00\x00")
+	next := 4 // Offset of the next IFD offset to set.
+	for i, entries := range pages {
+		strip := len(data)
+		data = append(data, strips[i]...)
+		if len(data)%2 != 0 {
+			data = append(data, 0)
+		}
+		entries = append(append([]testEntry{}, entries...),
+			testEntry{id: exifid.StripOffsets, ints: []int64{int64(strip)}},
+			testEntry{id: exifid.StripByteCounts, ints: []int64{int64(len(strips[i]))}},
+		)
+		sort.Slice(entries, func(i, j int) bool { return entries[i].id < entries[j].id })
+		ifd := len(data)
+		order.PutUint32(data[next:], uint32(ifd))
+		ifdSize := 2 + 12*len(entries) + 4
+		data = append(data, make([]byte, ifdSize)...)
+		order.PutUint16(data[ifd:], uint16(len(entries)))
+		for j, e := range entries {
+			var value []byte
+			tp := exif.TypeUint32
+			switch {
+			case e.ascii != "":
+				tp = exif.TypeString
+				value = append([]byte(e.ascii), 0)
+			case e.short:
+				tp = exif.TypeUint16
+				for _, v := range e.ints {
+					value = order.AppendUint16(value, uint16(v))
+				}
+			default:
+				for _, v := range e.ints {
+					value = order.AppendUint32(value, uint32(v))
+				}
+			}
+			entry := data[ifd+2+12*j:]
+			order.PutUint16(entry, uint16(e.id))
+			order.PutUint16(entry[2:], uint16(tp))
+			count := len(value)
+			if tp != exif.TypeString {
+				count = len(e.ints)
+			}
+			order.PutUint32(entry[4:], uint32(count))
+			if len(value) <= 4 {
+				copy(entry[8:12], value)
+				continue
+			}
+			order.PutUint32(entry[8:], uint32(len(data)))
+			data = append(data, value...)
+			if len(data)%2 != 0 {
+				data = append(data, 0)
+			}
+		}
+		next = ifd + 2 + 12*len(entries)
+	}
+	return data
+}
+
+func TestPages(t *testing.T) {
+	gray := func(width, height int64, extra ...testEntry) []testEntry {
+		return append([]testEntry{
+			{id: exifid.ImageWidth, ints: []int64{width}},
+			{id: exifid.ImageHeight, ints: []int64{height}},
+			{id: exifid.BitsPerSample, short: true, ints: []int64{8}},
+			{id: exifid.PhotometricInterpretation, short: true, ints: []int64{pBlackIsZero}},
+		}, extra...)
+	}
+	data := buildTestChain([][]testEntry{
+		gray(2, 1,
+			testEntry{id: exifid.SubfileType, ints: []int64{SubfilePage}},
+			testEntry{id: exifid.PageName, ascii: "Cover"},
+			testEntry{id: exifid.PageNumber, short: true, ints: []int64{0, 2}},
+		),
+		gray(1, 1, testEntry{id: exifid.SubfileType, ints: []int64{SubfileReducedResolution}}),
+		gray(2, 1,
+			testEntry{id: exifid.OldSubfileType, short: true, ints: []int64{3}},
+			testEntry{id: exifid.PageNumber, short: true, ints: []int64{1, 2}},
+		),
+		gray(2, 1, testEntry{id: exifid.SubfileType, ints: []int64{SubfilePage | SubfileMask}}),
+	}, [][]byte{{10, 20}, {15}, {30, 40}, {0xff, 0}})
+
+	type page struct {
+		subfileType   int
+		width         int
+		name          string
+		number, total int
+		thumb, mask   bool
+		pixels        []uint8
+	}
+	want := []page{
+		{SubfilePage, 2, "Cover", 0, 2, false, false, []uint8{10, 20}},
+		{SubfileReducedResolution, 1, "", -1, -1, true, false, []uint8{15}},
+		{SubfilePage, 2, "", 1, 2, false, false, []uint8{30, 40}},
+		{SubfilePage | SubfileMask, 2, "", -1, -1, false, true, []uint8{0xff, 0}},
+	}
+	it, err := Pages(bytes.NewReader(data), Options{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	i := 0
+	for ; it.Next(); i++ {
+		p := it.Page()
+		if i >= len(want) {
+			t.Fatal("too many pages")
+		}
+		w := want[i]
+		got := page{p.SubfileType, p.Width, p.Name, p.Number, p.Total, p.IsThumbnail(), p.IsMask(), nil}
+		if p.Index != i || p.Height != 1 {
+			t.Errorf("page %d: got index %d and height %d", i, p.Index, p.Height)
+		}
+		m, err := p.Image()
+		if err != nil {
+			t.Fatalf("page %d: %v", i, err)
+		}
+		for x := 0; x < p.Width; x++ {
+			got.pixels = append(got.pixels, m.At(x, 0).(color.Gray).Y)
+		}
+		if fmt.Sprint(got) != fmt.Sprint(w) {
+			t.Errorf("page %d: got %+v, want %+v", i, got, w)
+		}
+	}
+	if err := it.Err(); err != nil {
+		t.Fatal(err)
+	}
+	if i != len(want) || it.Page() != nil {
+		t.Errorf("got %d pages, want %d", i, len(want))
+	}
+}
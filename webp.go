@@ -0,0 +1,162 @@
+package exif
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+)
+
+// WebP RIFF chunk FourCCs handled by this package.
+const (
+	webpChunkVP8X = "VP8X"
+	webpChunkEXIF = "EXIF"
+	webpChunkXMP  = "XMP "
+	webpChunkICCP = "ICCP"
+)
+
+// VP8X chunk flags, found in the first byte of the chunk's payload.
+const (
+	vp8xFlagXMP  = 1 << 2
+	vp8xFlagEXIF = 1 << 3
+	vp8xFlagICC  = 1 << 5
+)
+
+// webpChunk is a chunk of a WebP RIFF container.
+type webpChunk struct {
+	fourcc string
+	// offset of the chunk's payload in the RIFF container.
+	offset int64
+	data   []byte
+}
+
+// isWebP reports whether b begins with the header of a WebP RIFF container.
+func isWebP(b []byte) bool {
+	return len(b) >= 12 && string(b[:4]) == "RIFF" && string(b[8:12]) == "WEBP"
+}
+
+// parseWebP parses the chunks of the WebP RIFF container in b.
+// The returned chunks reference the data in b.
+func parseWebP(b []byte) ([]webpChunk, error) {
+	if !isWebP(b) {
+		return nil, errors.New("missing WebP RIFF header")
+	}
+	riffSize := int64(binary.LittleEndian.Uint32(b[4:8]))
+	end := 8 + riffSize
+	if end > int64(len(b)) {
+		return nil, errors.New("WebP RIFF size exceeds data")
+	}
+	var chunks []webpChunk
+	for off := int64(12); off < end; {
+		if end-off < 8 {
+			return nil, errors.New("short WebP chunk header")
+		}
+		size := int64(binary.LittleEndian.Uint32(b[off+4:]))
+		start := off + 8
+		if size > end-start {
+			return nil, fmt.Errorf("WebP %q chunk size exceeds RIFF container", b[off:off+4])
+		}
+		chunks = append(chunks, webpChunk{
+			fourcc: string(b[off : off+4]),
+			offset: start,
+			data:   b[start : start+size],
+		})
+		off = start + size + size%2 // Chunks are padded to even size.
+	}
+	return chunks, nil
+}
+
+// appendWebP appends the WebP RIFF container with the given chunks to dst.
+func appendWebP(dst []byte, chunks []webpChunk) []byte {
+	var riffSize uint32 = 4 // "WEBP" FourCC.
+	for _, chunk := range chunks {
+		riffSize += 8 + uint32(len(chunk.data)+len(chunk.data)%2)
+	}
+	dst = append(dst, "RIFF"...)
+	dst = binary.LittleEndian.AppendUint32(dst, riffSize)
+	dst = append(dst, "WEBP"...)
+	for _, chunk := range chunks {
+		dst = append(dst, chunk.fourcc...)
+		dst = binary.LittleEndian.AppendUint32(dst, uint32(len(chunk.data)))
+		dst = append(dst, chunk.data...)
+		if len(chunk.data)%2 != 0 {
+			dst = append(dst, 0)
+		}
+	}
+	return dst
+}
+
+// FindWebPExif searches the chunks of the extended (VP8X) WebP image in r for the
+// EXIF chunk and returns a reader of the TIFF formatted EXIF data it contains,
+// which may be passed to [LazyDecoder.Decode]. The "Exif\x00\x00" prefix written
+// by some encoders is skipped.
+func FindWebPExif(r io.ReaderAt) (*io.SectionReader, error) {
+	offset, size, err := findWebPExif(r)
+	if err != nil {
+		return nil, err
+	}
+	return io.NewSectionReader(r, offset, size), nil
+}
+
+// findWebPExif returns the offset and size of the TIFF formatted EXIF data in the EXIF chunk.
+func findWebPExif(r io.ReaderAt) (offset, size int64, err error) {
+	offset, size, err = findWebPChunk(r, webpChunkEXIF)
+	if err != nil {
+		return 0, 0, err
+	}
+	var hdr [len(exifHeader)]byte
+	if size >= int64(len(hdr)) {
+		_, err = r.ReadAt(hdr[:], offset)
+		if err != nil {
+			return 0, 0, noEOF(err)
+		}
+		if string(hdr[:]) == exifHeader {
+			offset += int64(len(hdr))
+			size -= int64(len(hdr))
+		}
+	}
+	return offset, size, nil
+}
+
+// FindWebPXMP searches the chunks of the extended (VP8X) WebP image in r for
+// the XMP chunk and returns a reader of the XMP packet it contains.
+func FindWebPXMP(r io.ReaderAt) (*io.SectionReader, error) {
+	offset, size, err := findWebPChunk(r, webpChunkXMP)
+	if err != nil {
+		return nil, err
+	}
+	return io.NewSectionReader(r, offset, size), nil
+}
+
+// findWebPChunk returns the offset and size of the payload of the first
+// chunk with the given FourCC in the extended WebP image in r.
+func findWebPChunk(r io.ReaderAt, fourcc string) (offset, size int64, err error) {
+	var hdr [12]byte
+	_, err = r.ReadAt(hdr[:], 0)
+	if err != nil {
+		return 0, 0, noEOF(err)
+	}
+	if !isWebP(hdr[:]) {
+		return 0, 0, errors.New("missing WebP RIFF header")
+	}
+	end := 8 + int64(binary.LittleEndian.Uint32(hdr[4:8]))
+	for off := int64(12); off < end; {
+		_, err = r.ReadAt(hdr[:8], off)
+		if err != nil {
+			return 0, 0, noEOF(err)
+		}
+		chunk := string(hdr[:4])
+		size = int64(binary.LittleEndian.Uint32(hdr[4:8]))
+		if off == 12 && chunk != webpChunkVP8X {
+			return 0, 0, fmt.Errorf("simple WebP format (%q) does not contain metadata", chunk)
+		}
+		if size > end-off-8 {
+			return 0, 0, fmt.Errorf("WebP %q chunk size exceeds RIFF container", chunk)
+		}
+		if chunk == fourcc {
+			return off + 8, size, nil
+		}
+		off += 8 + size + size%2 // Chunks are padded to even size.
+	}
+	return 0, 0, fmt.Errorf("WebP %q chunk not found", fourcc)
+}
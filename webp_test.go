@@ -0,0 +1,61 @@
+package exif
+
+import (
+	"bytes"
+	"encoding/binary"
+	"io"
+	"testing"
+)
+
+func TestLazyDecoder_webp(t *testing.T) {
+	exif, err := Marshal(binary.LittleEndian, []IFD{{Group: GroupIFD0, Tags: []Tag{
+		{ID: 0x010f, data: "Maker"}, // Make.
+	}}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	xmp := []byte("<x:xmpmeta/>")
+	for _, tc := range []struct {
+		desc string
+		data []byte
+	}{
+		{desc: "no prefix", data: exif},
+		{desc: "Exif prefix", data: append([]byte(exifHeader), exif...)},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			webp := appendWebP(nil, []webpChunk{
+				{fourcc: webpChunkVP8X, data: []byte{vp8xFlagEXIF | vp8xFlagXMP, 0, 0, 0, 1, 0, 0, 1, 0, 0}},
+				{fourcc: "VP8 ", data: []byte{1, 2, 3}},
+				{fourcc: webpChunkEXIF, data: tc.data},
+				{fourcc: webpChunkXMP, data: xmp},
+			})
+			var decoder LazyDecoder
+			r := bytes.NewReader(webp)
+			err := decoder.Decode(r)
+			if err != nil {
+				t.Fatal(err)
+			}
+			tag, err := decoder.GetTag(r, GroupIFD0, 0x010f)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got := tag.String(); got != "Make (string): Maker\x00" {
+				t.Errorf("got tag %q", got)
+			}
+			sr, err := FindWebPXMP(r)
+			if err != nil {
+				t.Fatal(err)
+			}
+			got, _ := io.ReadAll(sr)
+			if !bytes.Equal(got, xmp) {
+				t.Errorf("got XMP %q, want %q", got, xmp)
+			}
+		})
+	}
+
+	simple := appendWebP(nil, []webpChunk{{fourcc: "VP8L", data: []byte{1, 2, 3}}})
+	_, err = FindWebPExif(bytes.NewReader(simple))
+	if err == nil {
+		t.Error("expected error for simple WebP format")
+	}
+}